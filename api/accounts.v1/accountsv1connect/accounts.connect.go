@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: accounts.v1/accounts.proto
+
+package accountsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	accounts_v1 "github.com/manzanit0/mcduck/api/accounts.v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccountsServiceName is the fully-qualified name of the AccountsService service.
+	AccountsServiceName = "accounts.v1.AccountsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccountsServiceCreateAccountProcedure is the fully-qualified name of the AccountsService's
+	// CreateAccount RPC.
+	AccountsServiceCreateAccountProcedure = "/accounts.v1.AccountsService/CreateAccount"
+	// AccountsServiceUpdateAccountProcedure is the fully-qualified name of the AccountsService's
+	// UpdateAccount RPC.
+	AccountsServiceUpdateAccountProcedure = "/accounts.v1.AccountsService/UpdateAccount"
+	// AccountsServiceDeleteAccountProcedure is the fully-qualified name of the AccountsService's
+	// DeleteAccount RPC.
+	AccountsServiceDeleteAccountProcedure = "/accounts.v1.AccountsService/DeleteAccount"
+	// AccountsServiceListAccountsProcedure is the fully-qualified name of the AccountsService's
+	// ListAccounts RPC.
+	AccountsServiceListAccountsProcedure = "/accounts.v1.AccountsService/ListAccounts"
+	// AccountsServiceCreateTransferProcedure is the fully-qualified name of the AccountsService's
+	// CreateTransfer RPC.
+	AccountsServiceCreateTransferProcedure = "/accounts.v1.AccountsService/CreateTransfer"
+	// AccountsServiceDeleteTransferProcedure is the fully-qualified name of the AccountsService's
+	// DeleteTransfer RPC.
+	AccountsServiceDeleteTransferProcedure = "/accounts.v1.AccountsService/DeleteTransfer"
+	// AccountsServiceListTransfersProcedure is the fully-qualified name of the AccountsService's
+	// ListTransfers RPC.
+	AccountsServiceListTransfersProcedure = "/accounts.v1.AccountsService/ListTransfers"
+	// AccountsServiceGetBalanceHistoryProcedure is the fully-qualified name of the AccountsService's
+	// GetBalanceHistory RPC.
+	AccountsServiceGetBalanceHistoryProcedure = "/accounts.v1.AccountsService/GetBalanceHistory"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	accountsServiceServiceDescriptor                 = accounts_v1.File_accounts_v1_accounts_proto.Services().ByName("AccountsService")
+	accountsServiceCreateAccountMethodDescriptor     = accountsServiceServiceDescriptor.Methods().ByName("CreateAccount")
+	accountsServiceUpdateAccountMethodDescriptor     = accountsServiceServiceDescriptor.Methods().ByName("UpdateAccount")
+	accountsServiceDeleteAccountMethodDescriptor     = accountsServiceServiceDescriptor.Methods().ByName("DeleteAccount")
+	accountsServiceListAccountsMethodDescriptor      = accountsServiceServiceDescriptor.Methods().ByName("ListAccounts")
+	accountsServiceCreateTransferMethodDescriptor    = accountsServiceServiceDescriptor.Methods().ByName("CreateTransfer")
+	accountsServiceDeleteTransferMethodDescriptor    = accountsServiceServiceDescriptor.Methods().ByName("DeleteTransfer")
+	accountsServiceListTransfersMethodDescriptor     = accountsServiceServiceDescriptor.Methods().ByName("ListTransfers")
+	accountsServiceGetBalanceHistoryMethodDescriptor = accountsServiceServiceDescriptor.Methods().ByName("GetBalanceHistory")
+)
+
+// AccountsServiceClient is a client for the accounts.v1.AccountsService service.
+type AccountsServiceClient interface {
+	CreateAccount(context.Context, *connect.Request[accounts_v1.CreateAccountRequest]) (*connect.Response[accounts_v1.CreateAccountResponse], error)
+	UpdateAccount(context.Context, *connect.Request[accounts_v1.UpdateAccountRequest]) (*connect.Response[accounts_v1.UpdateAccountResponse], error)
+	DeleteAccount(context.Context, *connect.Request[accounts_v1.DeleteAccountRequest]) (*connect.Response[accounts_v1.DeleteAccountResponse], error)
+	ListAccounts(context.Context, *connect.Request[accounts_v1.ListAccountsRequest]) (*connect.Response[accounts_v1.ListAccountsResponse], error)
+	CreateTransfer(context.Context, *connect.Request[accounts_v1.CreateTransferRequest]) (*connect.Response[accounts_v1.CreateTransferResponse], error)
+	DeleteTransfer(context.Context, *connect.Request[accounts_v1.DeleteTransferRequest]) (*connect.Response[accounts_v1.DeleteTransferResponse], error)
+	ListTransfers(context.Context, *connect.Request[accounts_v1.ListTransfersRequest]) (*connect.Response[accounts_v1.ListTransfersResponse], error)
+	GetBalanceHistory(context.Context, *connect.Request[accounts_v1.GetBalanceHistoryRequest]) (*connect.Response[accounts_v1.GetBalanceHistoryResponse], error)
+}
+
+// NewAccountsServiceClient constructs a client for the accounts.v1.AccountsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccountsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccountsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &accountsServiceClient{
+		createAccount: connect.NewClient[accounts_v1.CreateAccountRequest, accounts_v1.CreateAccountResponse](
+			httpClient,
+			baseURL+AccountsServiceCreateAccountProcedure,
+			connect.WithSchema(accountsServiceCreateAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateAccount: connect.NewClient[accounts_v1.UpdateAccountRequest, accounts_v1.UpdateAccountResponse](
+			httpClient,
+			baseURL+AccountsServiceUpdateAccountProcedure,
+			connect.WithSchema(accountsServiceUpdateAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[accounts_v1.DeleteAccountRequest, accounts_v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+AccountsServiceDeleteAccountProcedure,
+			connect.WithSchema(accountsServiceDeleteAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAccounts: connect.NewClient[accounts_v1.ListAccountsRequest, accounts_v1.ListAccountsResponse](
+			httpClient,
+			baseURL+AccountsServiceListAccountsProcedure,
+			connect.WithSchema(accountsServiceListAccountsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createTransfer: connect.NewClient[accounts_v1.CreateTransferRequest, accounts_v1.CreateTransferResponse](
+			httpClient,
+			baseURL+AccountsServiceCreateTransferProcedure,
+			connect.WithSchema(accountsServiceCreateTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTransfer: connect.NewClient[accounts_v1.DeleteTransferRequest, accounts_v1.DeleteTransferResponse](
+			httpClient,
+			baseURL+AccountsServiceDeleteTransferProcedure,
+			connect.WithSchema(accountsServiceDeleteTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTransfers: connect.NewClient[accounts_v1.ListTransfersRequest, accounts_v1.ListTransfersResponse](
+			httpClient,
+			baseURL+AccountsServiceListTransfersProcedure,
+			connect.WithSchema(accountsServiceListTransfersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getBalanceHistory: connect.NewClient[accounts_v1.GetBalanceHistoryRequest, accounts_v1.GetBalanceHistoryResponse](
+			httpClient,
+			baseURL+AccountsServiceGetBalanceHistoryProcedure,
+			connect.WithSchema(accountsServiceGetBalanceHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accountsServiceClient implements AccountsServiceClient.
+type accountsServiceClient struct {
+	createAccount     *connect.Client[accounts_v1.CreateAccountRequest, accounts_v1.CreateAccountResponse]
+	updateAccount     *connect.Client[accounts_v1.UpdateAccountRequest, accounts_v1.UpdateAccountResponse]
+	deleteAccount     *connect.Client[accounts_v1.DeleteAccountRequest, accounts_v1.DeleteAccountResponse]
+	listAccounts      *connect.Client[accounts_v1.ListAccountsRequest, accounts_v1.ListAccountsResponse]
+	createTransfer    *connect.Client[accounts_v1.CreateTransferRequest, accounts_v1.CreateTransferResponse]
+	deleteTransfer    *connect.Client[accounts_v1.DeleteTransferRequest, accounts_v1.DeleteTransferResponse]
+	listTransfers     *connect.Client[accounts_v1.ListTransfersRequest, accounts_v1.ListTransfersResponse]
+	getBalanceHistory *connect.Client[accounts_v1.GetBalanceHistoryRequest, accounts_v1.GetBalanceHistoryResponse]
+}
+
+// CreateAccount calls accounts.v1.AccountsService.CreateAccount.
+func (c *accountsServiceClient) CreateAccount(ctx context.Context, req *connect.Request[accounts_v1.CreateAccountRequest]) (*connect.Response[accounts_v1.CreateAccountResponse], error) {
+	return c.createAccount.CallUnary(ctx, req)
+}
+
+// UpdateAccount calls accounts.v1.AccountsService.UpdateAccount.
+func (c *accountsServiceClient) UpdateAccount(ctx context.Context, req *connect.Request[accounts_v1.UpdateAccountRequest]) (*connect.Response[accounts_v1.UpdateAccountResponse], error) {
+	return c.updateAccount.CallUnary(ctx, req)
+}
+
+// DeleteAccount calls accounts.v1.AccountsService.DeleteAccount.
+func (c *accountsServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[accounts_v1.DeleteAccountRequest]) (*connect.Response[accounts_v1.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
+// ListAccounts calls accounts.v1.AccountsService.ListAccounts.
+func (c *accountsServiceClient) ListAccounts(ctx context.Context, req *connect.Request[accounts_v1.ListAccountsRequest]) (*connect.Response[accounts_v1.ListAccountsResponse], error) {
+	return c.listAccounts.CallUnary(ctx, req)
+}
+
+// CreateTransfer calls accounts.v1.AccountsService.CreateTransfer.
+func (c *accountsServiceClient) CreateTransfer(ctx context.Context, req *connect.Request[accounts_v1.CreateTransferRequest]) (*connect.Response[accounts_v1.CreateTransferResponse], error) {
+	return c.createTransfer.CallUnary(ctx, req)
+}
+
+// DeleteTransfer calls accounts.v1.AccountsService.DeleteTransfer.
+func (c *accountsServiceClient) DeleteTransfer(ctx context.Context, req *connect.Request[accounts_v1.DeleteTransferRequest]) (*connect.Response[accounts_v1.DeleteTransferResponse], error) {
+	return c.deleteTransfer.CallUnary(ctx, req)
+}
+
+// ListTransfers calls accounts.v1.AccountsService.ListTransfers.
+func (c *accountsServiceClient) ListTransfers(ctx context.Context, req *connect.Request[accounts_v1.ListTransfersRequest]) (*connect.Response[accounts_v1.ListTransfersResponse], error) {
+	return c.listTransfers.CallUnary(ctx, req)
+}
+
+// GetBalanceHistory calls accounts.v1.AccountsService.GetBalanceHistory.
+func (c *accountsServiceClient) GetBalanceHistory(ctx context.Context, req *connect.Request[accounts_v1.GetBalanceHistoryRequest]) (*connect.Response[accounts_v1.GetBalanceHistoryResponse], error) {
+	return c.getBalanceHistory.CallUnary(ctx, req)
+}
+
+// AccountsServiceHandler is an implementation of the accounts.v1.AccountsService service.
+type AccountsServiceHandler interface {
+	CreateAccount(context.Context, *connect.Request[accounts_v1.CreateAccountRequest]) (*connect.Response[accounts_v1.CreateAccountResponse], error)
+	UpdateAccount(context.Context, *connect.Request[accounts_v1.UpdateAccountRequest]) (*connect.Response[accounts_v1.UpdateAccountResponse], error)
+	DeleteAccount(context.Context, *connect.Request[accounts_v1.DeleteAccountRequest]) (*connect.Response[accounts_v1.DeleteAccountResponse], error)
+	ListAccounts(context.Context, *connect.Request[accounts_v1.ListAccountsRequest]) (*connect.Response[accounts_v1.ListAccountsResponse], error)
+	CreateTransfer(context.Context, *connect.Request[accounts_v1.CreateTransferRequest]) (*connect.Response[accounts_v1.CreateTransferResponse], error)
+	DeleteTransfer(context.Context, *connect.Request[accounts_v1.DeleteTransferRequest]) (*connect.Response[accounts_v1.DeleteTransferResponse], error)
+	ListTransfers(context.Context, *connect.Request[accounts_v1.ListTransfersRequest]) (*connect.Response[accounts_v1.ListTransfersResponse], error)
+	GetBalanceHistory(context.Context, *connect.Request[accounts_v1.GetBalanceHistoryRequest]) (*connect.Response[accounts_v1.GetBalanceHistoryResponse], error)
+}
+
+// NewAccountsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccountsServiceHandler(svc AccountsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accountsServiceCreateAccountHandler := connect.NewUnaryHandler(
+		AccountsServiceCreateAccountProcedure,
+		svc.CreateAccount,
+		connect.WithSchema(accountsServiceCreateAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountsServiceUpdateAccountHandler := connect.NewUnaryHandler(
+		AccountsServiceUpdateAccountProcedure,
+		svc.UpdateAccount,
+		connect.WithSchema(accountsServiceUpdateAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountsServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		AccountsServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(accountsServiceDeleteAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountsServiceListAccountsHandler := connect.NewUnaryHandler(
+		AccountsServiceListAccountsProcedure,
+		svc.ListAccounts,
+		connect.WithSchema(accountsServiceListAccountsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountsServiceCreateTransferHandler := connect.NewUnaryHandler(
+		AccountsServiceCreateTransferProcedure,
+		svc.CreateTransfer,
+		connect.WithSchema(accountsServiceCreateTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountsServiceDeleteTransferHandler := connect.NewUnaryHandler(
+		AccountsServiceDeleteTransferProcedure,
+		svc.DeleteTransfer,
+		connect.WithSchema(accountsServiceDeleteTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountsServiceListTransfersHandler := connect.NewUnaryHandler(
+		AccountsServiceListTransfersProcedure,
+		svc.ListTransfers,
+		connect.WithSchema(accountsServiceListTransfersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountsServiceGetBalanceHistoryHandler := connect.NewUnaryHandler(
+		AccountsServiceGetBalanceHistoryProcedure,
+		svc.GetBalanceHistory,
+		connect.WithSchema(accountsServiceGetBalanceHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/accounts.v1.AccountsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccountsServiceCreateAccountProcedure:
+			accountsServiceCreateAccountHandler.ServeHTTP(w, r)
+		case AccountsServiceUpdateAccountProcedure:
+			accountsServiceUpdateAccountHandler.ServeHTTP(w, r)
+		case AccountsServiceDeleteAccountProcedure:
+			accountsServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case AccountsServiceListAccountsProcedure:
+			accountsServiceListAccountsHandler.ServeHTTP(w, r)
+		case AccountsServiceCreateTransferProcedure:
+			accountsServiceCreateTransferHandler.ServeHTTP(w, r)
+		case AccountsServiceDeleteTransferProcedure:
+			accountsServiceDeleteTransferHandler.ServeHTTP(w, r)
+		case AccountsServiceListTransfersProcedure:
+			accountsServiceListTransfersHandler.ServeHTTP(w, r)
+		case AccountsServiceGetBalanceHistoryProcedure:
+			accountsServiceGetBalanceHistoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccountsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccountsServiceHandler struct{}
+
+func (UnimplementedAccountsServiceHandler) CreateAccount(context.Context, *connect.Request[accounts_v1.CreateAccountRequest]) (*connect.Response[accounts_v1.CreateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.CreateAccount is not implemented"))
+}
+
+func (UnimplementedAccountsServiceHandler) UpdateAccount(context.Context, *connect.Request[accounts_v1.UpdateAccountRequest]) (*connect.Response[accounts_v1.UpdateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.UpdateAccount is not implemented"))
+}
+
+func (UnimplementedAccountsServiceHandler) DeleteAccount(context.Context, *connect.Request[accounts_v1.DeleteAccountRequest]) (*connect.Response[accounts_v1.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.DeleteAccount is not implemented"))
+}
+
+func (UnimplementedAccountsServiceHandler) ListAccounts(context.Context, *connect.Request[accounts_v1.ListAccountsRequest]) (*connect.Response[accounts_v1.ListAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.ListAccounts is not implemented"))
+}
+
+func (UnimplementedAccountsServiceHandler) CreateTransfer(context.Context, *connect.Request[accounts_v1.CreateTransferRequest]) (*connect.Response[accounts_v1.CreateTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.CreateTransfer is not implemented"))
+}
+
+func (UnimplementedAccountsServiceHandler) DeleteTransfer(context.Context, *connect.Request[accounts_v1.DeleteTransferRequest]) (*connect.Response[accounts_v1.DeleteTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.DeleteTransfer is not implemented"))
+}
+
+func (UnimplementedAccountsServiceHandler) ListTransfers(context.Context, *connect.Request[accounts_v1.ListTransfersRequest]) (*connect.Response[accounts_v1.ListTransfersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.ListTransfers is not implemented"))
+}
+
+func (UnimplementedAccountsServiceHandler) GetBalanceHistory(context.Context, *connect.Request[accounts_v1.GetBalanceHistoryRequest]) (*connect.Response[accounts_v1.GetBalanceHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accounts.v1.AccountsService.GetBalanceHistory is not implemented"))
+}
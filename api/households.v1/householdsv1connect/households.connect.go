@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: households.v1/households.proto
+
+package householdsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	households_v1 "github.com/manzanit0/mcduck/api/households.v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// HouseholdsServiceName is the fully-qualified name of the HouseholdsService service.
+	HouseholdsServiceName = "households.v1.HouseholdsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// HouseholdsServiceCreateHouseholdProcedure is the fully-qualified name of the HouseholdsService's
+	// CreateHousehold RPC.
+	HouseholdsServiceCreateHouseholdProcedure = "/households.v1.HouseholdsService/CreateHousehold"
+	// HouseholdsServiceGetHouseholdProcedure is the fully-qualified name of the HouseholdsService's
+	// GetHousehold RPC.
+	HouseholdsServiceGetHouseholdProcedure = "/households.v1.HouseholdsService/GetHousehold"
+	// HouseholdsServiceInviteMemberProcedure is the fully-qualified name of the HouseholdsService's
+	// InviteMember RPC.
+	HouseholdsServiceInviteMemberProcedure = "/households.v1.HouseholdsService/InviteMember"
+	// HouseholdsServiceListInvitesProcedure is the fully-qualified name of the HouseholdsService's
+	// ListInvites RPC.
+	HouseholdsServiceListInvitesProcedure = "/households.v1.HouseholdsService/ListInvites"
+	// HouseholdsServiceAcceptInviteProcedure is the fully-qualified name of the HouseholdsService's
+	// AcceptInvite RPC.
+	HouseholdsServiceAcceptInviteProcedure = "/households.v1.HouseholdsService/AcceptInvite"
+	// HouseholdsServiceDeclineInviteProcedure is the fully-qualified name of the HouseholdsService's
+	// DeclineInvite RPC.
+	HouseholdsServiceDeclineInviteProcedure = "/households.v1.HouseholdsService/DeclineInvite"
+	// HouseholdsServiceLeaveHouseholdProcedure is the fully-qualified name of the HouseholdsService's
+	// LeaveHousehold RPC.
+	HouseholdsServiceLeaveHouseholdProcedure = "/households.v1.HouseholdsService/LeaveHousehold"
+	// HouseholdsServiceRemoveMemberProcedure is the fully-qualified name of the HouseholdsService's
+	// RemoveMember RPC.
+	HouseholdsServiceRemoveMemberProcedure = "/households.v1.HouseholdsService/RemoveMember"
+	// HouseholdsServiceUpdateMemberRoleProcedure is the fully-qualified name of the HouseholdsService's
+	// UpdateMemberRole RPC.
+	HouseholdsServiceUpdateMemberRoleProcedure = "/households.v1.HouseholdsService/UpdateMemberRole"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	householdsServiceServiceDescriptor                = households_v1.File_households_v1_households_proto.Services().ByName("HouseholdsService")
+	householdsServiceCreateHouseholdMethodDescriptor  = householdsServiceServiceDescriptor.Methods().ByName("CreateHousehold")
+	householdsServiceGetHouseholdMethodDescriptor     = householdsServiceServiceDescriptor.Methods().ByName("GetHousehold")
+	householdsServiceInviteMemberMethodDescriptor     = householdsServiceServiceDescriptor.Methods().ByName("InviteMember")
+	householdsServiceListInvitesMethodDescriptor      = householdsServiceServiceDescriptor.Methods().ByName("ListInvites")
+	householdsServiceAcceptInviteMethodDescriptor     = householdsServiceServiceDescriptor.Methods().ByName("AcceptInvite")
+	householdsServiceDeclineInviteMethodDescriptor    = householdsServiceServiceDescriptor.Methods().ByName("DeclineInvite")
+	householdsServiceLeaveHouseholdMethodDescriptor   = householdsServiceServiceDescriptor.Methods().ByName("LeaveHousehold")
+	householdsServiceRemoveMemberMethodDescriptor     = householdsServiceServiceDescriptor.Methods().ByName("RemoveMember")
+	householdsServiceUpdateMemberRoleMethodDescriptor = householdsServiceServiceDescriptor.Methods().ByName("UpdateMemberRole")
+)
+
+// HouseholdsServiceClient is a client for the households.v1.HouseholdsService service.
+type HouseholdsServiceClient interface {
+	CreateHousehold(context.Context, *connect.Request[households_v1.CreateHouseholdRequest]) (*connect.Response[households_v1.CreateHouseholdResponse], error)
+	GetHousehold(context.Context, *connect.Request[households_v1.GetHouseholdRequest]) (*connect.Response[households_v1.GetHouseholdResponse], error)
+	InviteMember(context.Context, *connect.Request[households_v1.InviteMemberRequest]) (*connect.Response[households_v1.InviteMemberResponse], error)
+	ListInvites(context.Context, *connect.Request[households_v1.ListInvitesRequest]) (*connect.Response[households_v1.ListInvitesResponse], error)
+	AcceptInvite(context.Context, *connect.Request[households_v1.AcceptInviteRequest]) (*connect.Response[households_v1.AcceptInviteResponse], error)
+	DeclineInvite(context.Context, *connect.Request[households_v1.DeclineInviteRequest]) (*connect.Response[households_v1.DeclineInviteResponse], error)
+	LeaveHousehold(context.Context, *connect.Request[households_v1.LeaveHouseholdRequest]) (*connect.Response[households_v1.LeaveHouseholdResponse], error)
+	RemoveMember(context.Context, *connect.Request[households_v1.RemoveMemberRequest]) (*connect.Response[households_v1.RemoveMemberResponse], error)
+	UpdateMemberRole(context.Context, *connect.Request[households_v1.UpdateMemberRoleRequest]) (*connect.Response[households_v1.UpdateMemberRoleResponse], error)
+}
+
+// NewHouseholdsServiceClient constructs a client for the households.v1.HouseholdsService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewHouseholdsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) HouseholdsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &householdsServiceClient{
+		createHousehold: connect.NewClient[households_v1.CreateHouseholdRequest, households_v1.CreateHouseholdResponse](
+			httpClient,
+			baseURL+HouseholdsServiceCreateHouseholdProcedure,
+			connect.WithSchema(householdsServiceCreateHouseholdMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getHousehold: connect.NewClient[households_v1.GetHouseholdRequest, households_v1.GetHouseholdResponse](
+			httpClient,
+			baseURL+HouseholdsServiceGetHouseholdProcedure,
+			connect.WithSchema(householdsServiceGetHouseholdMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		inviteMember: connect.NewClient[households_v1.InviteMemberRequest, households_v1.InviteMemberResponse](
+			httpClient,
+			baseURL+HouseholdsServiceInviteMemberProcedure,
+			connect.WithSchema(householdsServiceInviteMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listInvites: connect.NewClient[households_v1.ListInvitesRequest, households_v1.ListInvitesResponse](
+			httpClient,
+			baseURL+HouseholdsServiceListInvitesProcedure,
+			connect.WithSchema(householdsServiceListInvitesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvite: connect.NewClient[households_v1.AcceptInviteRequest, households_v1.AcceptInviteResponse](
+			httpClient,
+			baseURL+HouseholdsServiceAcceptInviteProcedure,
+			connect.WithSchema(householdsServiceAcceptInviteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		declineInvite: connect.NewClient[households_v1.DeclineInviteRequest, households_v1.DeclineInviteResponse](
+			httpClient,
+			baseURL+HouseholdsServiceDeclineInviteProcedure,
+			connect.WithSchema(householdsServiceDeclineInviteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		leaveHousehold: connect.NewClient[households_v1.LeaveHouseholdRequest, households_v1.LeaveHouseholdResponse](
+			httpClient,
+			baseURL+HouseholdsServiceLeaveHouseholdProcedure,
+			connect.WithSchema(householdsServiceLeaveHouseholdMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeMember: connect.NewClient[households_v1.RemoveMemberRequest, households_v1.RemoveMemberResponse](
+			httpClient,
+			baseURL+HouseholdsServiceRemoveMemberProcedure,
+			connect.WithSchema(householdsServiceRemoveMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemberRole: connect.NewClient[households_v1.UpdateMemberRoleRequest, households_v1.UpdateMemberRoleResponse](
+			httpClient,
+			baseURL+HouseholdsServiceUpdateMemberRoleProcedure,
+			connect.WithSchema(householdsServiceUpdateMemberRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// householdsServiceClient implements HouseholdsServiceClient.
+type householdsServiceClient struct {
+	createHousehold  *connect.Client[households_v1.CreateHouseholdRequest, households_v1.CreateHouseholdResponse]
+	getHousehold     *connect.Client[households_v1.GetHouseholdRequest, households_v1.GetHouseholdResponse]
+	inviteMember     *connect.Client[households_v1.InviteMemberRequest, households_v1.InviteMemberResponse]
+	listInvites      *connect.Client[households_v1.ListInvitesRequest, households_v1.ListInvitesResponse]
+	acceptInvite     *connect.Client[households_v1.AcceptInviteRequest, households_v1.AcceptInviteResponse]
+	declineInvite    *connect.Client[households_v1.DeclineInviteRequest, households_v1.DeclineInviteResponse]
+	leaveHousehold   *connect.Client[households_v1.LeaveHouseholdRequest, households_v1.LeaveHouseholdResponse]
+	removeMember     *connect.Client[households_v1.RemoveMemberRequest, households_v1.RemoveMemberResponse]
+	updateMemberRole *connect.Client[households_v1.UpdateMemberRoleRequest, households_v1.UpdateMemberRoleResponse]
+}
+
+// CreateHousehold calls households.v1.HouseholdsService.CreateHousehold.
+func (c *householdsServiceClient) CreateHousehold(ctx context.Context, req *connect.Request[households_v1.CreateHouseholdRequest]) (*connect.Response[households_v1.CreateHouseholdResponse], error) {
+	return c.createHousehold.CallUnary(ctx, req)
+}
+
+// GetHousehold calls households.v1.HouseholdsService.GetHousehold.
+func (c *householdsServiceClient) GetHousehold(ctx context.Context, req *connect.Request[households_v1.GetHouseholdRequest]) (*connect.Response[households_v1.GetHouseholdResponse], error) {
+	return c.getHousehold.CallUnary(ctx, req)
+}
+
+// InviteMember calls households.v1.HouseholdsService.InviteMember.
+func (c *householdsServiceClient) InviteMember(ctx context.Context, req *connect.Request[households_v1.InviteMemberRequest]) (*connect.Response[households_v1.InviteMemberResponse], error) {
+	return c.inviteMember.CallUnary(ctx, req)
+}
+
+// ListInvites calls households.v1.HouseholdsService.ListInvites.
+func (c *householdsServiceClient) ListInvites(ctx context.Context, req *connect.Request[households_v1.ListInvitesRequest]) (*connect.Response[households_v1.ListInvitesResponse], error) {
+	return c.listInvites.CallUnary(ctx, req)
+}
+
+// AcceptInvite calls households.v1.HouseholdsService.AcceptInvite.
+func (c *householdsServiceClient) AcceptInvite(ctx context.Context, req *connect.Request[households_v1.AcceptInviteRequest]) (*connect.Response[households_v1.AcceptInviteResponse], error) {
+	return c.acceptInvite.CallUnary(ctx, req)
+}
+
+// DeclineInvite calls households.v1.HouseholdsService.DeclineInvite.
+func (c *householdsServiceClient) DeclineInvite(ctx context.Context, req *connect.Request[households_v1.DeclineInviteRequest]) (*connect.Response[households_v1.DeclineInviteResponse], error) {
+	return c.declineInvite.CallUnary(ctx, req)
+}
+
+// LeaveHousehold calls households.v1.HouseholdsService.LeaveHousehold.
+func (c *householdsServiceClient) LeaveHousehold(ctx context.Context, req *connect.Request[households_v1.LeaveHouseholdRequest]) (*connect.Response[households_v1.LeaveHouseholdResponse], error) {
+	return c.leaveHousehold.CallUnary(ctx, req)
+}
+
+// RemoveMember calls households.v1.HouseholdsService.RemoveMember.
+func (c *householdsServiceClient) RemoveMember(ctx context.Context, req *connect.Request[households_v1.RemoveMemberRequest]) (*connect.Response[households_v1.RemoveMemberResponse], error) {
+	return c.removeMember.CallUnary(ctx, req)
+}
+
+// UpdateMemberRole calls households.v1.HouseholdsService.UpdateMemberRole.
+func (c *householdsServiceClient) UpdateMemberRole(ctx context.Context, req *connect.Request[households_v1.UpdateMemberRoleRequest]) (*connect.Response[households_v1.UpdateMemberRoleResponse], error) {
+	return c.updateMemberRole.CallUnary(ctx, req)
+}
+
+// HouseholdsServiceHandler is an implementation of the households.v1.HouseholdsService service.
+type HouseholdsServiceHandler interface {
+	CreateHousehold(context.Context, *connect.Request[households_v1.CreateHouseholdRequest]) (*connect.Response[households_v1.CreateHouseholdResponse], error)
+	GetHousehold(context.Context, *connect.Request[households_v1.GetHouseholdRequest]) (*connect.Response[households_v1.GetHouseholdResponse], error)
+	InviteMember(context.Context, *connect.Request[households_v1.InviteMemberRequest]) (*connect.Response[households_v1.InviteMemberResponse], error)
+	ListInvites(context.Context, *connect.Request[households_v1.ListInvitesRequest]) (*connect.Response[households_v1.ListInvitesResponse], error)
+	AcceptInvite(context.Context, *connect.Request[households_v1.AcceptInviteRequest]) (*connect.Response[households_v1.AcceptInviteResponse], error)
+	DeclineInvite(context.Context, *connect.Request[households_v1.DeclineInviteRequest]) (*connect.Response[households_v1.DeclineInviteResponse], error)
+	LeaveHousehold(context.Context, *connect.Request[households_v1.LeaveHouseholdRequest]) (*connect.Response[households_v1.LeaveHouseholdResponse], error)
+	RemoveMember(context.Context, *connect.Request[households_v1.RemoveMemberRequest]) (*connect.Response[households_v1.RemoveMemberResponse], error)
+	UpdateMemberRole(context.Context, *connect.Request[households_v1.UpdateMemberRoleRequest]) (*connect.Response[households_v1.UpdateMemberRoleResponse], error)
+}
+
+// NewHouseholdsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewHouseholdsServiceHandler(svc HouseholdsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	householdsServiceCreateHouseholdHandler := connect.NewUnaryHandler(
+		HouseholdsServiceCreateHouseholdProcedure,
+		svc.CreateHousehold,
+		connect.WithSchema(householdsServiceCreateHouseholdMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceGetHouseholdHandler := connect.NewUnaryHandler(
+		HouseholdsServiceGetHouseholdProcedure,
+		svc.GetHousehold,
+		connect.WithSchema(householdsServiceGetHouseholdMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceInviteMemberHandler := connect.NewUnaryHandler(
+		HouseholdsServiceInviteMemberProcedure,
+		svc.InviteMember,
+		connect.WithSchema(householdsServiceInviteMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceListInvitesHandler := connect.NewUnaryHandler(
+		HouseholdsServiceListInvitesProcedure,
+		svc.ListInvites,
+		connect.WithSchema(householdsServiceListInvitesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceAcceptInviteHandler := connect.NewUnaryHandler(
+		HouseholdsServiceAcceptInviteProcedure,
+		svc.AcceptInvite,
+		connect.WithSchema(householdsServiceAcceptInviteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceDeclineInviteHandler := connect.NewUnaryHandler(
+		HouseholdsServiceDeclineInviteProcedure,
+		svc.DeclineInvite,
+		connect.WithSchema(householdsServiceDeclineInviteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceLeaveHouseholdHandler := connect.NewUnaryHandler(
+		HouseholdsServiceLeaveHouseholdProcedure,
+		svc.LeaveHousehold,
+		connect.WithSchema(householdsServiceLeaveHouseholdMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceRemoveMemberHandler := connect.NewUnaryHandler(
+		HouseholdsServiceRemoveMemberProcedure,
+		svc.RemoveMember,
+		connect.WithSchema(householdsServiceRemoveMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	householdsServiceUpdateMemberRoleHandler := connect.NewUnaryHandler(
+		HouseholdsServiceUpdateMemberRoleProcedure,
+		svc.UpdateMemberRole,
+		connect.WithSchema(householdsServiceUpdateMemberRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/households.v1.HouseholdsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case HouseholdsServiceCreateHouseholdProcedure:
+			householdsServiceCreateHouseholdHandler.ServeHTTP(w, r)
+		case HouseholdsServiceGetHouseholdProcedure:
+			householdsServiceGetHouseholdHandler.ServeHTTP(w, r)
+		case HouseholdsServiceInviteMemberProcedure:
+			householdsServiceInviteMemberHandler.ServeHTTP(w, r)
+		case HouseholdsServiceListInvitesProcedure:
+			householdsServiceListInvitesHandler.ServeHTTP(w, r)
+		case HouseholdsServiceAcceptInviteProcedure:
+			householdsServiceAcceptInviteHandler.ServeHTTP(w, r)
+		case HouseholdsServiceDeclineInviteProcedure:
+			householdsServiceDeclineInviteHandler.ServeHTTP(w, r)
+		case HouseholdsServiceLeaveHouseholdProcedure:
+			householdsServiceLeaveHouseholdHandler.ServeHTTP(w, r)
+		case HouseholdsServiceRemoveMemberProcedure:
+			householdsServiceRemoveMemberHandler.ServeHTTP(w, r)
+		case HouseholdsServiceUpdateMemberRoleProcedure:
+			householdsServiceUpdateMemberRoleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedHouseholdsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedHouseholdsServiceHandler struct{}
+
+func (UnimplementedHouseholdsServiceHandler) CreateHousehold(context.Context, *connect.Request[households_v1.CreateHouseholdRequest]) (*connect.Response[households_v1.CreateHouseholdResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.CreateHousehold is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) GetHousehold(context.Context, *connect.Request[households_v1.GetHouseholdRequest]) (*connect.Response[households_v1.GetHouseholdResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.GetHousehold is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) InviteMember(context.Context, *connect.Request[households_v1.InviteMemberRequest]) (*connect.Response[households_v1.InviteMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.InviteMember is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) ListInvites(context.Context, *connect.Request[households_v1.ListInvitesRequest]) (*connect.Response[households_v1.ListInvitesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.ListInvites is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) AcceptInvite(context.Context, *connect.Request[households_v1.AcceptInviteRequest]) (*connect.Response[households_v1.AcceptInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.AcceptInvite is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) DeclineInvite(context.Context, *connect.Request[households_v1.DeclineInviteRequest]) (*connect.Response[households_v1.DeclineInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.DeclineInvite is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) LeaveHousehold(context.Context, *connect.Request[households_v1.LeaveHouseholdRequest]) (*connect.Response[households_v1.LeaveHouseholdResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.LeaveHousehold is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) RemoveMember(context.Context, *connect.Request[households_v1.RemoveMemberRequest]) (*connect.Response[households_v1.RemoveMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.RemoveMember is not implemented"))
+}
+
+func (UnimplementedHouseholdsServiceHandler) UpdateMemberRole(context.Context, *connect.Request[households_v1.UpdateMemberRoleRequest]) (*connect.Response[households_v1.UpdateMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("households.v1.HouseholdsService.UpdateMemberRole is not implemented"))
+}
@@ -21,6 +21,289 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DuplicatePolicy int32
+
+const (
+	// Defaults to flagging them.
+	DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED DuplicatePolicy = 0
+	// Create them but point them to the receipt they're a duplicate of.
+	DuplicatePolicy_DUPLICATE_POLICY_FLAG DuplicatePolicy = 1
+	// Don't create them.
+	DuplicatePolicy_DUPLICATE_POLICY_REJECT DuplicatePolicy = 2
+)
+
+// Enum value maps for DuplicatePolicy.
+var (
+	DuplicatePolicy_name = map[int32]string{
+		0: "DUPLICATE_POLICY_UNSPECIFIED",
+		1: "DUPLICATE_POLICY_FLAG",
+		2: "DUPLICATE_POLICY_REJECT",
+	}
+	DuplicatePolicy_value = map[string]int32{
+		"DUPLICATE_POLICY_UNSPECIFIED": 0,
+		"DUPLICATE_POLICY_FLAG":        1,
+		"DUPLICATE_POLICY_REJECT":      2,
+	}
+)
+
+func (x DuplicatePolicy) Enum() *DuplicatePolicy {
+	p := new(DuplicatePolicy)
+	*p = x
+	return p
+}
+
+func (x DuplicatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_receipts_v1_receipts_proto_enumTypes[0].Descriptor()
+}
+
+func (DuplicatePolicy) Type() protoreflect.EnumType {
+	return &file_receipts_v1_receipts_proto_enumTypes[0]
+}
+
+func (x DuplicatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicatePolicy.Descriptor instead.
+func (DuplicatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{0}
+}
+
+type DuplicateReason int32
+
+const (
+	DuplicateReason_DUPLICATE_REASON_UNSPECIFIED DuplicateReason = 0
+	// The exact same file.
+	DuplicateReason_DUPLICATE_REASON_SAME_FILE DuplicateReason = 1
+	// A picture which looks like the existing one.
+	DuplicateReason_DUPLICATE_REASON_SIMILAR_IMAGE DuplicateReason = 2
+	// The same vendor, date and amount.
+	DuplicateReason_DUPLICATE_REASON_SAME_DETAILS DuplicateReason = 3
+)
+
+// Enum value maps for DuplicateReason.
+var (
+	DuplicateReason_name = map[int32]string{
+		0: "DUPLICATE_REASON_UNSPECIFIED",
+		1: "DUPLICATE_REASON_SAME_FILE",
+		2: "DUPLICATE_REASON_SIMILAR_IMAGE",
+		3: "DUPLICATE_REASON_SAME_DETAILS",
+	}
+	DuplicateReason_value = map[string]int32{
+		"DUPLICATE_REASON_UNSPECIFIED":   0,
+		"DUPLICATE_REASON_SAME_FILE":     1,
+		"DUPLICATE_REASON_SIMILAR_IMAGE": 2,
+		"DUPLICATE_REASON_SAME_DETAILS":  3,
+	}
+)
+
+func (x DuplicateReason) Enum() *DuplicateReason {
+	p := new(DuplicateReason)
+	*p = x
+	return p
+}
+
+func (x DuplicateReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_receipts_v1_receipts_proto_enumTypes[1].Descriptor()
+}
+
+func (DuplicateReason) Type() protoreflect.EnumType {
+	return &file_receipts_v1_receipts_proto_enumTypes[1]
+}
+
+func (x DuplicateReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicateReason.Descriptor instead.
+func (DuplicateReason) EnumDescriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{1}
+}
+
+type ReceiptSplit int32
+
+const (
+	ReceiptSplit_RECEIPT_SPLIT_UNSPECIFIED  ReceiptSplit = 0
+	ReceiptSplit_RECEIPT_SPLIT_NONE         ReceiptSplit = 1
+	ReceiptSplit_RECEIPT_SPLIT_PER_ITEM     ReceiptSplit = 2
+	ReceiptSplit_RECEIPT_SPLIT_PER_CATEGORY ReceiptSplit = 3
+)
+
+// Enum value maps for ReceiptSplit.
+var (
+	ReceiptSplit_name = map[int32]string{
+		0: "RECEIPT_SPLIT_UNSPECIFIED",
+		1: "RECEIPT_SPLIT_NONE",
+		2: "RECEIPT_SPLIT_PER_ITEM",
+		3: "RECEIPT_SPLIT_PER_CATEGORY",
+	}
+	ReceiptSplit_value = map[string]int32{
+		"RECEIPT_SPLIT_UNSPECIFIED":  0,
+		"RECEIPT_SPLIT_NONE":         1,
+		"RECEIPT_SPLIT_PER_ITEM":     2,
+		"RECEIPT_SPLIT_PER_CATEGORY": 3,
+	}
+)
+
+func (x ReceiptSplit) Enum() *ReceiptSplit {
+	p := new(ReceiptSplit)
+	*p = x
+	return p
+}
+
+func (x ReceiptSplit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceiptSplit) Descriptor() protoreflect.EnumDescriptor {
+	return file_receipts_v1_receipts_proto_enumTypes[2].Descriptor()
+}
+
+func (ReceiptSplit) Type() protoreflect.EnumType {
+	return &file_receipts_v1_receipts_proto_enumTypes[2]
+}
+
+func (x ReceiptSplit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceiptSplit.Descriptor instead.
+func (ReceiptSplit) EnumDescriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{2}
+}
+
+type FileErrorReason int32
+
+const (
+	FileErrorReason_FILE_ERROR_REASON_UNSPECIFIED FileErrorReason = 0
+	// Not a PDF nor an image.
+	FileErrorReason_FILE_ERROR_REASON_UNSUPPORTED_TYPE FileErrorReason = 1
+	FileErrorReason_FILE_ERROR_REASON_TOO_LARGE        FileErrorReason = 2
+	// The file doesn't match its declared size or checksum, usually because
+	// the upload was cut short.
+	FileErrorReason_FILE_ERROR_REASON_CORRUPTED FileErrorReason = 3
+	// The file is of a supported type but can't be decoded, so the parser
+	// wouldn't read it either.
+	FileErrorReason_FILE_ERROR_REASON_UNREADABLE FileErrorReason = 4
+	// The file looks like an existing receipt and duplicates are rejected.
+	FileErrorReason_FILE_ERROR_REASON_DUPLICATE FileErrorReason = 5
+	// Storing the receipt failed.
+	FileErrorReason_FILE_ERROR_REASON_INTERNAL FileErrorReason = 6
+)
+
+// Enum value maps for FileErrorReason.
+var (
+	FileErrorReason_name = map[int32]string{
+		0: "FILE_ERROR_REASON_UNSPECIFIED",
+		1: "FILE_ERROR_REASON_UNSUPPORTED_TYPE",
+		2: "FILE_ERROR_REASON_TOO_LARGE",
+		3: "FILE_ERROR_REASON_CORRUPTED",
+		4: "FILE_ERROR_REASON_UNREADABLE",
+		5: "FILE_ERROR_REASON_DUPLICATE",
+		6: "FILE_ERROR_REASON_INTERNAL",
+	}
+	FileErrorReason_value = map[string]int32{
+		"FILE_ERROR_REASON_UNSPECIFIED":      0,
+		"FILE_ERROR_REASON_UNSUPPORTED_TYPE": 1,
+		"FILE_ERROR_REASON_TOO_LARGE":        2,
+		"FILE_ERROR_REASON_CORRUPTED":        3,
+		"FILE_ERROR_REASON_UNREADABLE":       4,
+		"FILE_ERROR_REASON_DUPLICATE":        5,
+		"FILE_ERROR_REASON_INTERNAL":         6,
+	}
+)
+
+func (x FileErrorReason) Enum() *FileErrorReason {
+	p := new(FileErrorReason)
+	*p = x
+	return p
+}
+
+func (x FileErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_receipts_v1_receipts_proto_enumTypes[3].Descriptor()
+}
+
+func (FileErrorReason) Type() protoreflect.EnumType {
+	return &file_receipts_v1_receipts_proto_enumTypes[3]
+}
+
+func (x FileErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileErrorReason.Descriptor instead.
+func (FileErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{3}
+}
+
+type ListReceiptsOrder int32
+
+const (
+	// Defaults to the most recent first.
+	ListReceiptsOrder_LIST_RECEIPTS_ORDER_UNSPECIFIED ListReceiptsOrder = 0
+	ListReceiptsOrder_LIST_RECEIPTS_ORDER_DATE_DESC   ListReceiptsOrder = 1
+	ListReceiptsOrder_LIST_RECEIPTS_ORDER_DATE_ASC    ListReceiptsOrder = 2
+	ListReceiptsOrder_LIST_RECEIPTS_ORDER_AMOUNT_DESC ListReceiptsOrder = 3
+	ListReceiptsOrder_LIST_RECEIPTS_ORDER_AMOUNT_ASC  ListReceiptsOrder = 4
+)
+
+// Enum value maps for ListReceiptsOrder.
+var (
+	ListReceiptsOrder_name = map[int32]string{
+		0: "LIST_RECEIPTS_ORDER_UNSPECIFIED",
+		1: "LIST_RECEIPTS_ORDER_DATE_DESC",
+		2: "LIST_RECEIPTS_ORDER_DATE_ASC",
+		3: "LIST_RECEIPTS_ORDER_AMOUNT_DESC",
+		4: "LIST_RECEIPTS_ORDER_AMOUNT_ASC",
+	}
+	ListReceiptsOrder_value = map[string]int32{
+		"LIST_RECEIPTS_ORDER_UNSPECIFIED": 0,
+		"LIST_RECEIPTS_ORDER_DATE_DESC":   1,
+		"LIST_RECEIPTS_ORDER_DATE_ASC":    2,
+		"LIST_RECEIPTS_ORDER_AMOUNT_DESC": 3,
+		"LIST_RECEIPTS_ORDER_AMOUNT_ASC":  4,
+	}
+)
+
+func (x ListReceiptsOrder) Enum() *ListReceiptsOrder {
+	p := new(ListReceiptsOrder)
+	*p = x
+	return p
+}
+
+func (x ListReceiptsOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListReceiptsOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_receipts_v1_receipts_proto_enumTypes[4].Descriptor()
+}
+
+func (ListReceiptsOrder) Type() protoreflect.EnumType {
+	return &file_receipts_v1_receipts_proto_enumTypes[4]
+}
+
+func (x ListReceiptsOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListReceiptsOrder.Descriptor instead.
+func (ListReceiptsOrder) EnumDescriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{4}
+}
+
 type ListReceiptsSince int32
 
 const (
@@ -57,11 +340,11 @@ func (x ListReceiptsSince) String() string {
 }
 
 func (ListReceiptsSince) Descriptor() protoreflect.EnumDescriptor {
-	return file_receipts_v1_receipts_proto_enumTypes[0].Descriptor()
+	return file_receipts_v1_receipts_proto_enumTypes[5].Descriptor()
 }
 
 func (ListReceiptsSince) Type() protoreflect.EnumType {
-	return &file_receipts_v1_receipts_proto_enumTypes[0]
+	return &file_receipts_v1_receipts_proto_enumTypes[5]
 }
 
 func (x ListReceiptsSince) Number() protoreflect.EnumNumber {
@@ -70,15 +353,26 @@ func (x ListReceiptsSince) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListReceiptsSince.Descriptor instead.
 func (ListReceiptsSince) EnumDescriptor() ([]byte, []int) {
-	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{0}
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{5}
 }
 
+// The lifecycle of a receipt: uploaded, parsing, pending review and reviewed.
+// Receipts which can't be parsed end up failed, and any of them can be
+// archived.
 type ReceiptStatus int32
 
 const (
-	ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED    ReceiptStatus = 0
+	ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED ReceiptStatus = 0
+	// The receipt has been parsed and its expenses have to be checked.
 	ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW ReceiptStatus = 1
 	ReceiptStatus_RECEIPT_STATUS_REVIEWED       ReceiptStatus = 2
+	// The receipt is waiting to be parsed in the background.
+	ReceiptStatus_RECEIPT_STATUS_UPLOADED ReceiptStatus = 3
+	// The receipt couldn't be parsed, so its details have to be filled in by
+	// hand.
+	ReceiptStatus_RECEIPT_STATUS_FAILED   ReceiptStatus = 4
+	ReceiptStatus_RECEIPT_STATUS_PARSING  ReceiptStatus = 5
+	ReceiptStatus_RECEIPT_STATUS_ARCHIVED ReceiptStatus = 6
 )
 
 // Enum value maps for ReceiptStatus.
@@ -87,11 +381,19 @@ var (
 		0: "RECEIPT_STATUS_UNSPECIFIED",
 		1: "RECEIPT_STATUS_PENDING_REVIEW",
 		2: "RECEIPT_STATUS_REVIEWED",
+		3: "RECEIPT_STATUS_UPLOADED",
+		4: "RECEIPT_STATUS_FAILED",
+		5: "RECEIPT_STATUS_PARSING",
+		6: "RECEIPT_STATUS_ARCHIVED",
 	}
 	ReceiptStatus_value = map[string]int32{
 		"RECEIPT_STATUS_UNSPECIFIED":    0,
 		"RECEIPT_STATUS_PENDING_REVIEW": 1,
 		"RECEIPT_STATUS_REVIEWED":       2,
+		"RECEIPT_STATUS_UPLOADED":       3,
+		"RECEIPT_STATUS_FAILED":         4,
+		"RECEIPT_STATUS_PARSING":        5,
+		"RECEIPT_STATUS_ARCHIVED":       6,
 	}
 )
 
@@ -106,11 +408,11 @@ func (x ReceiptStatus) String() string {
 }
 
 func (ReceiptStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_receipts_v1_receipts_proto_enumTypes[1].Descriptor()
+	return file_receipts_v1_receipts_proto_enumTypes[6].Descriptor()
 }
 
 func (ReceiptStatus) Type() protoreflect.EnumType {
-	return &file_receipts_v1_receipts_proto_enumTypes[1]
+	return &file_receipts_v1_receipts_proto_enumTypes[6]
 }
 
 func (x ReceiptStatus) Number() protoreflect.EnumNumber {
@@ -119,15 +421,131 @@ func (x ReceiptStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReceiptStatus.Descriptor instead.
 func (ReceiptStatus) EnumDescriptor() ([]byte, []int) {
-	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{1}
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{6}
+}
+
+type ParserBackend int32
+
+const (
+	ParserBackend_PARSER_BACKEND_UNSPECIFIED ParserBackend = 0
+	ParserBackend_PARSER_BACKEND_VISION      ParserBackend = 1
+	ParserBackend_PARSER_BACKEND_TEXTRACT    ParserBackend = 2
+	ParserBackend_PARSER_BACKEND_PDF_TEXT    ParserBackend = 3
+)
+
+// Enum value maps for ParserBackend.
+var (
+	ParserBackend_name = map[int32]string{
+		0: "PARSER_BACKEND_UNSPECIFIED",
+		1: "PARSER_BACKEND_VISION",
+		2: "PARSER_BACKEND_TEXTRACT",
+		3: "PARSER_BACKEND_PDF_TEXT",
+	}
+	ParserBackend_value = map[string]int32{
+		"PARSER_BACKEND_UNSPECIFIED": 0,
+		"PARSER_BACKEND_VISION":      1,
+		"PARSER_BACKEND_TEXTRACT":    2,
+		"PARSER_BACKEND_PDF_TEXT":    3,
+	}
+)
+
+func (x ParserBackend) Enum() *ParserBackend {
+	p := new(ParserBackend)
+	*p = x
+	return p
+}
+
+func (x ParserBackend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParserBackend) Descriptor() protoreflect.EnumDescriptor {
+	return file_receipts_v1_receipts_proto_enumTypes[7].Descriptor()
+}
+
+func (ParserBackend) Type() protoreflect.EnumType {
+	return &file_receipts_v1_receipts_proto_enumTypes[7]
+}
+
+func (x ParserBackend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParserBackend.Descriptor instead.
+func (ParserBackend) EnumDescriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{7}
+}
+
+type ReceiptField int32
+
+const (
+	ReceiptField_RECEIPT_FIELD_UNSPECIFIED ReceiptField = 0
+	ReceiptField_RECEIPT_FIELD_VENDOR      ReceiptField = 1
+	ReceiptField_RECEIPT_FIELD_DATE        ReceiptField = 2
+	ReceiptField_RECEIPT_FIELD_AMOUNT      ReceiptField = 3
+	ReceiptField_RECEIPT_FIELD_DESCRIPTION ReceiptField = 4
+)
+
+// Enum value maps for ReceiptField.
+var (
+	ReceiptField_name = map[int32]string{
+		0: "RECEIPT_FIELD_UNSPECIFIED",
+		1: "RECEIPT_FIELD_VENDOR",
+		2: "RECEIPT_FIELD_DATE",
+		3: "RECEIPT_FIELD_AMOUNT",
+		4: "RECEIPT_FIELD_DESCRIPTION",
+	}
+	ReceiptField_value = map[string]int32{
+		"RECEIPT_FIELD_UNSPECIFIED": 0,
+		"RECEIPT_FIELD_VENDOR":      1,
+		"RECEIPT_FIELD_DATE":        2,
+		"RECEIPT_FIELD_AMOUNT":      3,
+		"RECEIPT_FIELD_DESCRIPTION": 4,
+	}
+)
+
+func (x ReceiptField) Enum() *ReceiptField {
+	p := new(ReceiptField)
+	*p = x
+	return p
+}
+
+func (x ReceiptField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceiptField) Descriptor() protoreflect.EnumDescriptor {
+	return file_receipts_v1_receipts_proto_enumTypes[8].Descriptor()
+}
+
+func (ReceiptField) Type() protoreflect.EnumType {
+	return &file_receipts_v1_receipts_proto_enumTypes[8]
+}
+
+func (x ReceiptField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceiptField.Descriptor instead.
+func (ReceiptField) EnumDescriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{8}
 }
 
+// Receipts are created straight away with the uploaded status and parsed in
+// the background. Only duplicates of an existing file or image are rejected in
+// the response: those with the same details are only spotted once parsed, and
+// deleted then. Files must be PDFs, images or the text of an e-receipt.
 type CreateReceiptsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ReceiptFiles [][]byte `protobuf:"bytes,1,rep,name=receipt_files,json=receiptFiles,proto3" json:"receipt_files,omitempty"`
+	// How the amount of each receipt is turned into expenses. Splitting only
+	// happens when the line items add up to the total of the receipt.
+	Split ReceiptSplit `protobuf:"varint,2,opt,name=split,proto3,enum=receipts.v1.ReceiptSplit" json:"split,omitempty"`
+	// What to do with receipts which look like one the user already has.
+	DuplicatePolicy DuplicatePolicy `protobuf:"varint,3,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=receipts.v1.DuplicatePolicy" json:"duplicate_policy,omitempty"`
 }
 
 func (x *CreateReceiptsRequest) Reset() {
@@ -169,16 +587,36 @@ func (x *CreateReceiptsRequest) GetReceiptFiles() [][]byte {
 	return nil
 }
 
-type CreateReceiptsResponse struct {
+func (x *CreateReceiptsRequest) GetSplit() ReceiptSplit {
+	if x != nil {
+		return x.Split
+	}
+	return ReceiptSplit_RECEIPT_SPLIT_UNSPECIFIED
+}
+
+func (x *CreateReceiptsRequest) GetDuplicatePolicy() DuplicatePolicy {
+	if x != nil {
+		return x.DuplicatePolicy
+	}
+	return DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED
+}
+
+// The options, if any, are sent first. Then each file is sent as its header
+// followed by its chunks.
+type UploadReceiptsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadReceiptsRequest_Options
+	//	*UploadReceiptsRequest_File
+	//	*UploadReceiptsRequest_Chunk
+	Payload isUploadReceiptsRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *CreateReceiptsResponse) Reset() {
-	*x = CreateReceiptsResponse{}
+func (x *UploadReceiptsRequest) Reset() {
+	*x = UploadReceiptsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -186,13 +624,13 @@ func (x *CreateReceiptsResponse) Reset() {
 	}
 }
 
-func (x *CreateReceiptsResponse) String() string {
+func (x *UploadReceiptsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReceiptsResponse) ProtoMessage() {}
+func (*UploadReceiptsRequest) ProtoMessage() {}
 
-func (x *CreateReceiptsResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadReceiptsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,31 +642,72 @@ func (x *CreateReceiptsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReceiptsResponse.ProtoReflect.Descriptor instead.
-func (*CreateReceiptsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*UploadReceiptsRequest) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateReceiptsResponse) GetReceipts() []*Receipt {
-	if x != nil {
-		return x.Receipts
+func (m *UploadReceiptsRequest) GetPayload() isUploadReceiptsRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-type UpdateReceiptRequest struct {
+func (x *UploadReceiptsRequest) GetOptions() *UploadOptions {
+	if x, ok := x.GetPayload().(*UploadReceiptsRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UploadReceiptsRequest) GetFile() *UploadedFile {
+	if x, ok := x.GetPayload().(*UploadReceiptsRequest_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *UploadReceiptsRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadReceiptsRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadReceiptsRequest_Payload interface {
+	isUploadReceiptsRequest_Payload()
+}
+
+type UploadReceiptsRequest_Options struct {
+	Options *UploadOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type UploadReceiptsRequest_File struct {
+	File *UploadedFile `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+type UploadReceiptsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadReceiptsRequest_Options) isUploadReceiptsRequest_Payload() {}
+
+func (*UploadReceiptsRequest_File) isUploadReceiptsRequest_Payload() {}
+
+func (*UploadReceiptsRequest_Chunk) isUploadReceiptsRequest_Payload() {}
+
+type UploadOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Vendor        *string                `protobuf:"bytes,2,opt,name=vendor,proto3,oneof" json:"vendor,omitempty"`
-	PendingReview *bool                  `protobuf:"varint,3,opt,name=pending_review,json=pendingReview,proto3,oneof" json:"pending_review,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	Split           ReceiptSplit    `protobuf:"varint,1,opt,name=split,proto3,enum=receipts.v1.ReceiptSplit" json:"split,omitempty"`
+	DuplicatePolicy DuplicatePolicy `protobuf:"varint,2,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=receipts.v1.DuplicatePolicy" json:"duplicate_policy,omitempty"`
 }
 
-func (x *UpdateReceiptRequest) Reset() {
-	*x = UpdateReceiptRequest{}
+func (x *UploadOptions) Reset() {
+	*x = UploadOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -236,13 +715,13 @@ func (x *UpdateReceiptRequest) Reset() {
 	}
 }
 
-func (x *UpdateReceiptRequest) String() string {
+func (x *UploadOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReceiptRequest) ProtoMessage() {}
+func (*UploadOptions) ProtoMessage() {}
 
-func (x *UpdateReceiptRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,47 +733,43 @@ func (x *UpdateReceiptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReceiptRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReceiptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadOptions.ProtoReflect.Descriptor instead.
+func (*UploadOptions) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UpdateReceiptRequest) GetId() uint64 {
+func (x *UploadOptions) GetSplit() ReceiptSplit {
 	if x != nil {
-		return x.Id
+		return x.Split
 	}
-	return 0
+	return ReceiptSplit_RECEIPT_SPLIT_UNSPECIFIED
 }
 
-func (x *UpdateReceiptRequest) GetVendor() string {
-	if x != nil && x.Vendor != nil {
-		return *x.Vendor
+func (x *UploadOptions) GetDuplicatePolicy() DuplicatePolicy {
+	if x != nil {
+		return x.DuplicatePolicy
 	}
-	return ""
+	return DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED
 }
 
-func (x *UpdateReceiptRequest) GetPendingReview() bool {
-	if x != nil && x.PendingReview != nil {
-		return *x.PendingReview
-	}
-	return false
-}
-
-func (x *UpdateReceiptRequest) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-type UpdateReceiptResponse struct {
+// The chunks of the file must add up to its size and checksum, and its
+// contents must be of the declared MIME type. Only PDFs and JPEG, PNG, GIF
+// and WebP images are accepted, up to 10 MiB each and 50 MiB per upload.
+type UploadedFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Only used to report errors.
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size     uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The hex encoded SHA-256 of the file.
+	Sha256 string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
 }
 
-func (x *UpdateReceiptResponse) Reset() {
-	*x = UpdateReceiptResponse{}
+func (x *UploadedFile) Reset() {
+	*x = UploadedFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -302,13 +777,13 @@ func (x *UpdateReceiptResponse) Reset() {
 	}
 }
 
-func (x *UpdateReceiptResponse) String() string {
+func (x *UploadedFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReceiptResponse) ProtoMessage() {}
+func (*UploadedFile) ProtoMessage() {}
 
-func (x *UpdateReceiptResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadedFile) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -320,21 +795,51 @@ func (x *UpdateReceiptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReceiptResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReceiptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadedFile.ProtoReflect.Descriptor instead.
+func (*UploadedFile) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{3}
 }
 
-type DeleteReceiptRequest struct {
+func (x *UploadedFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadedFile) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *UploadedFile) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadedFile) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type Duplicate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The existing receipt which matched.
+	ReceiptId uint64          `protobuf:"varint,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	Reason    DuplicateReason `protobuf:"varint,2,opt,name=reason,proto3,enum=receipts.v1.DuplicateReason" json:"reason,omitempty"`
 }
 
-func (x *DeleteReceiptRequest) Reset() {
-	*x = DeleteReceiptRequest{}
+func (x *Duplicate) Reset() {
+	*x = Duplicate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -342,13 +847,13 @@ func (x *DeleteReceiptRequest) Reset() {
 	}
 }
 
-func (x *DeleteReceiptRequest) String() string {
+func (x *Duplicate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReceiptRequest) ProtoMessage() {}
+func (*Duplicate) ProtoMessage() {}
 
-func (x *DeleteReceiptRequest) ProtoReflect() protoreflect.Message {
+func (x *Duplicate) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -360,26 +865,42 @@ func (x *DeleteReceiptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReceiptRequest.ProtoReflect.Descriptor instead.
-func (*DeleteReceiptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Duplicate.ProtoReflect.Descriptor instead.
+func (*Duplicate) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteReceiptRequest) GetId() uint64 {
+func (x *Duplicate) GetReceiptId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ReceiptId
 	}
 	return 0
 }
 
-type DeleteReceiptResponse struct {
+func (x *Duplicate) GetReason() DuplicateReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DuplicateReason_DUPLICATE_REASON_UNSPECIFIED
+}
+
+// Each file succeeds or fails on its own: a file which can't be stored
+// doesn't stop the rest from being created.
+type CreateReceiptsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The receipts created, also found in results.
+	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// Superseded by results, which also include the duplicates rejected.
+	Rejected []*RejectedReceipt `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	// One for each file, in the order they were sent.
+	Results []*FileResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *DeleteReceiptResponse) Reset() {
-	*x = DeleteReceiptResponse{}
+func (x *CreateReceiptsResponse) Reset() {
+	*x = CreateReceiptsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,13 +908,13 @@ func (x *DeleteReceiptResponse) Reset() {
 	}
 }
 
-func (x *DeleteReceiptResponse) String() string {
+func (x *CreateReceiptsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReceiptResponse) ProtoMessage() {}
+func (*CreateReceiptsResponse) ProtoMessage() {}
 
-func (x *DeleteReceiptResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateReceiptsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,22 +926,49 @@ func (x *DeleteReceiptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReceiptResponse.ProtoReflect.Descriptor instead.
-func (*DeleteReceiptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*CreateReceiptsResponse) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{5}
 }
 
-type ListReceiptsRequest struct {
+func (x *CreateReceiptsResponse) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *CreateReceiptsResponse) GetRejected() []*RejectedReceipt {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *CreateReceiptsResponse) GetResults() []*FileResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type FileResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Since  ListReceiptsSince `protobuf:"varint,1,opt,name=since,proto3,enum=receipts.v1.ListReceiptsSince" json:"since,omitempty"`
-	Status ReceiptStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=receipts.v1.ReceiptStatus" json:"status,omitempty"`
+	// The position of the file in the request.
+	FileIndex uint32 `protobuf:"varint,1,opt,name=file_index,json=fileIndex,proto3" json:"file_index,omitempty"`
+	// The name of the file, for uploads which send it.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Result:
+	//	*FileResult_Receipt
+	//	*FileResult_Error
+	Result isFileResult_Result `protobuf_oneof:"result"`
 }
 
-func (x *ListReceiptsRequest) Reset() {
-	*x = ListReceiptsRequest{}
+func (x *FileResult) Reset() {
+	*x = FileResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,13 +976,13 @@ func (x *ListReceiptsRequest) Reset() {
 	}
 }
 
-func (x *ListReceiptsRequest) String() string {
+func (x *FileResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReceiptsRequest) ProtoMessage() {}
+func (*FileResult) ProtoMessage() {}
 
-func (x *ListReceiptsRequest) ProtoReflect() protoreflect.Message {
+func (x *FileResult) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -446,39 +994,80 @@ func (x *ListReceiptsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReceiptsRequest.ProtoReflect.Descriptor instead.
-func (*ListReceiptsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileResult.ProtoReflect.Descriptor instead.
+func (*FileResult) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListReceiptsRequest) GetSince() ListReceiptsSince {
+func (x *FileResult) GetFileIndex() uint32 {
 	if x != nil {
-		return x.Since
+		return x.FileIndex
 	}
-	return ListReceiptsSince_LIST_RECEIPTS_SINCE_UNSPECIFIED
+	return 0
 }
 
-func (x *ListReceiptsRequest) GetStatus() ReceiptStatus {
+func (x *FileResult) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+	return ""
 }
 
-type Receipt struct {
+func (m *FileResult) GetResult() isFileResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *FileResult) GetReceipt() *Receipt {
+	if x, ok := x.GetResult().(*FileResult_Receipt); ok {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *FileResult) GetError() *FileError {
+	if x, ok := x.GetResult().(*FileResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isFileResult_Result interface {
+	isFileResult_Result()
+}
+
+type FileResult_Receipt struct {
+	Receipt *Receipt `protobuf:"bytes,3,opt,name=receipt,proto3,oneof"`
+}
+
+type FileResult_Error struct {
+	Error *FileError `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+func (*FileResult_Receipt) isFileResult_Result() {}
+
+func (*FileResult_Error) isFileResult_Result() {}
+
+// Parsing happens after the receipts are created, so files which can't be
+// read only fail here when they can't even be decoded. Otherwise the receipt
+// ends up with the failed status.
+type FileError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status   ReceiptStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=receipts.v1.ReceiptStatus" json:"status,omitempty"`
-	Vendor   string                 `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor,omitempty"`
-	Date     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	Expenses []*Expense             `protobuf:"bytes,5,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Reason  FileErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=receipts.v1.FileErrorReason" json:"reason,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Set when the reason is a duplicate.
+	DuplicateOf *Duplicate `protobuf:"bytes,3,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	// Whether sending the same file again may work.
+	Retryable bool `protobuf:"varint,4,opt,name=retryable,proto3" json:"retryable,omitempty"`
 }
 
-func (x *Receipt) Reset() {
-	*x = Receipt{}
+func (x *FileError) Reset() {
+	*x = FileError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,13 +1075,13 @@ func (x *Receipt) Reset() {
 	}
 }
 
-func (x *Receipt) String() string {
+func (x *FileError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Receipt) ProtoMessage() {}
+func (*FileError) ProtoMessage() {}
 
-func (x *Receipt) ProtoReflect() protoreflect.Message {
+func (x *FileError) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,61 +1093,51 @@ func (x *Receipt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
-func (*Receipt) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileError.ProtoReflect.Descriptor instead.
+func (*FileError) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Receipt) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Receipt) GetStatus() ReceiptStatus {
+func (x *FileError) GetReason() FileErrorReason {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
-	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+	return FileErrorReason_FILE_ERROR_REASON_UNSPECIFIED
 }
 
-func (x *Receipt) GetVendor() string {
+func (x *FileError) GetMessage() string {
 	if x != nil {
-		return x.Vendor
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Receipt) GetDate() *timestamppb.Timestamp {
+func (x *FileError) GetDuplicateOf() *Duplicate {
 	if x != nil {
-		return x.Date
+		return x.DuplicateOf
 	}
 	return nil
 }
 
-func (x *Receipt) GetExpenses() []*Expense {
+func (x *FileError) GetRetryable() bool {
 	if x != nil {
-		return x.Expenses
+		return x.Retryable
 	}
-	return nil
+	return false
 }
 
-type Expense struct {
+type RejectedReceipt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Date        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Category    string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Subcategory string                 `protobuf:"bytes,4,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
-	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Amount      uint64                 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The position of the file in the request.
+	FileIndex   uint32     `protobuf:"varint,1,opt,name=file_index,json=fileIndex,proto3" json:"file_index,omitempty"`
+	DuplicateOf *Duplicate `protobuf:"bytes,2,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
 }
 
-func (x *Expense) Reset() {
-	*x = Expense{}
+func (x *RejectedReceipt) Reset() {
+	*x = RejectedReceipt{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,13 +1145,13 @@ func (x *Expense) Reset() {
 	}
 }
 
-func (x *Expense) String() string {
+func (x *RejectedReceipt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Expense) ProtoMessage() {}
+func (*RejectedReceipt) ProtoMessage() {}
 
-func (x *Expense) ProtoReflect() protoreflect.Message {
+func (x *RejectedReceipt) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -584,63 +1163,43 @@ func (x *Expense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
-func (*Expense) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectedReceipt.ProtoReflect.Descriptor instead.
+func (*RejectedReceipt) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Expense) GetId() uint64 {
+func (x *RejectedReceipt) GetFileIndex() uint32 {
 	if x != nil {
-		return x.Id
+		return x.FileIndex
 	}
 	return 0
 }
 
-func (x *Expense) GetDate() *timestamppb.Timestamp {
+func (x *RejectedReceipt) GetDuplicateOf() *Duplicate {
 	if x != nil {
-		return x.Date
+		return x.DuplicateOf
 	}
 	return nil
 }
 
-func (x *Expense) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *Expense) GetSubcategory() string {
-	if x != nil {
-		return x.Subcategory
-	}
-	return ""
-}
-
-func (x *Expense) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Expense) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-type ListReceiptsResponse struct {
+type UpdateReceiptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	Id     uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vendor *string `protobuf:"bytes,2,opt,name=vendor,proto3,oneof" json:"vendor,omitempty"`
+	// Superseded by status: true moves the receipt to pending review and false
+	// to reviewed. Ignored when status is set.
+	PendingReview *bool                  `protobuf:"varint,3,opt,name=pending_review,json=pendingReview,proto3,oneof" json:"pending_review,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	// Moves the receipt to the status. Fails with a failed precondition error
+	// when the receipt can't get there from its current status.
+	Status *ReceiptStatus `protobuf:"varint,5,opt,name=status,proto3,enum=receipts.v1.ReceiptStatus,oneof" json:"status,omitempty"`
 }
 
-func (x *ListReceiptsResponse) Reset() {
-	*x = ListReceiptsResponse{}
+func (x *UpdateReceiptRequest) Reset() {
+	*x = UpdateReceiptRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_receipts_v1_receipts_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -648,13 +1207,13 @@ func (x *ListReceiptsResponse) Reset() {
 	}
 }
 
-func (x *ListReceiptsResponse) String() string {
+func (x *UpdateReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReceiptsResponse) ProtoMessage() {}
+func (*UpdateReceiptRequest) ProtoMessage() {}
 
-func (x *ListReceiptsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateReceiptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,423 +1225,3472 @@ func (x *ListReceiptsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReceiptsResponse.ProtoReflect.Descriptor instead.
-func (*ListReceiptsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateReceiptRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReceiptRequest) Descriptor() ([]byte, []int) {
 	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListReceiptsResponse) GetReceipts() []*Receipt {
+func (x *UpdateReceiptRequest) GetId() uint64 {
 	if x != nil {
-		return x.Receipts
+		return x.Id
 	}
-	return nil
-}
-
-type GetReceiptRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	return 0
 }
 
-func (x *GetReceiptRequest) Reset() {
-	*x = GetReceiptRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_receipts_v1_receipts_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateReceiptRequest) GetVendor() string {
+	if x != nil && x.Vendor != nil {
+		return *x.Vendor
 	}
+	return ""
 }
 
-func (x *GetReceiptRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateReceiptRequest) GetPendingReview() bool {
+	if x != nil && x.PendingReview != nil {
+		return *x.PendingReview
+	}
+	return false
 }
 
-func (*GetReceiptRequest) ProtoMessage() {}
+func (x *UpdateReceiptRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
 
-func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateReceiptRequest) GetStatus() ReceiptStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+}
+
+type UpdateReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateReceiptResponse) Reset() {
+	*x = UpdateReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReceiptResponse) ProtoMessage() {}
+
+func (x *UpdateReceiptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_receipts_v1_receipts_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
-func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
-	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetReceiptRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type GetReceiptResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Receipt *FullReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
-}
-
-func (x *GetReceiptResponse) Reset() {
-	*x = GetReceiptResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_receipts_v1_receipts_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetReceiptResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetReceiptResponse) ProtoMessage() {}
-
-func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_receipts_v1_receipts_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReceiptResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{10}
+}
+
+type DeleteReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteReceiptRequest) Reset() {
+	*x = DeleteReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReceiptRequest) ProtoMessage() {}
+
+func (x *DeleteReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReceiptRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteReceiptRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteReceiptResponse) Reset() {
+	*x = DeleteReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReceiptResponse) ProtoMessage() {}
+
+func (x *DeleteReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReceiptResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{12}
+}
+
+type ListReceiptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Superseded by date_range, which takes precedence when set.
+	Since ListReceiptsSince `protobuf:"varint,1,opt,name=since,proto3,enum=receipts.v1.ListReceiptsSince" json:"since,omitempty"`
+	// Only lists the receipts in the status. Archived receipts are left out
+	// unless they're asked for.
+	Status    ReceiptStatus `protobuf:"varint,2,opt,name=status,proto3,enum=receipts.v1.ReceiptStatus" json:"status,omitempty"`
+	DateRange *DateRange    `protobuf:"bytes,3,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	// Only lists the receipts whose vendor contains it, regardless of case.
+	Vendor string `protobuf:"bytes,4,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// Bounds of the total of the expenses of the receipt, in cents.
+	MinAmount *uint64           `protobuf:"varint,5,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount *uint64           `protobuf:"varint,6,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	Order     ListReceiptsOrder `protobuf:"varint,7,opt,name=order,proto3,enum=receipts.v1.ListReceiptsOrder" json:"order,omitempty"`
+	// Lists every receipt when zero.
+	PageSize uint32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous page, listed with the same order.
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListReceiptsRequest) Reset() {
+	*x = ListReceiptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceiptsRequest) ProtoMessage() {}
+
+func (x *ListReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListReceiptsRequest) GetSince() ListReceiptsSince {
+	if x != nil {
+		return x.Since
+	}
+	return ListReceiptsSince_LIST_RECEIPTS_SINCE_UNSPECIFIED
+}
+
+func (x *ListReceiptsRequest) GetStatus() ReceiptStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+}
+
+func (x *ListReceiptsRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *ListReceiptsRequest) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *ListReceiptsRequest) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListReceiptsRequest) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ListReceiptsRequest) GetOrder() ListReceiptsOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListReceiptsOrder_LIST_RECEIPTS_ORDER_UNSPECIFIED
+}
+
+func (x *ListReceiptsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReceiptsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Either end can be left out. From is inclusive and to exclusive.
+type DateRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DateRange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DateRange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type StatusTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unspecified for the status the receipt was created in.
+	From   ReceiptStatus          `protobuf:"varint,1,opt,name=from,proto3,enum=receipts.v1.ReceiptStatus" json:"from,omitempty"`
+	To     ReceiptStatus          `protobuf:"varint,2,opt,name=to,proto3,enum=receipts.v1.ReceiptStatus" json:"to,omitempty"`
+	Reason string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	At     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransition) ProtoMessage() {}
+
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StatusTransition) GetFrom() ReceiptStatus {
+	if x != nil {
+		return x.From
+	}
+	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+}
+
+func (x *StatusTransition) GetTo() ReceiptStatus {
+	if x != nil {
+		return x.To
+	}
+	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+}
+
+func (x *StatusTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status      ReceiptStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=receipts.v1.ReceiptStatus" json:"status,omitempty"`
+	Vendor      string                 `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Expenses    []*Expense             `protobuf:"bytes,5,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	DuplicateOf *Duplicate             `protobuf:"bytes,6,opt,name=duplicate_of,json=duplicateOf,proto3,oneof" json:"duplicate_of,omitempty"`
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Receipt) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Receipt) GetStatus() ReceiptStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+}
+
+func (x *Receipt) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *Receipt) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Receipt) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *Receipt) GetDuplicateOf() *Duplicate {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return nil
+}
+
+type Expense struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Category    string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Subcategory string                 `protobuf:"bytes,4,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      uint64                 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Expense) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Expense) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Expense) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Expense) GetSubcategory() string {
+	if x != nil {
+		return x.Subcategory
+	}
+	return ""
+}
+
+func (x *Expense) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Expense) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ListReceiptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListReceiptsResponse) Reset() {
+	*x = ListReceiptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReceiptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceiptsResponse) ProtoMessage() {}
+
+func (x *ListReceiptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceiptsResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListReceiptsResponse) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *ListReceiptsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetReceiptRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *FullReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetReceiptResponse) GetReceipt() *FullReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type FullReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status   ReceiptStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=receipts.v1.ReceiptStatus" json:"status,omitempty"`
+	Vendor   string                 `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Date     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	File     []byte                 `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+	Expenses []*Expense             `protobuf:"bytes,6,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Items    []*LineItem            `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	// Whether the line items add up to the total of the receipt.
+	ItemsReconcile bool       `protobuf:"varint,8,opt,name=items_reconcile,json=itemsReconcile,proto3" json:"items_reconcile,omitempty"`
+	DuplicateOf    *Duplicate `protobuf:"bytes,9,opt,name=duplicate_of,json=duplicateOf,proto3,oneof" json:"duplicate_of,omitempty"`
+	// Explains the last change of status, like why the receipt couldn't be
+	// parsed.
+	StatusReason string `protobuf:"bytes,10,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	// Every change of status of the receipt, oldest first.
+	Transitions []*StatusTransition `protobuf:"bytes,11,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	Tax         *ReceiptTax         `protobuf:"bytes,12,opt,name=tax,proto3" json:"tax,omitempty"`
+}
+
+func (x *FullReceipt) Reset() {
+	*x = FullReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FullReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FullReceipt) ProtoMessage() {}
+
+func (x *FullReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FullReceipt.ProtoReflect.Descriptor instead.
+func (*FullReceipt) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FullReceipt) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FullReceipt) GetStatus() ReceiptStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+}
+
+func (x *FullReceipt) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *FullReceipt) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *FullReceipt) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *FullReceipt) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *FullReceipt) GetItems() []*LineItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *FullReceipt) GetItemsReconcile() bool {
+	if x != nil {
+		return x.ItemsReconcile
+	}
+	return false
+}
+
+func (x *FullReceipt) GetDuplicateOf() *Duplicate {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return nil
+}
+
+func (x *FullReceipt) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *FullReceipt) GetTransitions() []*StatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *FullReceipt) GetTax() *ReceiptTax {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+// The taxes of a receipt, for business expenses. Empty when the receipt
+// doesn't break them down. Amounts are in cents.
+type ReceiptTax struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount before taxes.
+	Subtotal      uint64     `protobuf:"varint,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	VendorTaxId   string     `protobuf:"bytes,2,opt,name=vendor_tax_id,json=vendorTaxId,proto3" json:"vendor_tax_id,omitempty"`
+	InvoiceNumber string     `protobuf:"bytes,3,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	Lines         []*TaxLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (x *ReceiptTax) Reset() {
+	*x = ReceiptTax{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptTax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptTax) ProtoMessage() {}
+
+func (x *ReceiptTax) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptTax.ProtoReflect.Descriptor instead.
+func (*ReceiptTax) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReceiptTax) GetSubtotal() uint64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *ReceiptTax) GetVendorTaxId() string {
+	if x != nil {
+		return x.VendorTaxId
+	}
+	return ""
+}
+
+func (x *ReceiptTax) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *ReceiptTax) GetLines() []*TaxLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type TaxLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A percentage, like 21 for 21%.
+	Rate   float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Base   uint64  `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+	Amount uint64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *TaxLine) Reset() {
+	*x = TaxLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaxLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxLine) ProtoMessage() {}
+
+func (x *TaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxLine.ProtoReflect.Descriptor instead.
+func (*TaxLine) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaxLine) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TaxLine) GetBase() uint64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *TaxLine) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type LineItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  float64 `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice uint64  `protobuf:"varint,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Total     uint64  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Category  string  `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *LineItem) Reset() {
+	*x = LineItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineItem) ProtoMessage() {}
+
+func (x *LineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineItem.ProtoReflect.Descriptor instead.
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LineItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LineItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LineItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LineItem) GetUnitPrice() uint64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *LineItem) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LineItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ReparseReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the parser picked for the kind of file.
+	Backend ParserBackend `protobuf:"varint,2,opt,name=backend,proto3,enum=receipts.v1.ParserBackend" json:"backend,omitempty"`
+	// Extra instructions for the parser, on top of the usual ones.
+	Prompt string `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+}
+
+func (x *ReparseReceiptRequest) Reset() {
+	*x = ReparseReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReparseReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReparseReceiptRequest) ProtoMessage() {}
+
+func (x *ReparseReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReparseReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReparseReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReparseReceiptRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReparseReceiptRequest) GetBackend() ParserBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return ParserBackend_PARSER_BACKEND_UNSPECIFIED
+}
+
+func (x *ReparseReceiptRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+// The details of a receipt which the parser reads. The amount and description
+// are those of its expenses: the total of them, and the description when
+// there's a single one.
+type ReceiptDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vendor      string                 `protobuf:"bytes,1,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Amount      uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ReceiptDetails) Reset() {
+	*x = ReceiptDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptDetails) ProtoMessage() {}
+
+func (x *ReceiptDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptDetails.ProtoReflect.Descriptor instead.
+func (*ReceiptDetails) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReceiptDetails) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *ReceiptDetails) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ReceiptDetails) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReceiptDetails) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ReparseReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current  *ReceiptDetails `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Proposed *ReceiptDetails `protobuf:"bytes,2,opt,name=proposed,proto3" json:"proposed,omitempty"`
+	// The fields which differ between the current and the proposed details.
+	Changed []ReceiptField `protobuf:"varint,3,rep,packed,name=changed,proto3,enum=receipts.v1.ReceiptField" json:"changed,omitempty"`
+}
+
+func (x *ReparseReceiptResponse) Reset() {
+	*x = ReparseReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReparseReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReparseReceiptResponse) ProtoMessage() {}
+
+func (x *ReparseReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReparseReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReparseReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReparseReceiptResponse) GetCurrent() *ReceiptDetails {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *ReparseReceiptResponse) GetProposed() *ReceiptDetails {
+	if x != nil {
+		return x.Proposed
+	}
+	return nil
+}
+
+func (x *ReparseReceiptResponse) GetChanged() []ReceiptField {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+type ApplyReceiptChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Usually the proposed details returned by ReparseReceipt.
+	Details *ReceiptDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	// The fields of the details to apply, leaving the rest as they are. The
+	// amount and description can't be changed for receipts which were split
+	// into several expenses.
+	Fields []ReceiptField `protobuf:"varint,3,rep,packed,name=fields,proto3,enum=receipts.v1.ReceiptField" json:"fields,omitempty"`
+}
+
+func (x *ApplyReceiptChangesRequest) Reset() {
+	*x = ApplyReceiptChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyReceiptChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyReceiptChangesRequest) ProtoMessage() {}
+
+func (x *ApplyReceiptChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyReceiptChangesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyReceiptChangesRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApplyReceiptChangesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApplyReceiptChangesRequest) GetDetails() *ReceiptDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ApplyReceiptChangesRequest) GetFields() []ReceiptField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ApplyReceiptChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplyReceiptChangesResponse) Reset() {
+	*x = ApplyReceiptChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyReceiptChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyReceiptChangesResponse) ProtoMessage() {}
+
+func (x *ApplyReceiptChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyReceiptChangesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyReceiptChangesResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{29}
+}
+
+type SearchReceiptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Words to look for. Quoted phrases, "or" and "-" to exclude words are
+	// supported, like in web search engines.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Defaults to 20, and can't be more than 100.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchReceiptsRequest) Reset() {
+	*x = SearchReceiptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReceiptsRequest) ProtoMessage() {}
+
+func (x *SearchReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*SearchReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SearchReceiptsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchReceiptsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchReceiptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchReceiptsResponse) Reset() {
+	*x = SearchReceiptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReceiptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReceiptsResponse) ProtoMessage() {}
+
+func (x *SearchReceiptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*SearchReceiptsResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SearchReceiptsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// The parts of the text of the receipt around the matches, in order.
+	Snippet []*SnippetPart `protobuf:"bytes,2,rep,name=snippet,proto3" json:"snippet,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchResult) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *SearchResult) GetSnippet() []*SnippetPart {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+type SnippetPart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// Whether the text matched the query, to highlight it.
+	Match bool `protobuf:"varint,2,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *SnippetPart) Reset() {
+	*x = SnippetPart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnippetPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnippetPart) ProtoMessage() {}
+
+func (x *SnippetPart) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnippetPart.ProtoReflect.Descriptor instead.
+func (*SnippetPart) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SnippetPart) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SnippetPart) GetMatch() bool {
+	if x != nil {
+		return x.Match
+	}
+	return false
+}
+
+type ListReconciliationProposalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListReconciliationProposalsRequest) Reset() {
+	*x = ListReconciliationProposalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReconciliationProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationProposalsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{34}
+}
+
+type ListReconciliationProposalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Best matches first. Each receipt and transaction is proposed once at most.
+	Proposals []*ReconciliationProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+}
+
+func (x *ListReconciliationProposalsResponse) Reset() {
+	*x = ListReconciliationProposalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReconciliationProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationProposalsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListReconciliationProposalsResponse) GetProposals() []*ReconciliationProposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type ReconciliationProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiptId   uint64                 `protobuf:"varint,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	Vendor      string                 `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	ReceiptDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=receipt_date,json=receiptDate,proto3" json:"receipt_date,omitempty"`
+	// Total of the expenses of the receipt.
+	ReceiptAmount uint64           `protobuf:"varint,4,opt,name=receipt_amount,json=receiptAmount,proto3" json:"receipt_amount,omitempty"`
+	Transaction   *BankTransaction `protobuf:"bytes,5,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// From 0 to 1, the latter being an exact match of amount, date and vendor.
+	Score float64 `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ReconciliationProposal) Reset() {
+	*x = ReconciliationProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconciliationProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationProposal) ProtoMessage() {}
+
+func (x *ReconciliationProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationProposal.ProtoReflect.Descriptor instead.
+func (*ReconciliationProposal) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReconciliationProposal) GetReceiptId() uint64 {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return 0
+}
+
+func (x *ReconciliationProposal) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *ReconciliationProposal) GetReceiptDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceiptDate
+	}
+	return nil
+}
+
+func (x *ReconciliationProposal) GetReceiptAmount() uint64 {
+	if x != nil {
+		return x.ReceiptAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationProposal) GetTransaction() *BankTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *ReconciliationProposal) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// BankTransaction is an expense imported from a bank statement: paid from an
+// account and not attached to any receipt.
+type BankTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId   uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Amount      uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Category    string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Subcategory string                 `protobuf:"bytes,7,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+}
+
+func (x *BankTransaction) Reset() {
+	*x = BankTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankTransaction) ProtoMessage() {}
+
+func (x *BankTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankTransaction.ProtoReflect.Descriptor instead.
+func (*BankTransaction) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BankTransaction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BankTransaction) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *BankTransaction) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *BankTransaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BankTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BankTransaction) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BankTransaction) GetSubcategory() string {
+	if x != nil {
+		return x.Subcategory
+	}
+	return ""
+}
+
+// The expenses of the receipt take the account of the transaction, and its
+// amount when it includes a tip or a different exchange rate. The transaction
+// is deleted.
+type ReconcileReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiptId     uint64 `protobuf:"varint,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	TransactionId uint64 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *ReconcileReceiptRequest) Reset() {
+	*x = ReconcileReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileReceiptRequest) ProtoMessage() {}
+
+func (x *ReconcileReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReconcileReceiptRequest) GetReceiptId() uint64 {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return 0
+}
+
+func (x *ReconcileReceiptRequest) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type ReconcileReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expenses []*Expense `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+}
+
+func (x *ReconcileReceiptResponse) Reset() {
+	*x = ReconcileReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileReceiptResponse) ProtoMessage() {}
+
+func (x *ReconcileReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReconcileReceiptResponse) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type GetVATReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// From 1 to 4.
+	Quarter int32 `protobuf:"varint,2,opt,name=quarter,proto3" json:"quarter,omitempty"`
+}
+
+func (x *GetVATReportRequest) Reset() {
+	*x = GetVATReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVATReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVATReportRequest) ProtoMessage() {}
+
+func (x *GetVATReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVATReportRequest.ProtoReflect.Descriptor instead.
+func (*GetVATReportRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetVATReportRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetVATReportRequest) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
+}
+
+// Only reviewed receipts with the tax ID of the vendor count, since VAT can't
+// be deducted from simplified tickets. Amounts are in cents.
+type GetVATReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Highest rate first.
+	Rates       []*VATRate `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	TotalBase   uint64     `protobuf:"varint,2,opt,name=total_base,json=totalBase,proto3" json:"total_base,omitempty"`
+	TotalAmount uint64     `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+}
+
+func (x *GetVATReportResponse) Reset() {
+	*x = GetVATReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVATReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVATReportResponse) ProtoMessage() {}
+
+func (x *GetVATReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVATReportResponse.ProtoReflect.Descriptor instead.
+func (*GetVATReportResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetVATReportResponse) GetRates() []*VATRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *GetVATReportResponse) GetTotalBase() uint64 {
+	if x != nil {
+		return x.TotalBase
+	}
+	return 0
+}
+
+func (x *GetVATReportResponse) GetTotalAmount() uint64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+type VATRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate   float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Base   uint64  `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+	Amount uint64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// How many receipts have taxes at the rate.
+	Receipts uint32 `protobuf:"varint,4,opt,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (x *VATRate) Reset() {
+	*x = VATRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VATRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VATRate) ProtoMessage() {}
+
+func (x *VATRate) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VATRate.ProtoReflect.Descriptor instead.
+func (*VATRate) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VATRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *VATRate) GetBase() uint64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *VATRate) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *VATRate) GetReceipts() uint32 {
+	if x != nil {
+		return x.Receipts
+	}
+	return 0
+}
+
+// Every filter is optional. Archived receipts are left out.
+type GenerateExpenseReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Of the dates of the expenses.
+	DateRange *DateRange `protobuf:"bytes,1,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	// Only reports the expenses in the category.
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// Only reports the expenses in the subcategory.
+	Subcategory string `protobuf:"bytes,3,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+}
+
+func (x *GenerateExpenseReportRequest) Reset() {
+	*x = GenerateExpenseReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateExpenseReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateExpenseReportRequest) ProtoMessage() {}
+
+func (x *GenerateExpenseReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateExpenseReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateExpenseReportRequest) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GenerateExpenseReportRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *GenerateExpenseReportRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GenerateExpenseReportRequest) GetSubcategory() string {
+	if x != nil {
+		return x.Subcategory
+	}
+	return ""
+}
+
+type GenerateExpenseReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pdf      []byte `protobuf:"bytes,1,opt,name=pdf,proto3" json:"pdf,omitempty"`
+	Receipts uint32 `protobuf:"varint,2,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	// In cents.
+	Total uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GenerateExpenseReportResponse) Reset() {
+	*x = GenerateExpenseReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_receipts_v1_receipts_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateExpenseReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateExpenseReportResponse) ProtoMessage() {}
+
+func (x *GenerateExpenseReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_receipts_v1_receipts_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateExpenseReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateExpenseReportResponse) Descriptor() ([]byte, []int) {
+	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GenerateExpenseReportResponse) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+func (x *GenerateExpenseReportResponse) GetReceipts() uint32 {
+	if x != nil {
+		return x.Receipts
+	}
+	return 0
+}
+
+func (x *GenerateExpenseReportResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_receipts_v1_receipts_proto protoreflect.FileDescriptor
+
+var file_receipts_v1_receipts_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x47, 0x0a, 0x10, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x47, 0x0a, 0x10,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x6b, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69,
+	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x22, 0x60, 0x0a, 0x09, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xab,
+	0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb4, 0x01, 0x0a,
+	0x09, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x4f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0x6b, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x0c, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x66,
+	0x22, 0x8f, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
+	0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x88,
+	0x01, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x03, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35,
+	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xb2,
+	0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x61, 0x74, 0x22, 0x98, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65,
+	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x0c, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x22, 0xc1,
+	0x01, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x70, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x22, 0x97, 0x04, 0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12,
+	0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x3f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x29, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x54, 0x61, 0x78, 0x52, 0x03, 0x74, 0x61, 0x78, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x22, 0x9f, 0x01,
+	0x0a, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x61, 0x78, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x54, 0x61, 0x78, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22,
+	0x49, 0x0a, 0x07, 0x54, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x08, 0x4c,
+	0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x6e, 0x69,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x75, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x61,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x22,
+	0x92, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12,
+	0x33, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x1d, 0x0a,
+	0x1b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x4d, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x72, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x12, 0x32, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x52, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x22, 0x37, 0x0a, 0x0b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x24, 0x0a,
+	0x22, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x8b, 0x02,
+	0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12,
+	0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x0f,
+	0x42, 0x61, 0x6e, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x5f, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x41, 0x54, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x72, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x71, 0x75, 0x61, 0x72, 0x74, 0x65, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x56, 0x41, 0x54, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x41, 0x54, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x65, 0x0a, 0x07, 0x56, 0x41, 0x54, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x63,
+	0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x64,
+	0x66, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x2a, 0x6b, 0x0a, 0x0f, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x4c, 0x41,
+	0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02,
+	0x2a, 0x9a, 0x01, 0x0a, 0x0f, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x4d, 0x49, 0x4c,
+	0x41, 0x52, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x53, 0x10, 0x03, 0x2a, 0x81, 0x01,
+	0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54,
+	0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10,
+	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x50, 0x4c,
+	0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x10,
+	0x03, 0x2a, 0x81, 0x02, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x10,
+	0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x44, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x10, 0x06, 0x2a, 0xc6, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x1f, 0x4c,
+	0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54,
+	0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x53,
+	0x43, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x50, 0x54, 0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4d, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x04, 0x2a, 0xa9,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43,
+	0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x53, 0x49, 0x4e, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x53, 0x49, 0x4e, 0x43, 0x45,
+	0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x01,
+	0x12, 0x26, 0x0a, 0x22, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54,
+	0x53, 0x5f, 0x53, 0x49, 0x4e, 0x43, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55, 0x53,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x53, 0x54,
+	0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x53, 0x49, 0x4e, 0x43, 0x45, 0x5f,
+	0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x2a, 0xe0, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
+	0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d,
+	0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12,
+	0x1b, 0x0a, 0x17, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
+	0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x43,
+	0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x05,
+	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x84, 0x01,
+	0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
+	0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x52, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x50, 0x41, 0x52, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e,
+	0x44, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41,
+	0x52, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x58,
+	0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x52, 0x53, 0x45,
+	0x52, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x50, 0x44, 0x46, 0x5f, 0x54, 0x45,
+	0x58, 0x54, 0x10, 0x03, 0x2a, 0x98, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54,
+	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50,
+	0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x32,
+	0x80, 0x0a, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12,
+	0x58, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x12, 0x21, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x52,
+	0x65, 0x70, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x22, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x27, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x12, 0x2f, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x24, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x56, 0x41, 0x54, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x41, 0x54, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x41, 0x54,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x29, 0x2e, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0xa5, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x7a, 0x61, 0x6e, 0x69, 0x74, 0x30, 0x2f, 0x6d, 0x63,
+	0x64, 0x75, 0x63, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x52, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x17, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_receipts_v1_receipts_proto_rawDescOnce sync.Once
+	file_receipts_v1_receipts_proto_rawDescData = file_receipts_v1_receipts_proto_rawDesc
+)
+
+func file_receipts_v1_receipts_proto_rawDescGZIP() []byte {
+	file_receipts_v1_receipts_proto_rawDescOnce.Do(func() {
+		file_receipts_v1_receipts_proto_rawDescData = protoimpl.X.CompressGZIP(file_receipts_v1_receipts_proto_rawDescData)
+	})
+	return file_receipts_v1_receipts_proto_rawDescData
+}
+
+var file_receipts_v1_receipts_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_receipts_v1_receipts_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_receipts_v1_receipts_proto_goTypes = []any{
+	(DuplicatePolicy)(0),                        // 0: receipts.v1.DuplicatePolicy
+	(DuplicateReason)(0),                        // 1: receipts.v1.DuplicateReason
+	(ReceiptSplit)(0),                           // 2: receipts.v1.ReceiptSplit
+	(FileErrorReason)(0),                        // 3: receipts.v1.FileErrorReason
+	(ListReceiptsOrder)(0),                      // 4: receipts.v1.ListReceiptsOrder
+	(ListReceiptsSince)(0),                      // 5: receipts.v1.ListReceiptsSince
+	(ReceiptStatus)(0),                          // 6: receipts.v1.ReceiptStatus
+	(ParserBackend)(0),                          // 7: receipts.v1.ParserBackend
+	(ReceiptField)(0),                           // 8: receipts.v1.ReceiptField
+	(*CreateReceiptsRequest)(nil),               // 9: receipts.v1.CreateReceiptsRequest
+	(*UploadReceiptsRequest)(nil),               // 10: receipts.v1.UploadReceiptsRequest
+	(*UploadOptions)(nil),                       // 11: receipts.v1.UploadOptions
+	(*UploadedFile)(nil),                        // 12: receipts.v1.UploadedFile
+	(*Duplicate)(nil),                           // 13: receipts.v1.Duplicate
+	(*CreateReceiptsResponse)(nil),              // 14: receipts.v1.CreateReceiptsResponse
+	(*FileResult)(nil),                          // 15: receipts.v1.FileResult
+	(*FileError)(nil),                           // 16: receipts.v1.FileError
+	(*RejectedReceipt)(nil),                     // 17: receipts.v1.RejectedReceipt
+	(*UpdateReceiptRequest)(nil),                // 18: receipts.v1.UpdateReceiptRequest
+	(*UpdateReceiptResponse)(nil),               // 19: receipts.v1.UpdateReceiptResponse
+	(*DeleteReceiptRequest)(nil),                // 20: receipts.v1.DeleteReceiptRequest
+	(*DeleteReceiptResponse)(nil),               // 21: receipts.v1.DeleteReceiptResponse
+	(*ListReceiptsRequest)(nil),                 // 22: receipts.v1.ListReceiptsRequest
+	(*DateRange)(nil),                           // 23: receipts.v1.DateRange
+	(*StatusTransition)(nil),                    // 24: receipts.v1.StatusTransition
+	(*Receipt)(nil),                             // 25: receipts.v1.Receipt
+	(*Expense)(nil),                             // 26: receipts.v1.Expense
+	(*ListReceiptsResponse)(nil),                // 27: receipts.v1.ListReceiptsResponse
+	(*GetReceiptRequest)(nil),                   // 28: receipts.v1.GetReceiptRequest
+	(*GetReceiptResponse)(nil),                  // 29: receipts.v1.GetReceiptResponse
+	(*FullReceipt)(nil),                         // 30: receipts.v1.FullReceipt
+	(*ReceiptTax)(nil),                          // 31: receipts.v1.ReceiptTax
+	(*TaxLine)(nil),                             // 32: receipts.v1.TaxLine
+	(*LineItem)(nil),                            // 33: receipts.v1.LineItem
+	(*ReparseReceiptRequest)(nil),               // 34: receipts.v1.ReparseReceiptRequest
+	(*ReceiptDetails)(nil),                      // 35: receipts.v1.ReceiptDetails
+	(*ReparseReceiptResponse)(nil),              // 36: receipts.v1.ReparseReceiptResponse
+	(*ApplyReceiptChangesRequest)(nil),          // 37: receipts.v1.ApplyReceiptChangesRequest
+	(*ApplyReceiptChangesResponse)(nil),         // 38: receipts.v1.ApplyReceiptChangesResponse
+	(*SearchReceiptsRequest)(nil),               // 39: receipts.v1.SearchReceiptsRequest
+	(*SearchReceiptsResponse)(nil),              // 40: receipts.v1.SearchReceiptsResponse
+	(*SearchResult)(nil),                        // 41: receipts.v1.SearchResult
+	(*SnippetPart)(nil),                         // 42: receipts.v1.SnippetPart
+	(*ListReconciliationProposalsRequest)(nil),  // 43: receipts.v1.ListReconciliationProposalsRequest
+	(*ListReconciliationProposalsResponse)(nil), // 44: receipts.v1.ListReconciliationProposalsResponse
+	(*ReconciliationProposal)(nil),              // 45: receipts.v1.ReconciliationProposal
+	(*BankTransaction)(nil),                     // 46: receipts.v1.BankTransaction
+	(*ReconcileReceiptRequest)(nil),             // 47: receipts.v1.ReconcileReceiptRequest
+	(*ReconcileReceiptResponse)(nil),            // 48: receipts.v1.ReconcileReceiptResponse
+	(*GetVATReportRequest)(nil),                 // 49: receipts.v1.GetVATReportRequest
+	(*GetVATReportResponse)(nil),                // 50: receipts.v1.GetVATReportResponse
+	(*VATRate)(nil),                             // 51: receipts.v1.VATRate
+	(*GenerateExpenseReportRequest)(nil),        // 52: receipts.v1.GenerateExpenseReportRequest
+	(*GenerateExpenseReportResponse)(nil),       // 53: receipts.v1.GenerateExpenseReportResponse
+	(*timestamppb.Timestamp)(nil),               // 54: google.protobuf.Timestamp
+}
+var file_receipts_v1_receipts_proto_depIdxs = []int32{
+	2,  // 0: receipts.v1.CreateReceiptsRequest.split:type_name -> receipts.v1.ReceiptSplit
+	0,  // 1: receipts.v1.CreateReceiptsRequest.duplicate_policy:type_name -> receipts.v1.DuplicatePolicy
+	11, // 2: receipts.v1.UploadReceiptsRequest.options:type_name -> receipts.v1.UploadOptions
+	12, // 3: receipts.v1.UploadReceiptsRequest.file:type_name -> receipts.v1.UploadedFile
+	2,  // 4: receipts.v1.UploadOptions.split:type_name -> receipts.v1.ReceiptSplit
+	0,  // 5: receipts.v1.UploadOptions.duplicate_policy:type_name -> receipts.v1.DuplicatePolicy
+	1,  // 6: receipts.v1.Duplicate.reason:type_name -> receipts.v1.DuplicateReason
+	25, // 7: receipts.v1.CreateReceiptsResponse.receipts:type_name -> receipts.v1.Receipt
+	17, // 8: receipts.v1.CreateReceiptsResponse.rejected:type_name -> receipts.v1.RejectedReceipt
+	15, // 9: receipts.v1.CreateReceiptsResponse.results:type_name -> receipts.v1.FileResult
+	25, // 10: receipts.v1.FileResult.receipt:type_name -> receipts.v1.Receipt
+	16, // 11: receipts.v1.FileResult.error:type_name -> receipts.v1.FileError
+	3,  // 12: receipts.v1.FileError.reason:type_name -> receipts.v1.FileErrorReason
+	13, // 13: receipts.v1.FileError.duplicate_of:type_name -> receipts.v1.Duplicate
+	13, // 14: receipts.v1.RejectedReceipt.duplicate_of:type_name -> receipts.v1.Duplicate
+	54, // 15: receipts.v1.UpdateReceiptRequest.date:type_name -> google.protobuf.Timestamp
+	6,  // 16: receipts.v1.UpdateReceiptRequest.status:type_name -> receipts.v1.ReceiptStatus
+	5,  // 17: receipts.v1.ListReceiptsRequest.since:type_name -> receipts.v1.ListReceiptsSince
+	6,  // 18: receipts.v1.ListReceiptsRequest.status:type_name -> receipts.v1.ReceiptStatus
+	23, // 19: receipts.v1.ListReceiptsRequest.date_range:type_name -> receipts.v1.DateRange
+	4,  // 20: receipts.v1.ListReceiptsRequest.order:type_name -> receipts.v1.ListReceiptsOrder
+	54, // 21: receipts.v1.DateRange.from:type_name -> google.protobuf.Timestamp
+	54, // 22: receipts.v1.DateRange.to:type_name -> google.protobuf.Timestamp
+	6,  // 23: receipts.v1.StatusTransition.from:type_name -> receipts.v1.ReceiptStatus
+	6,  // 24: receipts.v1.StatusTransition.to:type_name -> receipts.v1.ReceiptStatus
+	54, // 25: receipts.v1.StatusTransition.at:type_name -> google.protobuf.Timestamp
+	6,  // 26: receipts.v1.Receipt.status:type_name -> receipts.v1.ReceiptStatus
+	54, // 27: receipts.v1.Receipt.date:type_name -> google.protobuf.Timestamp
+	26, // 28: receipts.v1.Receipt.expenses:type_name -> receipts.v1.Expense
+	13, // 29: receipts.v1.Receipt.duplicate_of:type_name -> receipts.v1.Duplicate
+	54, // 30: receipts.v1.Expense.date:type_name -> google.protobuf.Timestamp
+	25, // 31: receipts.v1.ListReceiptsResponse.receipts:type_name -> receipts.v1.Receipt
+	30, // 32: receipts.v1.GetReceiptResponse.receipt:type_name -> receipts.v1.FullReceipt
+	6,  // 33: receipts.v1.FullReceipt.status:type_name -> receipts.v1.ReceiptStatus
+	54, // 34: receipts.v1.FullReceipt.date:type_name -> google.protobuf.Timestamp
+	26, // 35: receipts.v1.FullReceipt.expenses:type_name -> receipts.v1.Expense
+	33, // 36: receipts.v1.FullReceipt.items:type_name -> receipts.v1.LineItem
+	13, // 37: receipts.v1.FullReceipt.duplicate_of:type_name -> receipts.v1.Duplicate
+	24, // 38: receipts.v1.FullReceipt.transitions:type_name -> receipts.v1.StatusTransition
+	31, // 39: receipts.v1.FullReceipt.tax:type_name -> receipts.v1.ReceiptTax
+	32, // 40: receipts.v1.ReceiptTax.lines:type_name -> receipts.v1.TaxLine
+	7,  // 41: receipts.v1.ReparseReceiptRequest.backend:type_name -> receipts.v1.ParserBackend
+	54, // 42: receipts.v1.ReceiptDetails.date:type_name -> google.protobuf.Timestamp
+	35, // 43: receipts.v1.ReparseReceiptResponse.current:type_name -> receipts.v1.ReceiptDetails
+	35, // 44: receipts.v1.ReparseReceiptResponse.proposed:type_name -> receipts.v1.ReceiptDetails
+	8,  // 45: receipts.v1.ReparseReceiptResponse.changed:type_name -> receipts.v1.ReceiptField
+	35, // 46: receipts.v1.ApplyReceiptChangesRequest.details:type_name -> receipts.v1.ReceiptDetails
+	8,  // 47: receipts.v1.ApplyReceiptChangesRequest.fields:type_name -> receipts.v1.ReceiptField
+	41, // 48: receipts.v1.SearchReceiptsResponse.results:type_name -> receipts.v1.SearchResult
+	25, // 49: receipts.v1.SearchResult.receipt:type_name -> receipts.v1.Receipt
+	42, // 50: receipts.v1.SearchResult.snippet:type_name -> receipts.v1.SnippetPart
+	45, // 51: receipts.v1.ListReconciliationProposalsResponse.proposals:type_name -> receipts.v1.ReconciliationProposal
+	54, // 52: receipts.v1.ReconciliationProposal.receipt_date:type_name -> google.protobuf.Timestamp
+	46, // 53: receipts.v1.ReconciliationProposal.transaction:type_name -> receipts.v1.BankTransaction
+	54, // 54: receipts.v1.BankTransaction.date:type_name -> google.protobuf.Timestamp
+	26, // 55: receipts.v1.ReconcileReceiptResponse.expenses:type_name -> receipts.v1.Expense
+	51, // 56: receipts.v1.GetVATReportResponse.rates:type_name -> receipts.v1.VATRate
+	23, // 57: receipts.v1.GenerateExpenseReportRequest.date_range:type_name -> receipts.v1.DateRange
+	9,  // 58: receipts.v1.ReceiptsService.CreateReceipts:input_type -> receipts.v1.CreateReceiptsRequest
+	10, // 59: receipts.v1.ReceiptsService.UploadReceipts:input_type -> receipts.v1.UploadReceiptsRequest
+	18, // 60: receipts.v1.ReceiptsService.UpdateReceipt:input_type -> receipts.v1.UpdateReceiptRequest
+	20, // 61: receipts.v1.ReceiptsService.DeleteReceipt:input_type -> receipts.v1.DeleteReceiptRequest
+	22, // 62: receipts.v1.ReceiptsService.ListReceipts:input_type -> receipts.v1.ListReceiptsRequest
+	28, // 63: receipts.v1.ReceiptsService.GetReceipt:input_type -> receipts.v1.GetReceiptRequest
+	34, // 64: receipts.v1.ReceiptsService.ReparseReceipt:input_type -> receipts.v1.ReparseReceiptRequest
+	37, // 65: receipts.v1.ReceiptsService.ApplyReceiptChanges:input_type -> receipts.v1.ApplyReceiptChangesRequest
+	39, // 66: receipts.v1.ReceiptsService.SearchReceipts:input_type -> receipts.v1.SearchReceiptsRequest
+	43, // 67: receipts.v1.ReceiptsService.ListReconciliationProposals:input_type -> receipts.v1.ListReconciliationProposalsRequest
+	47, // 68: receipts.v1.ReceiptsService.ReconcileReceipt:input_type -> receipts.v1.ReconcileReceiptRequest
+	49, // 69: receipts.v1.ReceiptsService.GetVATReport:input_type -> receipts.v1.GetVATReportRequest
+	52, // 70: receipts.v1.ReceiptsService.GenerateExpenseReport:input_type -> receipts.v1.GenerateExpenseReportRequest
+	14, // 71: receipts.v1.ReceiptsService.CreateReceipts:output_type -> receipts.v1.CreateReceiptsResponse
+	14, // 72: receipts.v1.ReceiptsService.UploadReceipts:output_type -> receipts.v1.CreateReceiptsResponse
+	19, // 73: receipts.v1.ReceiptsService.UpdateReceipt:output_type -> receipts.v1.UpdateReceiptResponse
+	21, // 74: receipts.v1.ReceiptsService.DeleteReceipt:output_type -> receipts.v1.DeleteReceiptResponse
+	27, // 75: receipts.v1.ReceiptsService.ListReceipts:output_type -> receipts.v1.ListReceiptsResponse
+	29, // 76: receipts.v1.ReceiptsService.GetReceipt:output_type -> receipts.v1.GetReceiptResponse
+	36, // 77: receipts.v1.ReceiptsService.ReparseReceipt:output_type -> receipts.v1.ReparseReceiptResponse
+	38, // 78: receipts.v1.ReceiptsService.ApplyReceiptChanges:output_type -> receipts.v1.ApplyReceiptChangesResponse
+	40, // 79: receipts.v1.ReceiptsService.SearchReceipts:output_type -> receipts.v1.SearchReceiptsResponse
+	44, // 80: receipts.v1.ReceiptsService.ListReconciliationProposals:output_type -> receipts.v1.ListReconciliationProposalsResponse
+	48, // 81: receipts.v1.ReceiptsService.ReconcileReceipt:output_type -> receipts.v1.ReconcileReceiptResponse
+	50, // 82: receipts.v1.ReceiptsService.GetVATReport:output_type -> receipts.v1.GetVATReportResponse
+	53, // 83: receipts.v1.ReceiptsService.GenerateExpenseReport:output_type -> receipts.v1.GenerateExpenseReportResponse
+	71, // [71:84] is the sub-list for method output_type
+	58, // [58:71] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
+}
+
+func init() { file_receipts_v1_receipts_proto_init() }
+func file_receipts_v1_receipts_proto_init() {
+	if File_receipts_v1_receipts_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_receipts_v1_receipts_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateReceiptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadReceiptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Duplicate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateReceiptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*FileResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*FileError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RejectedReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ListReceiptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DateRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*Receipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Expense); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ListReceiptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*FullReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiptTax); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*TaxLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*LineItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ReparseReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiptDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ReparseReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ApplyReceiptChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ApplyReceiptChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
-func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
-	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GetReceiptResponse) GetReceipt() *FullReceipt {
-	if x != nil {
-		return x.Receipt
-	}
-	return nil
-}
-
-type FullReceipt struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status   ReceiptStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=receipts.v1.ReceiptStatus" json:"status,omitempty"`
-	Vendor   string                 `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor,omitempty"`
-	Date     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	File     []byte                 `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
-	Expenses []*Expense             `protobuf:"bytes,6,rep,name=expenses,proto3" json:"expenses,omitempty"`
-}
-
-func (x *FullReceipt) Reset() {
-	*x = FullReceipt{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_receipts_v1_receipts_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FullReceipt) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FullReceipt) ProtoMessage() {}
-
-func (x *FullReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_receipts_v1_receipts_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_receipts_v1_receipts_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchReceiptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FullReceipt.ProtoReflect.Descriptor instead.
-func (*FullReceipt) Descriptor() ([]byte, []int) {
-	return file_receipts_v1_receipts_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *FullReceipt) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *FullReceipt) GetStatus() ReceiptStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
-}
-
-func (x *FullReceipt) GetVendor() string {
-	if x != nil {
-		return x.Vendor
-	}
-	return ""
-}
-
-func (x *FullReceipt) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *FullReceipt) GetFile() []byte {
-	if x != nil {
-		return x.File
-	}
-	return nil
-}
-
-func (x *FullReceipt) GetExpenses() []*Expense {
-	if x != nil {
-		return x.Expenses
-	}
-	return nil
-}
-
-var File_receipts_v1_receipts_proto protoreflect.FileDescriptor
-
-var file_receipts_v1_receipts_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2f, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3c, 0x0a, 0x15, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
-	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x02, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7f, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x53, 0x69, 0x6e,
-	0x63, 0x65, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc7, 0x01,
-	0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76,
-	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x65,
-	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x65,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x14, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x66, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x65, 0x78,
-	0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x2a, 0xa9, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x1f,
-	0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x53, 0x49,
-	0x4e, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50,
-	0x54, 0x53, 0x5f, 0x53, 0x49, 0x4e, 0x43, 0x45, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54,
-	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x49, 0x53, 0x54,
-	0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x53, 0x49, 0x4e, 0x43, 0x45, 0x5f,
-	0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55, 0x53, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x02,
-	0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54,
-	0x53, 0x5f, 0x53, 0x49, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45,
-	0x10, 0x03, 0x2a, 0x6f, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45,
-	0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x45,
-	0x44, 0x10, 0x02, 0x32, 0xca, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
-	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
-	0x21, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1e, 0x2e,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x42, 0xa5, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x7a, 0x61, 0x6e, 0x69, 0x74, 0x30, 0x2f, 0x6d, 0x63, 0x64, 0x75,
-	0x63, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x3b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x52, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x17, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_receipts_v1_receipts_proto_rawDescOnce sync.Once
-	file_receipts_v1_receipts_proto_rawDescData = file_receipts_v1_receipts_proto_rawDesc
-)
-
-func file_receipts_v1_receipts_proto_rawDescGZIP() []byte {
-	file_receipts_v1_receipts_proto_rawDescOnce.Do(func() {
-		file_receipts_v1_receipts_proto_rawDescData = protoimpl.X.CompressGZIP(file_receipts_v1_receipts_proto_rawDescData)
-	})
-	return file_receipts_v1_receipts_proto_rawDescData
-}
-
-var file_receipts_v1_receipts_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_receipts_v1_receipts_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_receipts_v1_receipts_proto_goTypes = []any{
-	(ListReceiptsSince)(0),         // 0: receipts.v1.ListReceiptsSince
-	(ReceiptStatus)(0),             // 1: receipts.v1.ReceiptStatus
-	(*CreateReceiptsRequest)(nil),  // 2: receipts.v1.CreateReceiptsRequest
-	(*CreateReceiptsResponse)(nil), // 3: receipts.v1.CreateReceiptsResponse
-	(*UpdateReceiptRequest)(nil),   // 4: receipts.v1.UpdateReceiptRequest
-	(*UpdateReceiptResponse)(nil),  // 5: receipts.v1.UpdateReceiptResponse
-	(*DeleteReceiptRequest)(nil),   // 6: receipts.v1.DeleteReceiptRequest
-	(*DeleteReceiptResponse)(nil),  // 7: receipts.v1.DeleteReceiptResponse
-	(*ListReceiptsRequest)(nil),    // 8: receipts.v1.ListReceiptsRequest
-	(*Receipt)(nil),                // 9: receipts.v1.Receipt
-	(*Expense)(nil),                // 10: receipts.v1.Expense
-	(*ListReceiptsResponse)(nil),   // 11: receipts.v1.ListReceiptsResponse
-	(*GetReceiptRequest)(nil),      // 12: receipts.v1.GetReceiptRequest
-	(*GetReceiptResponse)(nil),     // 13: receipts.v1.GetReceiptResponse
-	(*FullReceipt)(nil),            // 14: receipts.v1.FullReceipt
-	(*timestamppb.Timestamp)(nil),  // 15: google.protobuf.Timestamp
-}
-var file_receipts_v1_receipts_proto_depIdxs = []int32{
-	9,  // 0: receipts.v1.CreateReceiptsResponse.receipts:type_name -> receipts.v1.Receipt
-	15, // 1: receipts.v1.UpdateReceiptRequest.date:type_name -> google.protobuf.Timestamp
-	0,  // 2: receipts.v1.ListReceiptsRequest.since:type_name -> receipts.v1.ListReceiptsSince
-	1,  // 3: receipts.v1.ListReceiptsRequest.status:type_name -> receipts.v1.ReceiptStatus
-	1,  // 4: receipts.v1.Receipt.status:type_name -> receipts.v1.ReceiptStatus
-	15, // 5: receipts.v1.Receipt.date:type_name -> google.protobuf.Timestamp
-	10, // 6: receipts.v1.Receipt.expenses:type_name -> receipts.v1.Expense
-	15, // 7: receipts.v1.Expense.date:type_name -> google.protobuf.Timestamp
-	9,  // 8: receipts.v1.ListReceiptsResponse.receipts:type_name -> receipts.v1.Receipt
-	14, // 9: receipts.v1.GetReceiptResponse.receipt:type_name -> receipts.v1.FullReceipt
-	1,  // 10: receipts.v1.FullReceipt.status:type_name -> receipts.v1.ReceiptStatus
-	15, // 11: receipts.v1.FullReceipt.date:type_name -> google.protobuf.Timestamp
-	10, // 12: receipts.v1.FullReceipt.expenses:type_name -> receipts.v1.Expense
-	2,  // 13: receipts.v1.ReceiptsService.CreateReceipts:input_type -> receipts.v1.CreateReceiptsRequest
-	4,  // 14: receipts.v1.ReceiptsService.UpdateReceipt:input_type -> receipts.v1.UpdateReceiptRequest
-	6,  // 15: receipts.v1.ReceiptsService.DeleteReceipt:input_type -> receipts.v1.DeleteReceiptRequest
-	8,  // 16: receipts.v1.ReceiptsService.ListReceipts:input_type -> receipts.v1.ListReceiptsRequest
-	12, // 17: receipts.v1.ReceiptsService.GetReceipt:input_type -> receipts.v1.GetReceiptRequest
-	3,  // 18: receipts.v1.ReceiptsService.CreateReceipts:output_type -> receipts.v1.CreateReceiptsResponse
-	5,  // 19: receipts.v1.ReceiptsService.UpdateReceipt:output_type -> receipts.v1.UpdateReceiptResponse
-	7,  // 20: receipts.v1.ReceiptsService.DeleteReceipt:output_type -> receipts.v1.DeleteReceiptResponse
-	11, // 21: receipts.v1.ReceiptsService.ListReceipts:output_type -> receipts.v1.ListReceiptsResponse
-	13, // 22: receipts.v1.ReceiptsService.GetReceipt:output_type -> receipts.v1.GetReceiptResponse
-	18, // [18:23] is the sub-list for method output_type
-	13, // [13:18] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
-}
-
-func init() { file_receipts_v1_receipts_proto_init() }
-func file_receipts_v1_receipts_proto_init() {
-	if File_receipts_v1_receipts_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_receipts_v1_receipts_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateReceiptsRequest); i {
+		file_receipts_v1_receipts_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchReceiptsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1093,8 +4701,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateReceiptsResponse); i {
+		file_receipts_v1_receipts_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1105,8 +4713,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateReceiptRequest); i {
+		file_receipts_v1_receipts_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*SnippetPart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1117,8 +4725,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateReceiptResponse); i {
+		file_receipts_v1_receipts_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ListReconciliationProposalsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1129,8 +4737,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteReceiptRequest); i {
+		file_receipts_v1_receipts_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ListReconciliationProposalsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1141,8 +4749,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteReceiptResponse); i {
+		file_receipts_v1_receipts_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconciliationProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1153,8 +4761,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ListReceiptsRequest); i {
+		file_receipts_v1_receipts_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*BankTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1165,8 +4773,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Receipt); i {
+		file_receipts_v1_receipts_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileReceiptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1177,8 +4785,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Expense); i {
+		file_receipts_v1_receipts_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileReceiptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1189,8 +4797,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ListReceiptsResponse); i {
+		file_receipts_v1_receipts_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVATReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1201,8 +4809,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetReceiptRequest); i {
+		file_receipts_v1_receipts_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVATReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1213,8 +4821,8 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetReceiptResponse); i {
+		file_receipts_v1_receipts_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*VATRate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1225,8 +4833,20 @@ func file_receipts_v1_receipts_proto_init() {
 				return nil
 			}
 		}
-		file_receipts_v1_receipts_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*FullReceipt); i {
+		file_receipts_v1_receipts_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateExpenseReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_receipts_v1_receipts_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateExpenseReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1238,14 +4858,26 @@ func file_receipts_v1_receipts_proto_init() {
 			}
 		}
 	}
-	file_receipts_v1_receipts_proto_msgTypes[2].OneofWrappers = []any{}
+	file_receipts_v1_receipts_proto_msgTypes[1].OneofWrappers = []any{
+		(*UploadReceiptsRequest_Options)(nil),
+		(*UploadReceiptsRequest_File)(nil),
+		(*UploadReceiptsRequest_Chunk)(nil),
+	}
+	file_receipts_v1_receipts_proto_msgTypes[6].OneofWrappers = []any{
+		(*FileResult_Receipt)(nil),
+		(*FileResult_Error)(nil),
+	}
+	file_receipts_v1_receipts_proto_msgTypes[9].OneofWrappers = []any{}
+	file_receipts_v1_receipts_proto_msgTypes[13].OneofWrappers = []any{}
+	file_receipts_v1_receipts_proto_msgTypes[16].OneofWrappers = []any{}
+	file_receipts_v1_receipts_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_receipts_v1_receipts_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   13,
+			NumEnums:      9,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,9 @@ const (
 	// ReceiptsServiceCreateReceiptsProcedure is the fully-qualified name of the ReceiptsService's
 	// CreateReceipts RPC.
 	ReceiptsServiceCreateReceiptsProcedure = "/receipts.v1.ReceiptsService/CreateReceipts"
+	// ReceiptsServiceUploadReceiptsProcedure is the fully-qualified name of the ReceiptsService's
+	// UploadReceipts RPC.
+	ReceiptsServiceUploadReceiptsProcedure = "/receipts.v1.ReceiptsService/UploadReceipts"
 	// ReceiptsServiceUpdateReceiptProcedure is the fully-qualified name of the ReceiptsService's
 	// UpdateReceipt RPC.
 	ReceiptsServiceUpdateReceiptProcedure = "/receipts.v1.ReceiptsService/UpdateReceipt"
@@ -48,25 +51,76 @@ const (
 	// ReceiptsServiceGetReceiptProcedure is the fully-qualified name of the ReceiptsService's
 	// GetReceipt RPC.
 	ReceiptsServiceGetReceiptProcedure = "/receipts.v1.ReceiptsService/GetReceipt"
+	// ReceiptsServiceReparseReceiptProcedure is the fully-qualified name of the ReceiptsService's
+	// ReparseReceipt RPC.
+	ReceiptsServiceReparseReceiptProcedure = "/receipts.v1.ReceiptsService/ReparseReceipt"
+	// ReceiptsServiceApplyReceiptChangesProcedure is the fully-qualified name of the ReceiptsService's
+	// ApplyReceiptChanges RPC.
+	ReceiptsServiceApplyReceiptChangesProcedure = "/receipts.v1.ReceiptsService/ApplyReceiptChanges"
+	// ReceiptsServiceSearchReceiptsProcedure is the fully-qualified name of the ReceiptsService's
+	// SearchReceipts RPC.
+	ReceiptsServiceSearchReceiptsProcedure = "/receipts.v1.ReceiptsService/SearchReceipts"
+	// ReceiptsServiceListReconciliationProposalsProcedure is the fully-qualified name of the
+	// ReceiptsService's ListReconciliationProposals RPC.
+	ReceiptsServiceListReconciliationProposalsProcedure = "/receipts.v1.ReceiptsService/ListReconciliationProposals"
+	// ReceiptsServiceReconcileReceiptProcedure is the fully-qualified name of the ReceiptsService's
+	// ReconcileReceipt RPC.
+	ReceiptsServiceReconcileReceiptProcedure = "/receipts.v1.ReceiptsService/ReconcileReceipt"
+	// ReceiptsServiceGetVATReportProcedure is the fully-qualified name of the ReceiptsService's
+	// GetVATReport RPC.
+	ReceiptsServiceGetVATReportProcedure = "/receipts.v1.ReceiptsService/GetVATReport"
+	// ReceiptsServiceGenerateExpenseReportProcedure is the fully-qualified name of the
+	// ReceiptsService's GenerateExpenseReport RPC.
+	ReceiptsServiceGenerateExpenseReportProcedure = "/receipts.v1.ReceiptsService/GenerateExpenseReport"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	receiptsServiceServiceDescriptor              = receipts_v1.File_receipts_v1_receipts_proto.Services().ByName("ReceiptsService")
-	receiptsServiceCreateReceiptsMethodDescriptor = receiptsServiceServiceDescriptor.Methods().ByName("CreateReceipts")
-	receiptsServiceUpdateReceiptMethodDescriptor  = receiptsServiceServiceDescriptor.Methods().ByName("UpdateReceipt")
-	receiptsServiceDeleteReceiptMethodDescriptor  = receiptsServiceServiceDescriptor.Methods().ByName("DeleteReceipt")
-	receiptsServiceListReceiptsMethodDescriptor   = receiptsServiceServiceDescriptor.Methods().ByName("ListReceipts")
-	receiptsServiceGetReceiptMethodDescriptor     = receiptsServiceServiceDescriptor.Methods().ByName("GetReceipt")
+	receiptsServiceServiceDescriptor                           = receipts_v1.File_receipts_v1_receipts_proto.Services().ByName("ReceiptsService")
+	receiptsServiceCreateReceiptsMethodDescriptor              = receiptsServiceServiceDescriptor.Methods().ByName("CreateReceipts")
+	receiptsServiceUploadReceiptsMethodDescriptor              = receiptsServiceServiceDescriptor.Methods().ByName("UploadReceipts")
+	receiptsServiceUpdateReceiptMethodDescriptor               = receiptsServiceServiceDescriptor.Methods().ByName("UpdateReceipt")
+	receiptsServiceDeleteReceiptMethodDescriptor               = receiptsServiceServiceDescriptor.Methods().ByName("DeleteReceipt")
+	receiptsServiceListReceiptsMethodDescriptor                = receiptsServiceServiceDescriptor.Methods().ByName("ListReceipts")
+	receiptsServiceGetReceiptMethodDescriptor                  = receiptsServiceServiceDescriptor.Methods().ByName("GetReceipt")
+	receiptsServiceReparseReceiptMethodDescriptor              = receiptsServiceServiceDescriptor.Methods().ByName("ReparseReceipt")
+	receiptsServiceApplyReceiptChangesMethodDescriptor         = receiptsServiceServiceDescriptor.Methods().ByName("ApplyReceiptChanges")
+	receiptsServiceSearchReceiptsMethodDescriptor              = receiptsServiceServiceDescriptor.Methods().ByName("SearchReceipts")
+	receiptsServiceListReconciliationProposalsMethodDescriptor = receiptsServiceServiceDescriptor.Methods().ByName("ListReconciliationProposals")
+	receiptsServiceReconcileReceiptMethodDescriptor            = receiptsServiceServiceDescriptor.Methods().ByName("ReconcileReceipt")
+	receiptsServiceGetVATReportMethodDescriptor                = receiptsServiceServiceDescriptor.Methods().ByName("GetVATReport")
+	receiptsServiceGenerateExpenseReportMethodDescriptor       = receiptsServiceServiceDescriptor.Methods().ByName("GenerateExpenseReport")
 )
 
 // ReceiptsServiceClient is a client for the receipts.v1.ReceiptsService service.
 type ReceiptsServiceClient interface {
 	CreateReceipts(context.Context, *connect.Request[receipts_v1.CreateReceiptsRequest]) (*connect.Response[receipts_v1.CreateReceiptsResponse], error)
+	// Uploads receipts in chunks, so that large files don't have to fit in a
+	// single message. Rejected receipts reference the files in the order they
+	// were sent.
+	UploadReceipts(context.Context) *connect.ClientStreamForClient[receipts_v1.UploadReceiptsRequest, receipts_v1.CreateReceiptsResponse]
 	UpdateReceipt(context.Context, *connect.Request[receipts_v1.UpdateReceiptRequest]) (*connect.Response[receipts_v1.UpdateReceiptResponse], error)
 	DeleteReceipt(context.Context, *connect.Request[receipts_v1.DeleteReceiptRequest]) (*connect.Response[receipts_v1.DeleteReceiptResponse], error)
 	ListReceipts(context.Context, *connect.Request[receipts_v1.ListReceiptsRequest]) (*connect.Response[receipts_v1.ListReceiptsResponse], error)
 	GetReceipt(context.Context, *connect.Request[receipts_v1.GetReceiptRequest]) (*connect.Response[receipts_v1.GetReceiptResponse], error)
+	// Runs the parser again on the stored file of the receipt and returns what
+	// would change, without changing anything.
+	ReparseReceipt(context.Context, *connect.Request[receipts_v1.ReparseReceiptRequest]) (*connect.Response[receipts_v1.ReparseReceiptResponse], error)
+	// Applies some or all of the changes returned by ReparseReceipt.
+	ApplyReceiptChanges(context.Context, *connect.Request[receipts_v1.ApplyReceiptChangesRequest]) (*connect.Response[receipts_v1.ApplyReceiptChangesResponse], error)
+	// Finds receipts by their vendor, the description of their expenses or their
+	// text, best matches first.
+	SearchReceipts(context.Context, *connect.Request[receipts_v1.SearchReceiptsRequest]) (*connect.Response[receipts_v1.SearchReceiptsResponse], error)
+	// Proposes the bank transaction which most likely paid each receipt, so
+	// that imported statements don't count the money twice.
+	ListReconciliationProposals(context.Context, *connect.Request[receipts_v1.ListReconciliationProposalsRequest]) (*connect.Response[receipts_v1.ListReconciliationProposalsResponse], error)
+	// Merges a bank transaction into the expenses of the receipt it paid.
+	ReconcileReceipt(context.Context, *connect.Request[receipts_v1.ReconcileReceiptRequest]) (*connect.Response[receipts_v1.ReconcileReceiptResponse], error)
+	// Sums the deductible VAT of the receipts of a quarter by rate.
+	GetVATReport(context.Context, *connect.Request[receipts_v1.GetVATReportRequest]) (*connect.Response[receipts_v1.GetVATReportResponse], error)
+	// Generates a PDF with the expenses of the receipts which match the filters
+	// and the receipts themselves, to hand in for reimbursements.
+	GenerateExpenseReport(context.Context, *connect.Request[receipts_v1.GenerateExpenseReportRequest]) (*connect.Response[receipts_v1.GenerateExpenseReportResponse], error)
 }
 
 // NewReceiptsServiceClient constructs a client for the receipts.v1.ReceiptsService service. By
@@ -85,6 +139,12 @@ func NewReceiptsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(receiptsServiceCreateReceiptsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		uploadReceipts: connect.NewClient[receipts_v1.UploadReceiptsRequest, receipts_v1.CreateReceiptsResponse](
+			httpClient,
+			baseURL+ReceiptsServiceUploadReceiptsProcedure,
+			connect.WithSchema(receiptsServiceUploadReceiptsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		updateReceipt: connect.NewClient[receipts_v1.UpdateReceiptRequest, receipts_v1.UpdateReceiptResponse](
 			httpClient,
 			baseURL+ReceiptsServiceUpdateReceiptProcedure,
@@ -109,16 +169,66 @@ func NewReceiptsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(receiptsServiceGetReceiptMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		reparseReceipt: connect.NewClient[receipts_v1.ReparseReceiptRequest, receipts_v1.ReparseReceiptResponse](
+			httpClient,
+			baseURL+ReceiptsServiceReparseReceiptProcedure,
+			connect.WithSchema(receiptsServiceReparseReceiptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		applyReceiptChanges: connect.NewClient[receipts_v1.ApplyReceiptChangesRequest, receipts_v1.ApplyReceiptChangesResponse](
+			httpClient,
+			baseURL+ReceiptsServiceApplyReceiptChangesProcedure,
+			connect.WithSchema(receiptsServiceApplyReceiptChangesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		searchReceipts: connect.NewClient[receipts_v1.SearchReceiptsRequest, receipts_v1.SearchReceiptsResponse](
+			httpClient,
+			baseURL+ReceiptsServiceSearchReceiptsProcedure,
+			connect.WithSchema(receiptsServiceSearchReceiptsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listReconciliationProposals: connect.NewClient[receipts_v1.ListReconciliationProposalsRequest, receipts_v1.ListReconciliationProposalsResponse](
+			httpClient,
+			baseURL+ReceiptsServiceListReconciliationProposalsProcedure,
+			connect.WithSchema(receiptsServiceListReconciliationProposalsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reconcileReceipt: connect.NewClient[receipts_v1.ReconcileReceiptRequest, receipts_v1.ReconcileReceiptResponse](
+			httpClient,
+			baseURL+ReceiptsServiceReconcileReceiptProcedure,
+			connect.WithSchema(receiptsServiceReconcileReceiptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getVATReport: connect.NewClient[receipts_v1.GetVATReportRequest, receipts_v1.GetVATReportResponse](
+			httpClient,
+			baseURL+ReceiptsServiceGetVATReportProcedure,
+			connect.WithSchema(receiptsServiceGetVATReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		generateExpenseReport: connect.NewClient[receipts_v1.GenerateExpenseReportRequest, receipts_v1.GenerateExpenseReportResponse](
+			httpClient,
+			baseURL+ReceiptsServiceGenerateExpenseReportProcedure,
+			connect.WithSchema(receiptsServiceGenerateExpenseReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // receiptsServiceClient implements ReceiptsServiceClient.
 type receiptsServiceClient struct {
-	createReceipts *connect.Client[receipts_v1.CreateReceiptsRequest, receipts_v1.CreateReceiptsResponse]
-	updateReceipt  *connect.Client[receipts_v1.UpdateReceiptRequest, receipts_v1.UpdateReceiptResponse]
-	deleteReceipt  *connect.Client[receipts_v1.DeleteReceiptRequest, receipts_v1.DeleteReceiptResponse]
-	listReceipts   *connect.Client[receipts_v1.ListReceiptsRequest, receipts_v1.ListReceiptsResponse]
-	getReceipt     *connect.Client[receipts_v1.GetReceiptRequest, receipts_v1.GetReceiptResponse]
+	createReceipts              *connect.Client[receipts_v1.CreateReceiptsRequest, receipts_v1.CreateReceiptsResponse]
+	uploadReceipts              *connect.Client[receipts_v1.UploadReceiptsRequest, receipts_v1.CreateReceiptsResponse]
+	updateReceipt               *connect.Client[receipts_v1.UpdateReceiptRequest, receipts_v1.UpdateReceiptResponse]
+	deleteReceipt               *connect.Client[receipts_v1.DeleteReceiptRequest, receipts_v1.DeleteReceiptResponse]
+	listReceipts                *connect.Client[receipts_v1.ListReceiptsRequest, receipts_v1.ListReceiptsResponse]
+	getReceipt                  *connect.Client[receipts_v1.GetReceiptRequest, receipts_v1.GetReceiptResponse]
+	reparseReceipt              *connect.Client[receipts_v1.ReparseReceiptRequest, receipts_v1.ReparseReceiptResponse]
+	applyReceiptChanges         *connect.Client[receipts_v1.ApplyReceiptChangesRequest, receipts_v1.ApplyReceiptChangesResponse]
+	searchReceipts              *connect.Client[receipts_v1.SearchReceiptsRequest, receipts_v1.SearchReceiptsResponse]
+	listReconciliationProposals *connect.Client[receipts_v1.ListReconciliationProposalsRequest, receipts_v1.ListReconciliationProposalsResponse]
+	reconcileReceipt            *connect.Client[receipts_v1.ReconcileReceiptRequest, receipts_v1.ReconcileReceiptResponse]
+	getVATReport                *connect.Client[receipts_v1.GetVATReportRequest, receipts_v1.GetVATReportResponse]
+	generateExpenseReport       *connect.Client[receipts_v1.GenerateExpenseReportRequest, receipts_v1.GenerateExpenseReportResponse]
 }
 
 // CreateReceipts calls receipts.v1.ReceiptsService.CreateReceipts.
@@ -126,6 +236,11 @@ func (c *receiptsServiceClient) CreateReceipts(ctx context.Context, req *connect
 	return c.createReceipts.CallUnary(ctx, req)
 }
 
+// UploadReceipts calls receipts.v1.ReceiptsService.UploadReceipts.
+func (c *receiptsServiceClient) UploadReceipts(ctx context.Context) *connect.ClientStreamForClient[receipts_v1.UploadReceiptsRequest, receipts_v1.CreateReceiptsResponse] {
+	return c.uploadReceipts.CallClientStream(ctx)
+}
+
 // UpdateReceipt calls receipts.v1.ReceiptsService.UpdateReceipt.
 func (c *receiptsServiceClient) UpdateReceipt(ctx context.Context, req *connect.Request[receipts_v1.UpdateReceiptRequest]) (*connect.Response[receipts_v1.UpdateReceiptResponse], error) {
 	return c.updateReceipt.CallUnary(ctx, req)
@@ -146,13 +261,70 @@ func (c *receiptsServiceClient) GetReceipt(ctx context.Context, req *connect.Req
 	return c.getReceipt.CallUnary(ctx, req)
 }
 
+// ReparseReceipt calls receipts.v1.ReceiptsService.ReparseReceipt.
+func (c *receiptsServiceClient) ReparseReceipt(ctx context.Context, req *connect.Request[receipts_v1.ReparseReceiptRequest]) (*connect.Response[receipts_v1.ReparseReceiptResponse], error) {
+	return c.reparseReceipt.CallUnary(ctx, req)
+}
+
+// ApplyReceiptChanges calls receipts.v1.ReceiptsService.ApplyReceiptChanges.
+func (c *receiptsServiceClient) ApplyReceiptChanges(ctx context.Context, req *connect.Request[receipts_v1.ApplyReceiptChangesRequest]) (*connect.Response[receipts_v1.ApplyReceiptChangesResponse], error) {
+	return c.applyReceiptChanges.CallUnary(ctx, req)
+}
+
+// SearchReceipts calls receipts.v1.ReceiptsService.SearchReceipts.
+func (c *receiptsServiceClient) SearchReceipts(ctx context.Context, req *connect.Request[receipts_v1.SearchReceiptsRequest]) (*connect.Response[receipts_v1.SearchReceiptsResponse], error) {
+	return c.searchReceipts.CallUnary(ctx, req)
+}
+
+// ListReconciliationProposals calls receipts.v1.ReceiptsService.ListReconciliationProposals.
+func (c *receiptsServiceClient) ListReconciliationProposals(ctx context.Context, req *connect.Request[receipts_v1.ListReconciliationProposalsRequest]) (*connect.Response[receipts_v1.ListReconciliationProposalsResponse], error) {
+	return c.listReconciliationProposals.CallUnary(ctx, req)
+}
+
+// ReconcileReceipt calls receipts.v1.ReceiptsService.ReconcileReceipt.
+func (c *receiptsServiceClient) ReconcileReceipt(ctx context.Context, req *connect.Request[receipts_v1.ReconcileReceiptRequest]) (*connect.Response[receipts_v1.ReconcileReceiptResponse], error) {
+	return c.reconcileReceipt.CallUnary(ctx, req)
+}
+
+// GetVATReport calls receipts.v1.ReceiptsService.GetVATReport.
+func (c *receiptsServiceClient) GetVATReport(ctx context.Context, req *connect.Request[receipts_v1.GetVATReportRequest]) (*connect.Response[receipts_v1.GetVATReportResponse], error) {
+	return c.getVATReport.CallUnary(ctx, req)
+}
+
+// GenerateExpenseReport calls receipts.v1.ReceiptsService.GenerateExpenseReport.
+func (c *receiptsServiceClient) GenerateExpenseReport(ctx context.Context, req *connect.Request[receipts_v1.GenerateExpenseReportRequest]) (*connect.Response[receipts_v1.GenerateExpenseReportResponse], error) {
+	return c.generateExpenseReport.CallUnary(ctx, req)
+}
+
 // ReceiptsServiceHandler is an implementation of the receipts.v1.ReceiptsService service.
 type ReceiptsServiceHandler interface {
 	CreateReceipts(context.Context, *connect.Request[receipts_v1.CreateReceiptsRequest]) (*connect.Response[receipts_v1.CreateReceiptsResponse], error)
+	// Uploads receipts in chunks, so that large files don't have to fit in a
+	// single message. Rejected receipts reference the files in the order they
+	// were sent.
+	UploadReceipts(context.Context, *connect.ClientStream[receipts_v1.UploadReceiptsRequest]) (*connect.Response[receipts_v1.CreateReceiptsResponse], error)
 	UpdateReceipt(context.Context, *connect.Request[receipts_v1.UpdateReceiptRequest]) (*connect.Response[receipts_v1.UpdateReceiptResponse], error)
 	DeleteReceipt(context.Context, *connect.Request[receipts_v1.DeleteReceiptRequest]) (*connect.Response[receipts_v1.DeleteReceiptResponse], error)
 	ListReceipts(context.Context, *connect.Request[receipts_v1.ListReceiptsRequest]) (*connect.Response[receipts_v1.ListReceiptsResponse], error)
 	GetReceipt(context.Context, *connect.Request[receipts_v1.GetReceiptRequest]) (*connect.Response[receipts_v1.GetReceiptResponse], error)
+	// Runs the parser again on the stored file of the receipt and returns what
+	// would change, without changing anything.
+	ReparseReceipt(context.Context, *connect.Request[receipts_v1.ReparseReceiptRequest]) (*connect.Response[receipts_v1.ReparseReceiptResponse], error)
+	// Applies some or all of the changes returned by ReparseReceipt.
+	ApplyReceiptChanges(context.Context, *connect.Request[receipts_v1.ApplyReceiptChangesRequest]) (*connect.Response[receipts_v1.ApplyReceiptChangesResponse], error)
+	// Finds receipts by their vendor, the description of their expenses or their
+	// text, best matches first.
+	SearchReceipts(context.Context, *connect.Request[receipts_v1.SearchReceiptsRequest]) (*connect.Response[receipts_v1.SearchReceiptsResponse], error)
+	// Proposes the bank transaction which most likely paid each receipt, so
+	// that imported statements don't count the money twice.
+	ListReconciliationProposals(context.Context, *connect.Request[receipts_v1.ListReconciliationProposalsRequest]) (*connect.Response[receipts_v1.ListReconciliationProposalsResponse], error)
+	// Merges a bank transaction into the expenses of the receipt it paid.
+	ReconcileReceipt(context.Context, *connect.Request[receipts_v1.ReconcileReceiptRequest]) (*connect.Response[receipts_v1.ReconcileReceiptResponse], error)
+	// Sums the deductible VAT of the receipts of a quarter by rate.
+	GetVATReport(context.Context, *connect.Request[receipts_v1.GetVATReportRequest]) (*connect.Response[receipts_v1.GetVATReportResponse], error)
+	// Generates a PDF with the expenses of the receipts which match the filters
+	// and the receipts themselves, to hand in for reimbursements.
+	GenerateExpenseReport(context.Context, *connect.Request[receipts_v1.GenerateExpenseReportRequest]) (*connect.Response[receipts_v1.GenerateExpenseReportResponse], error)
 }
 
 // NewReceiptsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -167,6 +339,12 @@ func NewReceiptsServiceHandler(svc ReceiptsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(receiptsServiceCreateReceiptsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	receiptsServiceUploadReceiptsHandler := connect.NewClientStreamHandler(
+		ReceiptsServiceUploadReceiptsProcedure,
+		svc.UploadReceipts,
+		connect.WithSchema(receiptsServiceUploadReceiptsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	receiptsServiceUpdateReceiptHandler := connect.NewUnaryHandler(
 		ReceiptsServiceUpdateReceiptProcedure,
 		svc.UpdateReceipt,
@@ -191,10 +369,54 @@ func NewReceiptsServiceHandler(svc ReceiptsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(receiptsServiceGetReceiptMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	receiptsServiceReparseReceiptHandler := connect.NewUnaryHandler(
+		ReceiptsServiceReparseReceiptProcedure,
+		svc.ReparseReceipt,
+		connect.WithSchema(receiptsServiceReparseReceiptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptsServiceApplyReceiptChangesHandler := connect.NewUnaryHandler(
+		ReceiptsServiceApplyReceiptChangesProcedure,
+		svc.ApplyReceiptChanges,
+		connect.WithSchema(receiptsServiceApplyReceiptChangesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptsServiceSearchReceiptsHandler := connect.NewUnaryHandler(
+		ReceiptsServiceSearchReceiptsProcedure,
+		svc.SearchReceipts,
+		connect.WithSchema(receiptsServiceSearchReceiptsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptsServiceListReconciliationProposalsHandler := connect.NewUnaryHandler(
+		ReceiptsServiceListReconciliationProposalsProcedure,
+		svc.ListReconciliationProposals,
+		connect.WithSchema(receiptsServiceListReconciliationProposalsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptsServiceReconcileReceiptHandler := connect.NewUnaryHandler(
+		ReceiptsServiceReconcileReceiptProcedure,
+		svc.ReconcileReceipt,
+		connect.WithSchema(receiptsServiceReconcileReceiptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptsServiceGetVATReportHandler := connect.NewUnaryHandler(
+		ReceiptsServiceGetVATReportProcedure,
+		svc.GetVATReport,
+		connect.WithSchema(receiptsServiceGetVATReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptsServiceGenerateExpenseReportHandler := connect.NewUnaryHandler(
+		ReceiptsServiceGenerateExpenseReportProcedure,
+		svc.GenerateExpenseReport,
+		connect.WithSchema(receiptsServiceGenerateExpenseReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/receipts.v1.ReceiptsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ReceiptsServiceCreateReceiptsProcedure:
 			receiptsServiceCreateReceiptsHandler.ServeHTTP(w, r)
+		case ReceiptsServiceUploadReceiptsProcedure:
+			receiptsServiceUploadReceiptsHandler.ServeHTTP(w, r)
 		case ReceiptsServiceUpdateReceiptProcedure:
 			receiptsServiceUpdateReceiptHandler.ServeHTTP(w, r)
 		case ReceiptsServiceDeleteReceiptProcedure:
@@ -203,6 +425,20 @@ func NewReceiptsServiceHandler(svc ReceiptsServiceHandler, opts ...connect.Handl
 			receiptsServiceListReceiptsHandler.ServeHTTP(w, r)
 		case ReceiptsServiceGetReceiptProcedure:
 			receiptsServiceGetReceiptHandler.ServeHTTP(w, r)
+		case ReceiptsServiceReparseReceiptProcedure:
+			receiptsServiceReparseReceiptHandler.ServeHTTP(w, r)
+		case ReceiptsServiceApplyReceiptChangesProcedure:
+			receiptsServiceApplyReceiptChangesHandler.ServeHTTP(w, r)
+		case ReceiptsServiceSearchReceiptsProcedure:
+			receiptsServiceSearchReceiptsHandler.ServeHTTP(w, r)
+		case ReceiptsServiceListReconciliationProposalsProcedure:
+			receiptsServiceListReconciliationProposalsHandler.ServeHTTP(w, r)
+		case ReceiptsServiceReconcileReceiptProcedure:
+			receiptsServiceReconcileReceiptHandler.ServeHTTP(w, r)
+		case ReceiptsServiceGetVATReportProcedure:
+			receiptsServiceGetVATReportHandler.ServeHTTP(w, r)
+		case ReceiptsServiceGenerateExpenseReportProcedure:
+			receiptsServiceGenerateExpenseReportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -216,6 +452,10 @@ func (UnimplementedReceiptsServiceHandler) CreateReceipts(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.CreateReceipts is not implemented"))
 }
 
+func (UnimplementedReceiptsServiceHandler) UploadReceipts(context.Context, *connect.ClientStream[receipts_v1.UploadReceiptsRequest]) (*connect.Response[receipts_v1.CreateReceiptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.UploadReceipts is not implemented"))
+}
+
 func (UnimplementedReceiptsServiceHandler) UpdateReceipt(context.Context, *connect.Request[receipts_v1.UpdateReceiptRequest]) (*connect.Response[receipts_v1.UpdateReceiptResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.UpdateReceipt is not implemented"))
 }
@@ -231,3 +471,31 @@ func (UnimplementedReceiptsServiceHandler) ListReceipts(context.Context, *connec
 func (UnimplementedReceiptsServiceHandler) GetReceipt(context.Context, *connect.Request[receipts_v1.GetReceiptRequest]) (*connect.Response[receipts_v1.GetReceiptResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.GetReceipt is not implemented"))
 }
+
+func (UnimplementedReceiptsServiceHandler) ReparseReceipt(context.Context, *connect.Request[receipts_v1.ReparseReceiptRequest]) (*connect.Response[receipts_v1.ReparseReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.ReparseReceipt is not implemented"))
+}
+
+func (UnimplementedReceiptsServiceHandler) ApplyReceiptChanges(context.Context, *connect.Request[receipts_v1.ApplyReceiptChangesRequest]) (*connect.Response[receipts_v1.ApplyReceiptChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.ApplyReceiptChanges is not implemented"))
+}
+
+func (UnimplementedReceiptsServiceHandler) SearchReceipts(context.Context, *connect.Request[receipts_v1.SearchReceiptsRequest]) (*connect.Response[receipts_v1.SearchReceiptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.SearchReceipts is not implemented"))
+}
+
+func (UnimplementedReceiptsServiceHandler) ListReconciliationProposals(context.Context, *connect.Request[receipts_v1.ListReconciliationProposalsRequest]) (*connect.Response[receipts_v1.ListReconciliationProposalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.ListReconciliationProposals is not implemented"))
+}
+
+func (UnimplementedReceiptsServiceHandler) ReconcileReceipt(context.Context, *connect.Request[receipts_v1.ReconcileReceiptRequest]) (*connect.Response[receipts_v1.ReconcileReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.ReconcileReceipt is not implemented"))
+}
+
+func (UnimplementedReceiptsServiceHandler) GetVATReport(context.Context, *connect.Request[receipts_v1.GetVATReportRequest]) (*connect.Response[receipts_v1.GetVATReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.GetVATReport is not implemented"))
+}
+
+func (UnimplementedReceiptsServiceHandler) GenerateExpenseReport(context.Context, *connect.Request[receipts_v1.GenerateExpenseReportRequest]) (*connect.Response[receipts_v1.GenerateExpenseReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("receipts.v1.ReceiptsService.GenerateExpenseReport is not implemented"))
+}
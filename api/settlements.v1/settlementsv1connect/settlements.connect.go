@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: settlements.v1/settlements.proto
+
+package settlementsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	settlements_v1 "github.com/manzanit0/mcduck/api/settlements.v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SettlementsServiceName is the fully-qualified name of the SettlementsService service.
+	SettlementsServiceName = "settlements.v1.SettlementsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SettlementsServiceSplitExpenseProcedure is the fully-qualified name of the SettlementsService's
+	// SplitExpense RPC.
+	SettlementsServiceSplitExpenseProcedure = "/settlements.v1.SettlementsService/SplitExpense"
+	// SettlementsServiceRemoveExpenseSplitProcedure is the fully-qualified name of the
+	// SettlementsService's RemoveExpenseSplit RPC.
+	SettlementsServiceRemoveExpenseSplitProcedure = "/settlements.v1.SettlementsService/RemoveExpenseSplit"
+	// SettlementsServiceGetBalancesProcedure is the fully-qualified name of the SettlementsService's
+	// GetBalances RPC.
+	SettlementsServiceGetBalancesProcedure = "/settlements.v1.SettlementsService/GetBalances"
+	// SettlementsServiceCreateSettlementProcedure is the fully-qualified name of the
+	// SettlementsService's CreateSettlement RPC.
+	SettlementsServiceCreateSettlementProcedure = "/settlements.v1.SettlementsService/CreateSettlement"
+	// SettlementsServiceListSettlementsProcedure is the fully-qualified name of the
+	// SettlementsService's ListSettlements RPC.
+	SettlementsServiceListSettlementsProcedure = "/settlements.v1.SettlementsService/ListSettlements"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	settlementsServiceServiceDescriptor                  = settlements_v1.File_settlements_v1_settlements_proto.Services().ByName("SettlementsService")
+	settlementsServiceSplitExpenseMethodDescriptor       = settlementsServiceServiceDescriptor.Methods().ByName("SplitExpense")
+	settlementsServiceRemoveExpenseSplitMethodDescriptor = settlementsServiceServiceDescriptor.Methods().ByName("RemoveExpenseSplit")
+	settlementsServiceGetBalancesMethodDescriptor        = settlementsServiceServiceDescriptor.Methods().ByName("GetBalances")
+	settlementsServiceCreateSettlementMethodDescriptor   = settlementsServiceServiceDescriptor.Methods().ByName("CreateSettlement")
+	settlementsServiceListSettlementsMethodDescriptor    = settlementsServiceServiceDescriptor.Methods().ByName("ListSettlements")
+)
+
+// SettlementsServiceClient is a client for the settlements.v1.SettlementsService service.
+type SettlementsServiceClient interface {
+	SplitExpense(context.Context, *connect.Request[settlements_v1.SplitExpenseRequest]) (*connect.Response[settlements_v1.SplitExpenseResponse], error)
+	RemoveExpenseSplit(context.Context, *connect.Request[settlements_v1.RemoveExpenseSplitRequest]) (*connect.Response[settlements_v1.RemoveExpenseSplitResponse], error)
+	GetBalances(context.Context, *connect.Request[settlements_v1.GetBalancesRequest]) (*connect.Response[settlements_v1.GetBalancesResponse], error)
+	CreateSettlement(context.Context, *connect.Request[settlements_v1.CreateSettlementRequest]) (*connect.Response[settlements_v1.CreateSettlementResponse], error)
+	ListSettlements(context.Context, *connect.Request[settlements_v1.ListSettlementsRequest]) (*connect.Response[settlements_v1.ListSettlementsResponse], error)
+}
+
+// NewSettlementsServiceClient constructs a client for the settlements.v1.SettlementsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSettlementsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SettlementsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &settlementsServiceClient{
+		splitExpense: connect.NewClient[settlements_v1.SplitExpenseRequest, settlements_v1.SplitExpenseResponse](
+			httpClient,
+			baseURL+SettlementsServiceSplitExpenseProcedure,
+			connect.WithSchema(settlementsServiceSplitExpenseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeExpenseSplit: connect.NewClient[settlements_v1.RemoveExpenseSplitRequest, settlements_v1.RemoveExpenseSplitResponse](
+			httpClient,
+			baseURL+SettlementsServiceRemoveExpenseSplitProcedure,
+			connect.WithSchema(settlementsServiceRemoveExpenseSplitMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getBalances: connect.NewClient[settlements_v1.GetBalancesRequest, settlements_v1.GetBalancesResponse](
+			httpClient,
+			baseURL+SettlementsServiceGetBalancesProcedure,
+			connect.WithSchema(settlementsServiceGetBalancesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createSettlement: connect.NewClient[settlements_v1.CreateSettlementRequest, settlements_v1.CreateSettlementResponse](
+			httpClient,
+			baseURL+SettlementsServiceCreateSettlementProcedure,
+			connect.WithSchema(settlementsServiceCreateSettlementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listSettlements: connect.NewClient[settlements_v1.ListSettlementsRequest, settlements_v1.ListSettlementsResponse](
+			httpClient,
+			baseURL+SettlementsServiceListSettlementsProcedure,
+			connect.WithSchema(settlementsServiceListSettlementsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// settlementsServiceClient implements SettlementsServiceClient.
+type settlementsServiceClient struct {
+	splitExpense       *connect.Client[settlements_v1.SplitExpenseRequest, settlements_v1.SplitExpenseResponse]
+	removeExpenseSplit *connect.Client[settlements_v1.RemoveExpenseSplitRequest, settlements_v1.RemoveExpenseSplitResponse]
+	getBalances        *connect.Client[settlements_v1.GetBalancesRequest, settlements_v1.GetBalancesResponse]
+	createSettlement   *connect.Client[settlements_v1.CreateSettlementRequest, settlements_v1.CreateSettlementResponse]
+	listSettlements    *connect.Client[settlements_v1.ListSettlementsRequest, settlements_v1.ListSettlementsResponse]
+}
+
+// SplitExpense calls settlements.v1.SettlementsService.SplitExpense.
+func (c *settlementsServiceClient) SplitExpense(ctx context.Context, req *connect.Request[settlements_v1.SplitExpenseRequest]) (*connect.Response[settlements_v1.SplitExpenseResponse], error) {
+	return c.splitExpense.CallUnary(ctx, req)
+}
+
+// RemoveExpenseSplit calls settlements.v1.SettlementsService.RemoveExpenseSplit.
+func (c *settlementsServiceClient) RemoveExpenseSplit(ctx context.Context, req *connect.Request[settlements_v1.RemoveExpenseSplitRequest]) (*connect.Response[settlements_v1.RemoveExpenseSplitResponse], error) {
+	return c.removeExpenseSplit.CallUnary(ctx, req)
+}
+
+// GetBalances calls settlements.v1.SettlementsService.GetBalances.
+func (c *settlementsServiceClient) GetBalances(ctx context.Context, req *connect.Request[settlements_v1.GetBalancesRequest]) (*connect.Response[settlements_v1.GetBalancesResponse], error) {
+	return c.getBalances.CallUnary(ctx, req)
+}
+
+// CreateSettlement calls settlements.v1.SettlementsService.CreateSettlement.
+func (c *settlementsServiceClient) CreateSettlement(ctx context.Context, req *connect.Request[settlements_v1.CreateSettlementRequest]) (*connect.Response[settlements_v1.CreateSettlementResponse], error) {
+	return c.createSettlement.CallUnary(ctx, req)
+}
+
+// ListSettlements calls settlements.v1.SettlementsService.ListSettlements.
+func (c *settlementsServiceClient) ListSettlements(ctx context.Context, req *connect.Request[settlements_v1.ListSettlementsRequest]) (*connect.Response[settlements_v1.ListSettlementsResponse], error) {
+	return c.listSettlements.CallUnary(ctx, req)
+}
+
+// SettlementsServiceHandler is an implementation of the settlements.v1.SettlementsService service.
+type SettlementsServiceHandler interface {
+	SplitExpense(context.Context, *connect.Request[settlements_v1.SplitExpenseRequest]) (*connect.Response[settlements_v1.SplitExpenseResponse], error)
+	RemoveExpenseSplit(context.Context, *connect.Request[settlements_v1.RemoveExpenseSplitRequest]) (*connect.Response[settlements_v1.RemoveExpenseSplitResponse], error)
+	GetBalances(context.Context, *connect.Request[settlements_v1.GetBalancesRequest]) (*connect.Response[settlements_v1.GetBalancesResponse], error)
+	CreateSettlement(context.Context, *connect.Request[settlements_v1.CreateSettlementRequest]) (*connect.Response[settlements_v1.CreateSettlementResponse], error)
+	ListSettlements(context.Context, *connect.Request[settlements_v1.ListSettlementsRequest]) (*connect.Response[settlements_v1.ListSettlementsResponse], error)
+}
+
+// NewSettlementsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSettlementsServiceHandler(svc SettlementsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	settlementsServiceSplitExpenseHandler := connect.NewUnaryHandler(
+		SettlementsServiceSplitExpenseProcedure,
+		svc.SplitExpense,
+		connect.WithSchema(settlementsServiceSplitExpenseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	settlementsServiceRemoveExpenseSplitHandler := connect.NewUnaryHandler(
+		SettlementsServiceRemoveExpenseSplitProcedure,
+		svc.RemoveExpenseSplit,
+		connect.WithSchema(settlementsServiceRemoveExpenseSplitMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	settlementsServiceGetBalancesHandler := connect.NewUnaryHandler(
+		SettlementsServiceGetBalancesProcedure,
+		svc.GetBalances,
+		connect.WithSchema(settlementsServiceGetBalancesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	settlementsServiceCreateSettlementHandler := connect.NewUnaryHandler(
+		SettlementsServiceCreateSettlementProcedure,
+		svc.CreateSettlement,
+		connect.WithSchema(settlementsServiceCreateSettlementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	settlementsServiceListSettlementsHandler := connect.NewUnaryHandler(
+		SettlementsServiceListSettlementsProcedure,
+		svc.ListSettlements,
+		connect.WithSchema(settlementsServiceListSettlementsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/settlements.v1.SettlementsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SettlementsServiceSplitExpenseProcedure:
+			settlementsServiceSplitExpenseHandler.ServeHTTP(w, r)
+		case SettlementsServiceRemoveExpenseSplitProcedure:
+			settlementsServiceRemoveExpenseSplitHandler.ServeHTTP(w, r)
+		case SettlementsServiceGetBalancesProcedure:
+			settlementsServiceGetBalancesHandler.ServeHTTP(w, r)
+		case SettlementsServiceCreateSettlementProcedure:
+			settlementsServiceCreateSettlementHandler.ServeHTTP(w, r)
+		case SettlementsServiceListSettlementsProcedure:
+			settlementsServiceListSettlementsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSettlementsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSettlementsServiceHandler struct{}
+
+func (UnimplementedSettlementsServiceHandler) SplitExpense(context.Context, *connect.Request[settlements_v1.SplitExpenseRequest]) (*connect.Response[settlements_v1.SplitExpenseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("settlements.v1.SettlementsService.SplitExpense is not implemented"))
+}
+
+func (UnimplementedSettlementsServiceHandler) RemoveExpenseSplit(context.Context, *connect.Request[settlements_v1.RemoveExpenseSplitRequest]) (*connect.Response[settlements_v1.RemoveExpenseSplitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("settlements.v1.SettlementsService.RemoveExpenseSplit is not implemented"))
+}
+
+func (UnimplementedSettlementsServiceHandler) GetBalances(context.Context, *connect.Request[settlements_v1.GetBalancesRequest]) (*connect.Response[settlements_v1.GetBalancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("settlements.v1.SettlementsService.GetBalances is not implemented"))
+}
+
+func (UnimplementedSettlementsServiceHandler) CreateSettlement(context.Context, *connect.Request[settlements_v1.CreateSettlementRequest]) (*connect.Response[settlements_v1.CreateSettlementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("settlements.v1.SettlementsService.CreateSettlement is not implemented"))
+}
+
+func (UnimplementedSettlementsServiceHandler) ListSettlements(context.Context, *connect.Request[settlements_v1.ListSettlementsRequest]) (*connect.Response[settlements_v1.ListSettlementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("settlements.v1.SettlementsService.ListSettlements is not implemented"))
+}
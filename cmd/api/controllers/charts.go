@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/manzanit0/mcduck/internal/chart"
+	"github.com/manzanit0/mcduck/internal/dashboard"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+type ChartsController struct {
+	Expenses *expense.Repository
+}
+
+// GetChart renders one of the dashboard charts as an image. By default it's
+// the spend per category, but when a category is provided it's the spend per
+// subcategory of that category.
+func (d *ChartsController) GetChart(c *gin.Context) {
+	ctx, span := xtrace.GetSpan(c.Request.Context())
+
+	kind := chart.Kind(c.DefaultQuery("kind", string(chart.KindBar)))
+	if !kind.Valid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown chart kind %q", kind)})
+		return
+	}
+
+	format := chart.Format(c.DefaultQuery("format", string(chart.FormatPNG)))
+	if format != chart.FormatPNG && format != chart.FormatSVG {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown chart format %q", format)})
+		return
+	}
+
+	expenses, err := d.Expenses.ListExpenses(ctx, auth.GetUserEmail(c))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed list expenses", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to list expenses: %s", err.Error())})
+		return
+	}
+
+	dash := dashboard.Build(expenses)
+
+	title := "Spend per category"
+	data := dash.CategoriesChart
+	if category := c.Query("category"); category != "" {
+		title = category
+		data = dashboard.ChartData{}
+		for _, sub := range dash.SubcategoriesCharts {
+			if sub.Title == category {
+				data = sub
+			}
+		}
+	}
+
+	img, err := chart.FromChartData(kind, title, data)
+	if err != nil && errors.Is(err, chart.ErrNoData) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "there are no expenses to chart"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to build chart: %s", err.Error())})
+		return
+	}
+
+	var b bytes.Buffer
+	err = chart.Render(&b, img, format)
+	if err != nil && errors.Is(err, chart.ErrNoData) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "there are no expenses to chart"})
+		return
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed render chart", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to render chart: %s", err.Error())})
+		return
+	}
+
+	c.Data(http.StatusOK, format.ContentType(), b.Bytes())
+}
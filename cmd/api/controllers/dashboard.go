@@ -3,117 +3,38 @@ package controllers
 import (
 	"database/sql"
 	"errors"
-	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"path/filepath"
-	"sort"
-	"time"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel/codes"
 
+	"github.com/manzanit0/mcduck/internal/account"
+	"github.com/manzanit0/mcduck/internal/dashboard"
 	"github.com/manzanit0/mcduck/internal/expense"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 )
 
-var chartColours = []string{
-	"rgba(255, 99, 132)",
-	"rgba(255, 159, 64)",
-	"rgba(255, 205, 86)",
-	"rgba(75, 192, 192)",
-	"rgba(54, 162, 235)",
-	"rgba(153, 102, 255)",
-	"rgba(201, 203, 207)",
-	// repeated
-	"rgba(255, 99, 132)",
-	"rgba(255, 159, 64)",
-	"rgba(255, 205, 86)",
-	"rgba(75, 192, 192)",
-	"rgba(54, 162, 235)",
-	"rgba(153, 102, 255)",
-	"rgba(201, 203, 207)",
-}
-
-var chartBackgroundColours = []string{
-	"rgba(255, 99, 132, 0.2)",
-	"rgba(255, 159, 64, 0.2)",
-	"rgba(255, 205, 86, 0.2)",
-	"rgba(75, 192, 192, 0.2)",
-	"rgba(54, 162, 235, 0.2)",
-	"rgba(153, 102, 255, 0.2)",
-	"rgba(201, 203, 207, 0.2)",
-	// repeated
-	"rgba(255, 99, 132, 0.2)",
-	"rgba(255, 159, 64, 0.2)",
-	"rgba(255, 205, 86, 0.2)",
-	"rgba(75, 192, 192, 0.2)",
-	"rgba(54, 162, 235, 0.2)",
-	"rgba(153, 102, 255, 0.2)",
-	"rgba(201, 203, 207, 0.2)",
-}
-
-type ChartData struct {
-	Title    string
-	Labels   []string
-	Datasets []Dataset
-}
-
-type Dataset struct {
-	Label            string
-	BorderColour     string
-	BackgroundColour string
-	Hidden           bool
-	Data             []string
-}
-
 type DashboardController struct {
 	Expenses   *expense.Repository
+	Accounts   *account.Repository
 	SampleData []expense.Expense
 }
 
 func (d *DashboardController) LiveDemo(c *gin.Context) {
-	expenses := d.SampleData
-
-	expense.SortByDate(expenses)
-
-	mostRecent := expense.FindMostRecentTime(expenses)
-	mostRecentMonthYear := expense.NewMonthYear(mostRecent)
-
-	categoryTotals := expense.CalculateTotalsPerCategory(expenses)
-	categoryLabels := getSecondClassifier(categoryTotals)
-	categoryChartData := buildChartData(categoryLabels, categoryTotals)
+	dash := dashboard.Build(d.SampleData)
 
 	// Since this is for public demoing, we might as well show-off the whole data
 	// off the bat.
-	for i := range categoryChartData.Datasets {
-		categoryChartData.Datasets[i].Hidden = false
+	for i := range dash.CategoriesChart.Datasets {
+		dash.CategoriesChart.Datasets[i].Hidden = false
 	}
 
-	var subcategoryCharts []ChartData
-	for cat, subcats := range GroupSubcategoriesByCategory(expenses) {
-		filtered := FilterByCategory(expenses, cat)
-		subcategoryTotals := expense.CalculateTotalsPerSubCategory(filtered)
-		subcategoryChartData := buildChartData(subcats, subcategoryTotals)
-
-		subcategoryChartData.Title = cat
-
-		subcategoryCharts = append(subcategoryCharts, subcategoryChartData)
-	}
-
-	totalSpendsArr := TotalSpendLastThreeMonths(expenses)
-
-	c.HTML(http.StatusOK, "dashboard.html", gin.H{
-		"PrettyMonthYear":        mostRecent.Format("January 2006"),
-		"NoExpenses":             len(expenses) == 0,
-		"Categories":             categoryLabels,
-		"CategoriesChartData":    categoryChartData,
-		"SubcategoriesChartData": subcategoryCharts,
-		"TopCategories":          expense.GetTop3ExpenseCategories(expenses, mostRecentMonthYear),
-		"TotalSpends":            totalSpendsArr,
-	})
+	c.HTML(http.StatusOK, "dashboard.html", dashboardTemplateData(dash, len(d.SampleData) == 0))
 }
 
 func (d *DashboardController) Dashboard(c *gin.Context) {
@@ -132,164 +53,30 @@ func (d *DashboardController) Dashboard(c *gin.Context) {
 		expenses = []expense.Expense{}
 	}
 
-	expense.SortByDate(expenses)
-
-	mostRecent := expense.FindMostRecentTime(expenses)
-	mostRecentMonthYear := expense.NewMonthYear(mostRecent)
-
-	categoryTotals := expense.CalculateTotalsPerCategory(expenses)
-	categoryLabels := getSecondClassifier(categoryTotals)
-	categoryChartData := buildChartData(categoryLabels, categoryTotals)
-
-	var subcategoryCharts []ChartData
-	for cat, subcats := range GroupSubcategoriesByCategory(expenses) {
-		filtered := FilterByCategory(expenses, cat)
-		subcategoryTotals := expense.CalculateTotalsPerSubCategory(filtered)
-		subcategoryChartData := buildChartData(subcats, subcategoryTotals)
-
-		subcategoryChartData.Title = cat
-
-		subcategoryCharts = append(subcategoryCharts, subcategoryChartData)
-	}
-
-	totalSpendsArr := TotalSpendLastThreeMonths(expenses)
-
-	c.HTML(http.StatusOK, "dashboard.html", gin.H{
-		"PrettyMonthYear":        mostRecent.Format("January 2006"),
-		"NoExpenses":             len(expenses) == 0,
-		"Categories":             categoryLabels,
-		"CategoriesChartData":    categoryChartData,
-		"SubcategoriesChartData": subcategoryCharts,
-		"TopCategories":          expense.GetTop3ExpenseCategories(expenses, mostRecentMonthYear),
-		"TotalSpends":            totalSpendsArr,
-		"User":                   user,
-	})
-}
-
-type MonthlySpend struct {
-	date      time.Time
-	amount    float32
-	MonthYear string
-	Amount    string
-}
-
-func TotalSpendLastThreeMonths(expenses []expense.Expense) []*MonthlySpend {
-	latest := expense.FindMostRecentTime(expenses)
-	totalSpends := map[string]*MonthlySpend{}
-	for i := range expenses {
-		if isOlderThanLastThreeMonths(expenses[i].Date, latest) {
-			continue
-		}
-
-		key := expenses[i].Date.Format("January 2006")
-		val, ok := totalSpends[key]
-		if !ok {
-			totalSpends[key] = &MonthlySpend{
-				date:      expenses[i].Date,
-				MonthYear: key,
-				amount:    expenses[i].Amount,
-				Amount:    fmt.Sprintf("%.2f", expenses[i].Amount),
-			}
-		} else {
-			val.amount += expenses[i].Amount
-			val.Amount = fmt.Sprintf("%.2f", val.amount)
-		}
-	}
-
-	sortedTotalSpends := []*MonthlySpend{}
-	for _, a := range totalSpends {
-		sortedTotalSpends = append(sortedTotalSpends, a)
-	}
-
-	sort.Slice(sortedTotalSpends, func(i, j int) bool {
-		return sortedTotalSpends[i].date.Before(sortedTotalSpends[j].date)
-	})
-
-	return sortedTotalSpends
-}
-
-func isOlderThanLastThreeMonths(t time.Time, latest time.Time) bool {
-	// 15th of March 2022-> 15th of December 2022
-	year, month, _ := latest.AddDate(0, -2, 0).Date()
-
-	// 1st of December 2022
-	beginningOf3MonthsAgo := time.Date(year, month, 1, 0, 0, 0, 0, time.Now().Location())
-
-	return t.Before(beginningOf3MonthsAgo)
-}
-
-func FilterByCategory(list []expense.Expense, cat string) []expense.Expense {
-	var filtered []expense.Expense
-	for i := range list {
-		if list[i].Category == cat {
-			filtered = append(filtered, list[i])
-		}
-	}
-
-	return filtered
-}
-
-func GroupSubcategoriesByCategory(list []expense.Expense) map[string][]string {
-	m := map[string]map[string]bool{}
-	for _, e := range list {
-		if _, ok := m[e.Category]; !ok {
-			m[e.Category] = map[string]bool{}
-		}
-
-		m[e.Category][e.Subcategory] = true
+	accounts, err := d.Accounts.ListAccounts(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed list accounts", "error", err.Error())
+		c.HTML(http.StatusOK, "error.html", gin.H{"error": err.Error()})
+		return
 	}
 
-	mm := map[string][]string{}
-	for k, v := range m {
-		if _, ok := mm[k]; !ok {
-			mm[k] = []string{}
-		}
-
-		for s := range v {
-			mm[k] = append(mm[k], s)
-		}
-	}
+	data := dashboardTemplateData(dashboard.Build(expenses), len(expenses) == 0)
+	data["User"] = user
+	data["Accounts"] = accounts
 
-	return mm
+	c.HTML(http.StatusOK, "dashboard.html", data)
 }
 
-func buildChartData(labels []string, totals map[string]map[string]float32) ChartData {
-	var datasets []Dataset
-	for monthYear, amountsByCategory := range totals { // totalsByMonth[monthYear][expense.Category] += expense.Amount
-		var data []string
-		for _, label := range labels {
-			if amount, ok := amountsByCategory[label]; ok {
-				data = append(data, fmt.Sprintf("%.2f", amount))
-			} else {
-				data = append(data, "0.00")
-			}
-		}
-
-		datasets = append(datasets, Dataset{
-			Label:  monthYear,
-			Data:   data,
-			Hidden: true,
-		})
-	}
-
-	// FIXME: very naive sort. We would want to do a time comparison.
-	sort.Slice(datasets, func(i, j int) bool {
-		return datasets[i].Label < datasets[j].Label
-	})
-
-	// By default we only show the current month.
-	if len(datasets) > 0 {
-		datasets[len(datasets)-1].Hidden = false
-	}
-
-	for i := range datasets {
-		datasets[i].BorderColour = chartColours[i]
-		datasets[i].BackgroundColour = chartBackgroundColours[i]
-	}
-
-	return ChartData{
-		Labels:   labels,
-		Datasets: datasets,
+func dashboardTemplateData(dash *dashboard.Dashboard, noExpenses bool) gin.H {
+	return gin.H{
+		"PrettyMonthYear":        dash.PrettyMonthYear(),
+		"NoExpenses":             noExpenses,
+		"Categories":             dash.Categories,
+		"CategoriesChartData":    dash.CategoriesChart,
+		"SubcategoriesChartData": dash.SubcategoriesCharts,
+		"TopCategories":          dash.TopCategories,
+		"TotalSpends":            dash.TotalSpends,
 	}
 }
 
@@ -327,7 +114,32 @@ func (d *DashboardController) UploadExpenses(c *gin.Context) {
 	// If the user is logged in, save those upload expenses
 	user := auth.GetUserEmail(c)
 	if user != "" {
-		err = d.Expenses.CreateExpenses(c.Request.Context(), expense.ExpensesBatch{UserEmail: user, Records: expenses})
+		var accountID uint64
+		if raw := c.PostForm("account_id"); raw != "" {
+			accountID, err = strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				c.String(http.StatusBadRequest, "invalid account: %s", err.Error())
+				return
+			}
+
+			a, err := d.Accounts.FindAccount(ctx, accountID)
+			if err != nil && errors.Is(err, sql.ErrNoRows) {
+				c.String(http.StatusBadRequest, "account %d doesn't exist", accountID)
+				return
+			} else if err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				slog.ErrorContext(ctx, "failed find account", "error", err.Error())
+				c.String(http.StatusInternalServerError, "find account error: %s", err.Error())
+				return
+			}
+
+			if a.UserEmail != user {
+				c.String(http.StatusBadRequest, "account %d doesn't exist", accountID)
+				return
+			}
+		}
+
+		err = d.Expenses.CreateExpenses(c.Request.Context(), expense.ExpensesBatch{UserEmail: user, Records: expenses, AccountID: accountID})
 		if err != nil {
 			span.SetStatus(codes.Error, err.Error())
 			slog.ErrorContext(ctx, "failed create expenses", "error", err.Error())
@@ -336,45 +148,10 @@ func (d *DashboardController) UploadExpenses(c *gin.Context) {
 		}
 	}
 
-	expense.SortByDate(expenses)
-
-	mostRecent := expense.FindMostRecentTime(expenses)
-	mostRecentMonthYear := expense.NewMonthYear(mostRecent)
-
-	categoryTotals := expense.CalculateTotalsPerCategory(expenses)
-	categoryLabels := getSecondClassifier(categoryTotals)
-	categoryChartData := buildChartData(categoryLabels, categoryTotals)
-
-	subcategoryTotals := expense.CalculateTotalsPerSubCategory(expenses)
-	subcategoryLabels := getSecondClassifier(subcategoryTotals)
-	subcategoryChartData := buildChartData(subcategoryLabels, subcategoryTotals)
-
-	c.HTML(http.StatusOK, "dashboard.html", gin.H{
-		"PrettyMonthYear":        mostRecent.Format("January 2006"),
-		"NoExpenses":             len(expenses) == 0,
-		"Categories":             categoryLabels,
-		"CategoriesChartData":    categoryChartData,
-		"SubCategories":          subcategoryLabels,
-		"SubCategoriesChartData": subcategoryChartData,
-		"TopCategories":          expense.GetTop3ExpenseCategories(expenses, mostRecentMonthYear),
-		"User":                   user,
-	})
-}
-
-func getSecondClassifier(calculations map[string]map[string]float32) []string {
-	classifierMap := map[string]bool{}
-	classifierSlice := []string{}
-	for _, amountByClassifier := range calculations {
-		for secondClassifier := range amountByClassifier {
-			if ok := classifierMap[secondClassifier]; !ok {
-				classifierMap[secondClassifier] = true
-				classifierSlice = append(classifierSlice, secondClassifier)
-			}
-		}
-	}
+	data := dashboardTemplateData(dashboard.Build(expenses), len(expenses) == 0)
+	data["User"] = user
 
-	sort.Strings(classifierSlice)
-	return classifierSlice
+	c.HTML(http.StatusOK, "dashboard.html", data)
 }
 
 func readExpensesFromCSV(filename string) ([]expense.Expense, error) {
@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -12,13 +14,17 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel/codes"
 
+	"github.com/manzanit0/mcduck/internal/account"
 	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 )
 
 type ExpensesController struct {
-	Expenses *expense.Repository
+	Expenses   *expense.Repository
+	Accounts   *account.Repository
+	Households *household.Repository
 }
 
 type ExpenseViewModel struct {
@@ -59,6 +65,14 @@ func (d *ExpensesController) ListExpenses(c *gin.Context) {
 		return
 	}
 
+	accounts, err := d.Accounts.ListAccounts(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.Error("failed to list accounts", "error", err.Error())
+		c.HTML(http.StatusOK, "error.html", gin.H{"error": err.Error()})
+		return
+	}
+
 	// Sort the most recent first
 	sort.Slice(expenses, func(i, j int) bool {
 		return expenses[i].Date.After(expenses[j].Date)
@@ -68,6 +82,7 @@ func (d *ExpensesController) ListExpenses(c *gin.Context) {
 		"User":        user,
 		"HasExpenses": len(expenses) > 0,
 		"Expenses":    MapExpenses(expenses),
+		"Accounts":    accounts,
 	})
 }
 
@@ -78,6 +93,7 @@ type UpdateExpense struct {
 	Subcategory *string  `json:"subcategory"`
 	Description *string  `json:"description"`
 	ReceiptID   *uint64  `json:"receipt_id,string"`
+	AccountID   *uint64  `json:"account_id,string"`
 }
 
 func (d *ExpensesController) UpdateExpense(c *gin.Context) {
@@ -112,6 +128,12 @@ func (d *ExpensesController) UpdateExpense(c *gin.Context) {
 		date = &d
 	}
 
+	if payload.AccountID != nil && *payload.AccountID != 0 {
+		if ok := d.checkAccountOwnership(c, *payload.AccountID); !ok {
+			return
+		}
+	}
+
 	err = d.Expenses.UpdateExpense(ctx, expense.UpdateExpenseRequest{
 		ID:          i,
 		Date:        date,
@@ -120,6 +142,7 @@ func (d *ExpensesController) UpdateExpense(c *gin.Context) {
 		Subcategory: payload.Subcategory,
 		Description: payload.Description,
 		ReceiptID:   payload.ReceiptID,
+		AccountID:   payload.AccountID,
 	})
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
@@ -135,6 +158,7 @@ type CreateExpensePayload struct {
 	Date      string  `json:"date"`
 	Amount    float32 `json:"amount,string"`
 	ReceiptID *uint64 `json:"receipt_id,string"`
+	AccountID *uint64 `json:"account_id,string"`
 }
 
 type CreateExpenseResponse struct {
@@ -161,11 +185,22 @@ func (d *ExpensesController) CreateExpense(c *gin.Context) {
 		return
 	}
 
+	if payload.AccountID != nil && *payload.AccountID == 0 {
+		payload.AccountID = nil
+	}
+
+	if payload.AccountID != nil {
+		if ok := d.checkAccountOwnership(c, *payload.AccountID); !ok {
+			return
+		}
+	}
+
 	expenseID, err := d.Expenses.CreateExpense(ctx, expense.CreateExpenseRequest{
 		UserEmail: auth.GetUserEmail(c),
 		Date:      date,
 		Amount:    payload.Amount,
 		ReceiptID: payload.ReceiptID,
+		AccountID: payload.AccountID,
 	})
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
@@ -228,6 +263,19 @@ func (d *ExpensesController) MergeExpenses(c *gin.Context) {
 			return
 		}
 
+		ok, err := d.Households.Authorize(ctx, auth.GetUserEmail(c), expense.UserEmail, household.AccessWrite)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, "failed to authorize user", "error", err.Error())
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to authorize user: %s", err.Error())})
+			return
+		}
+
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": fmt.Sprintf("expense with ID %d doesn't belong to requesting user", expense.ID)})
+			return
+		}
+
 		if expense.ReceiptID != payload.ReceiptID {
 			errorMessage := fmt.Sprintf("expense with ID %d doesn't belong to receipt %d", expense.ID, payload.ReceiptID)
 			span.SetStatus(codes.Error, errorMessage)
@@ -241,9 +289,16 @@ func (d *ExpensesController) MergeExpenses(c *gin.Context) {
 		expenses = append(expenses, expense)
 	}
 
+	// The merged expense stays in the ledger of the original ones, even when it's
+	// another member of the household doing the merge.
+	owner := auth.GetUserEmail(c)
+	if len(expenses) > 0 {
+		owner = expenses[0].UserEmail
+	}
+
 	// FIXME: create and delete should be done atomically
 	expenseID, err := d.Expenses.CreateExpense(ctx, expense.CreateExpenseRequest{
-		UserEmail: auth.GetUserEmail(c),
+		UserEmail: owner,
 		Date:      time.Now(),
 		Amount:    total,
 		ReceiptID: &payload.ReceiptID,
@@ -267,3 +322,28 @@ func (d *ExpensesController) MergeExpenses(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"expense_id": expenseID})
 }
+
+// checkAccountOwnership verifies that the account exists and belongs to the
+// logged in user. When it doesn't, it writes the error response and returns
+// false.
+func (d *ExpensesController) checkAccountOwnership(c *gin.Context, accountID uint64) bool {
+	ctx, span := xtrace.GetSpan(c.Request.Context())
+
+	a, err := d.Accounts.FindAccount(ctx, accountID)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("account %d doesn't exist", accountID)})
+		return false
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to find account", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to find account: %s", err.Error())})
+		return false
+	}
+
+	if a.UserEmail != auth.GetUserEmail(c) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("account %d doesn't exist", accountID)})
+		return false
+	}
+
+	return true
+}
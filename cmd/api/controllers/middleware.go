@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
 	"github.com/manzanit0/mcduck/internal/receipt"
 	"github.com/manzanit0/mcduck/pkg/auth"
 )
@@ -36,8 +36,10 @@ func ForceAuthentication(c *gin.Context) {
 }
 
 // ExpenseOwnershipWall validates that the expense ID in the URL parameter
-// belongs to the requesting user, otherwise abouts with Unauthorised status.
-func ExpenseOwnershipWall(repo *expense.Repository) gin.HandlerFunc {
+// belongs to the ledger of the requesting user, otherwise aborts with
+// Unauthorised status. Members of a household with read role can only access
+// the expense through safe methods.
+func ExpenseOwnershipWall(repo *expense.Repository, households *household.Repository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		id := c.Param("id")
 		i, err := strconv.ParseInt(id, 10, 64)
@@ -52,7 +54,13 @@ func ExpenseOwnershipWall(repo *expense.Repository) gin.HandlerFunc {
 			return
 		}
 
-		if !strings.EqualFold(e.UserEmail, auth.GetUserEmail(c)) {
+		ok, err := households.Authorize(c.Request.Context(), auth.GetUserEmail(c), e.UserEmail, requestedAccess(c))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to authorize user: %s", err.Error())})
+			return
+		}
+
+		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "the expense doesn't belong to requesting user"})
 			return
 		}
@@ -61,7 +69,8 @@ func ExpenseOwnershipWall(repo *expense.Repository) gin.HandlerFunc {
 	}
 }
 
-func ReceiptOwnershipWall(repo *receipt.Repository) gin.HandlerFunc {
+// ReceiptOwnershipWall is the receipt counterpart of ExpenseOwnershipWall.
+func ReceiptOwnershipWall(repo *receipt.Repository, households *household.Repository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		id := c.Param("id")
 		i, err := strconv.ParseUint(id, 10, 64)
@@ -76,7 +85,13 @@ func ReceiptOwnershipWall(repo *receipt.Repository) gin.HandlerFunc {
 			return
 		}
 
-		if !strings.EqualFold(receipt.UserEmail, auth.GetUserEmail(c)) {
+		ok, err := households.Authorize(c.Request.Context(), auth.GetUserEmail(c), receipt.UserEmail, requestedAccess(c))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to authorize user: %s", err.Error())})
+			return
+		}
+
+		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "the receipt doesn't belong to requesting user"})
 			return
 		}
@@ -84,3 +99,12 @@ func ReceiptOwnershipWall(repo *receipt.Repository) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+func requestedAccess(c *gin.Context) household.Access {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return household.AccessRead
+	default:
+		return household.AccessWrite
+	}
+}
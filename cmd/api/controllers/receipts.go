@@ -1,36 +1,54 @@
 package controllers
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
-	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"connectrpc.com/connect"
 	"go.opentelemetry.io/otel/codes"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
 	receiptsv1 "github.com/manzanit0/mcduck/api/receipts.v1"
 	"github.com/manzanit0/mcduck/api/receipts.v1/receiptsv1connect"
+	"github.com/manzanit0/mcduck/internal/blob"
 	"github.com/manzanit0/mcduck/internal/client"
 	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/inbound"
 	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/internal/users"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// uploadChunkSize is the size of the chunks files are streamed to the server
+// in.
+const uploadChunkSize = 256 << 10
+
 type ReceiptsController struct {
+	DB       *sqlx.DB
 	Expenses *expense.Repository
 	Receipts *receipt.Repository
 	Parser   client.ParserClient
 
 	ReceiptsClient receiptsv1connect.ReceiptsServiceClient
+
+	// InboundEmailDomain is the domain of the addresses users forward
+	// receipts to. Empty when receiving them by email isn't set up.
+	InboundEmailDomain string
 }
 
 func (d *ReceiptsController) ListReceipts(c *gin.Context) {
@@ -52,6 +70,10 @@ func (d *ReceiptsController) ListReceipts(c *gin.Context) {
 		status = receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW
 	case "reviewed":
 		status = receiptsv1.ReceiptStatus_RECEIPT_STATUS_REVIEWED
+	case "failed":
+		status = receiptsv1.ReceiptStatus_RECEIPT_STATUS_FAILED
+	case "archived":
+		status = receiptsv1.ReceiptStatus_RECEIPT_STATUS_ARCHIVED
 	}
 
 	req := connect.Request[receiptsv1.ListReceiptsRequest]{
@@ -82,13 +104,22 @@ func (d *ReceiptsController) ListReceipts(c *gin.Context) {
 
 	var pendingReviewCount int
 	var reviewedCount int
+	var processingCount int
 	var viewModels []ReceiptViewModel
 	for _, r := range res.Msg.Receipts {
 		pendingReview := "No"
-		if r.Status == receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW {
+		switch r.Status {
+		case receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW:
 			pendingReview = "Yes"
 			pendingReviewCount += 1
-		} else {
+		case receiptsv1.ReceiptStatus_RECEIPT_STATUS_UPLOADED, receiptsv1.ReceiptStatus_RECEIPT_STATUS_PARSING:
+			pendingReview = "Processing"
+			processingCount += 1
+		case receiptsv1.ReceiptStatus_RECEIPT_STATUS_FAILED:
+			pendingReview = "Failed"
+		case receiptsv1.ReceiptStatus_RECEIPT_STATUS_ARCHIVED:
+			pendingReview = "Archived"
+		default:
 			reviewedCount += 1
 		}
 
@@ -105,22 +136,42 @@ func (d *ReceiptsController) ListReceipts(c *gin.Context) {
 			TotalAmount:   fmt.Sprintf("%0.2f", total),
 		}
 
+		if r.DuplicateOf != nil {
+			v.DuplicateOf = fmt.Sprint(r.DuplicateOf.ReceiptId)
+		}
+
 		viewModels = append(viewModels, v)
 	}
 
+	var inboundAddress string
+	if d.InboundEmailDomain != "" {
+		u, err := users.Find(ctx, d.DB, auth.GetUserEmail(c))
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to find user", "error", err.Error())
+		} else {
+			inboundAddress = inbound.Address(u.InboundToken, d.InboundEmailDomain)
+		}
+	}
+
 	c.HTML(http.StatusOK, "list_receipts.html", gin.H{
+		"InboundAddress":        inboundAddress,
 		"User":                  auth.GetUserEmail(c),
 		"HasReceipts":           len(viewModels) > 0,
 		"Receipts":              viewModels,
 		"ReceiptsPendingReview": pendingReviewCount,
 		"ReceiptsReviewed":      reviewedCount,
+		"UploadResults":         c.Value(uploadResultsKey),
+		"HasProcessing":         processingCount > 0,
 	})
 }
 
 type UpdateReceiptRequest struct {
 	Vendor        *string `json:"vendor"`
 	PendingReview *string `json:"pending_review"`
-	Date          *string `json:"date"`
+	// Status is one of the receipt.Status values. It takes precedence over
+	// PendingReview.
+	Status *string `json:"status"`
+	Date   *string `json:"date"`
 }
 
 func (d *ReceiptsController) UpdateReceipt(c *gin.Context) {
@@ -142,13 +193,22 @@ func (d *ReceiptsController) UpdateReceipt(c *gin.Context) {
 		return
 	}
 
-	var pendingReview *bool
-	if payload.PendingReview != nil && *payload.PendingReview == "Yes" {
-		b := true
-		pendingReview = &b
+	var status *receipt.Status
+	if payload.Status != nil {
+		st := receipt.Status(*payload.Status)
+		if !st.Valid() {
+			span.SetStatus(codes.Error, "unsupported status value")
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unsupported status: %s", *payload.Status)})
+			return
+		}
+
+		status = &st
+	} else if payload.PendingReview != nil && *payload.PendingReview == "Yes" {
+		st := receipt.StatusPendingReview
+		status = &st
 	} else if payload.PendingReview != nil && *payload.PendingReview == "No" {
-		b := false
-		pendingReview = &b
+		st := receipt.StatusReviewed
+		status = &st
 	}
 
 	var date *time.Time
@@ -163,12 +223,16 @@ func (d *ReceiptsController) UpdateReceipt(c *gin.Context) {
 	}
 
 	err = d.Receipts.UpdateReceipt(ctx, receipt.UpdateReceiptRequest{
-		ID:            i,
-		Vendor:        payload.Vendor,
-		PendingReview: pendingReview,
-		Date:          date,
+		ID:     i,
+		Vendor: payload.Vendor,
+		Status: status,
+		Date:   date,
 	})
-	if err != nil {
+	if err != nil && errors.Is(err, receipt.ErrInvalidTransition) {
+		span.SetStatus(codes.Error, err.Error())
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("unable to update receipt: %s", err.Error())})
+		return
+	} else if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		slog.ErrorContext(ctx, "failed to update receipt", "error", err.Error())
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to update receipt: %s", err.Error())})
@@ -191,7 +255,7 @@ func (d *ReceiptsController) ReviewReceipt(c *gin.Context) {
 		return
 	}
 
-	receipt, err := d.Receipts.GetReceipt(ctx, receiptID)
+	r, err := d.Receipts.GetReceipt(ctx, receiptID)
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		slog.ErrorContext(ctx, "failed to get receipt", "error", err.Error())
@@ -207,13 +271,32 @@ func (d *ReceiptsController) ReviewReceipt(c *gin.Context) {
 		return
 	}
 
+	items, err := d.Receipts.ListReceiptItems(ctx, receiptID)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to list items for receipt", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to list items: %s", err.Error())})
+		return
+	}
+
+	var total int32
+	for _, e := range expenses {
+		total += expense.ConvertToCents(e.Amount)
+	}
+
 	c.HTML(http.StatusOK, "review_receipt.html", gin.H{
-		"User":     userEmail,
-		"Receipt":  ToSingleReceiptViewModel(receipt),
-		"Expenses": MapExpenses(expenses),
+		"User":           userEmail,
+		"Receipt":        ToSingleReceiptViewModel(r),
+		"Expenses":       MapExpenses(expenses),
+		"Items":          MapItems(items),
+		"ItemsReconcile": len(items) == 0 || receipt.ItemsReconcile(expense.ConvertToDollar(total), items),
 	})
 }
 
+// GetImage serves the receipt image. The size query parameter picks the
+// original upload, the large copy or the thumbnail, and download=true makes
+// browsers save it instead of displaying it. Since images never change, the
+// responses are cached through their content-addressed ETag.
 func (d *ReceiptsController) GetImage(c *gin.Context) {
 	ctx, span := xtrace.GetSpan(c.Request.Context())
 
@@ -225,7 +308,28 @@ func (d *ReceiptsController) GetImage(c *gin.Context) {
 		return
 	}
 
-	image, err := d.Receipts.GetReceiptImage(ctx, receiptID)
+	size := receipt.ImageSize(c.DefaultQuery("size", string(receipt.ImageOriginal)))
+	if !size.Valid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown image size %q", size)})
+		return
+	}
+
+	key, err := d.Receipts.GetReceiptImageKey(ctx, receiptID, size)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to get receipt image key", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to retrieve receipt: %s", err.Error())})
+		return
+	}
+
+	var image []byte
+	if key == "" {
+		image, err = d.Receipts.GetReceiptImage(ctx, receiptID)
+		key = blob.Key(image)
+	} else if !etagMatches(c.GetHeader("If-None-Match"), imageETag(key)) {
+		image, err = d.Receipts.GetImage(ctx, key)
+	}
+
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		slog.ErrorContext(ctx, "failed to get receipt", "error", err.Error())
@@ -233,11 +337,249 @@ func (d *ReceiptsController) GetImage(c *gin.Context) {
 		return
 	}
 
+	etag := imageETag(key)
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, no-cache")
+
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	contentType := http.DetectContentType(image)
-	c.Writer.Header().Add("Content-Type", contentType)
-	c.Writer.Header().Add("Content-Length", strconv.Itoa(len(image)))
 
-	c.Writer.Write(image)
+	disposition := "inline"
+	if download, _ := strconv.ParseBool(c.Query("download")); download {
+		disposition = "attachment"
+	}
+
+	filename := fmt.Sprintf("receipt-%d%s", receiptID, imageExtension(contentType))
+	c.Header("Content-Disposition", fmt.Sprintf("%s; filename=%q", disposition, filename))
+
+	c.Data(http.StatusOK, contentType, image)
+}
+
+// sendReceiptFile streams the file to the server: its header, and then its
+// contents in chunks.
+func sendReceiptFile(stream *connect.ClientStreamForClient[receiptsv1.UploadReceiptsRequest, receiptsv1.CreateReceiptsResponse], file *multipart.FileHeader) error {
+	f, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("open uploaded file: %w", err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	sniffed := make([]byte, 512)
+	n, err := io.ReadFull(io.TeeReader(f, hash), sniffed)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("read uploaded file: %w", err)
+	}
+
+	_, err = io.Copy(hash, f)
+	if err != nil {
+		return fmt.Errorf("read uploaded file: %w", err)
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("rewind uploaded file: %w", err)
+	}
+
+	mimeType := receipt.DetectMIMEType(sniffed[:n])
+
+	err = stream.Send(&receiptsv1.UploadReceiptsRequest{
+		Payload: &receiptsv1.UploadReceiptsRequest_File{
+			File: &receiptsv1.UploadedFile{
+				Name:     filepath.Base(file.Filename),
+				MimeType: mimeType,
+				Size:     uint64(file.Size),
+				Sha256:   hex.EncodeToString(hash.Sum(nil)),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("send file header: %w", err)
+	}
+
+	chunk := make([]byte, uploadChunkSize)
+	for {
+		n, err := f.Read(chunk)
+		if n > 0 {
+			err := stream.Send(&receiptsv1.UploadReceiptsRequest{
+				Payload: &receiptsv1.UploadReceiptsRequest_Chunk{Chunk: chunk[:n]},
+			})
+			if err != nil {
+				return fmt.Errorf("send file chunk: %w", err)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("read uploaded file: %w", err)
+		}
+	}
+}
+
+func imageETag(key string) string {
+	_, digest, _ := strings.Cut(key, "/")
+	return fmt.Sprintf("%q", digest)
+}
+
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func imageExtension(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "image/heic":
+		return ".heic"
+	case "image/heif":
+		return ".heif"
+	case "image/avif":
+		return ".avif"
+	case "image/tiff":
+		return ".tiff"
+	case "application/pdf":
+		return ".pdf"
+	default:
+		return ""
+	}
+}
+
+// DownloadVATReport serves the deductible VAT of a quarter, by rate, as CSV
+// for the accountant. It's the current quarter unless the year and quarter
+// query parameters say otherwise.
+func (d *ReceiptsController) DownloadVATReport(c *gin.Context) {
+	ctx, span := xtrace.GetSpan(c.Request.Context())
+
+	now := time.Now()
+	year, err := strconv.Atoi(c.DefaultQuery("year", strconv.Itoa(now.Year())))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse year: %s", err.Error())})
+		return
+	}
+
+	quarter, err := strconv.Atoi(c.DefaultQuery("quarter", strconv.Itoa((int(now.Month())-1)/3+1)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse quarter: %s", err.Error())})
+		return
+	}
+
+	req := connect.NewRequest(&receiptsv1.GetVATReportRequest{Year: int32(year), Quarter: int32(quarter)})
+
+	err = auth.CopyAuthHeader(req, c.Request)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to copy auth header", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to get VAT report: %s", err.Error())})
+		return
+	}
+
+	res, err := d.ReceiptsClient.GetVATReport(ctx, req)
+	if err != nil && connect.CodeOf(err) == connect.CodeInvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to get VAT report", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to get VAT report: %s", err.Error())})
+		return
+	}
+
+	records := [][]string{{"rate", "base", "vat", "receipts"}}
+	for _, r := range res.Msg.Rates {
+		records = append(records, []string{
+			strconv.FormatFloat(r.Rate, 'f', -1, 64),
+			formatCents(int64(r.Base)),
+			formatCents(int64(r.Amount)),
+			strconv.Itoa(int(r.Receipts)),
+		})
+	}
+
+	records = append(records, []string{"total", formatCents(int64(res.Msg.TotalBase)), formatCents(int64(res.Msg.TotalAmount)), ""})
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("vat-%dQ%d.csv", year, quarter)))
+	c.Header("Content-Type", "text/csv")
+	c.Status(http.StatusOK)
+
+	err = csv.NewWriter(c.Writer).WriteAll(records)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to write VAT report", "error", err.Error())
+	}
+}
+
+// DownloadExpenseReport serves the PDF expense report of the receipts which
+// match the from, to, category and subcategory query parameters. Dates are
+// formatted as YYYY-MM-DD, and both are inclusive.
+func (d *ReceiptsController) DownloadExpenseReport(c *gin.Context) {
+	ctx, span := xtrace.GetSpan(c.Request.Context())
+
+	dateRange := &receiptsv1.DateRange{}
+	if from := c.Query("from"); from != "" {
+		date, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse from date: %s", err.Error())})
+			return
+		}
+
+		dateRange.From = timestamppb.New(date)
+	}
+
+	if to := c.Query("to"); to != "" {
+		date, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse to date: %s", err.Error())})
+			return
+		}
+
+		dateRange.To = timestamppb.New(date.AddDate(0, 0, 1))
+	}
+
+	req := connect.NewRequest(&receiptsv1.GenerateExpenseReportRequest{
+		DateRange:   dateRange,
+		Category:    c.Query("category"),
+		Subcategory: c.Query("subcategory"),
+	})
+
+	err := auth.CopyAuthHeader(req, c.Request)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to copy auth header", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to generate expense report: %s", err.Error())})
+		return
+	}
+
+	res, err := d.ReceiptsClient.GenerateExpenseReport(ctx, req)
+	if err != nil && connect.CodeOf(err) == connect.CodeResourceExhausted {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "the report has too many receipts, narrow down the dates or the category"})
+		return
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to generate expense report", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to generate expense report: %s", err.Error())})
+		return
+	}
+
+	filename := fmt.Sprintf("expense-report-%s.pdf", time.Now().Format("2006-01-02"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/pdf", res.Msg.Pdf)
 }
 
 func (d *ReceiptsController) DeleteReceipt(c *gin.Context) {
@@ -276,58 +618,96 @@ func (d *ReceiptsController) UploadReceipts(c *gin.Context) {
 		return
 	}
 
-	var files [][]byte
-	var m sync.Mutex
-	g, _ := errgroup.WithContext(ctx)
-	for _, file := range form.File["files"] {
-		g.Go(func() error {
-			filename := filepath.Base(file.Filename)
-			if err := c.SaveUploadedFile(file, filename); err != nil {
-				return fmt.Errorf("save uploaded file: %w", err)
-			}
+	var split receiptsv1.ReceiptSplit
+	switch c.PostForm("split") {
+	case "per_item":
+		split = receiptsv1.ReceiptSplit_RECEIPT_SPLIT_PER_ITEM
+	case "per_category":
+		split = receiptsv1.ReceiptSplit_RECEIPT_SPLIT_PER_CATEGORY
+	default:
+		split = receiptsv1.ReceiptSplit_RECEIPT_SPLIT_NONE
+	}
 
-			data, err := os.ReadFile(filename)
-			if err != nil {
-				return fmt.Errorf("read file: %w", err)
-			}
+	duplicatePolicy := receiptsv1.DuplicatePolicy_DUPLICATE_POLICY_FLAG
+	if c.PostForm("duplicates") == "reject" {
+		duplicatePolicy = receiptsv1.DuplicatePolicy_DUPLICATE_POLICY_REJECT
+	}
 
-			m.Lock()
-			files = append(files, data)
-			m.Unlock()
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-			return nil
-		})
-	}
+	stream := d.ReceiptsClient.UploadReceipts(streamCtx)
 
-	if err := g.Wait(); err != nil {
+	err = auth.CopyAuthHeaderTo(stream.RequestHeader(), c.Request)
+	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
-		slog.ErrorContext(ctx, "failed to read file", "error", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		slog.ErrorContext(ctx, "failed to copy auth header", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to create receipt: %s", err.Error())})
 		return
 	}
 
-	req := connect.Request[receiptsv1.CreateReceiptsRequest]{
-		Msg: &receiptsv1.CreateReceiptsRequest{
-			ReceiptFiles: files,
+	err = stream.Send(&receiptsv1.UploadReceiptsRequest{
+		Payload: &receiptsv1.UploadReceiptsRequest_Options{
+			Options: &receiptsv1.UploadOptions{Split: split, DuplicatePolicy: duplicatePolicy},
 		},
+	})
+
+	// Files keep the order of the form so that rejections, which reference
+	// them by index, can be told apart by name.
+	for _, file := range form.File["files"] {
+		if err != nil {
+			break
+		}
+
+		err = sendReceiptFile(stream, file)
 	}
 
-	err = auth.CopyAuthHeader(&req, c.Request)
-	if err != nil {
+	// Sending fails with io.EOF when the server has already rejected the
+	// upload, and the reason is returned on closing the stream. Otherwise, it
+	// failed on this side and the upload is cancelled so that none of the files
+	// sent so far are stored.
+	if err != nil && !errors.Is(err, io.EOF) {
+		cancel()
+		_, _ = stream.CloseAndReceive()
+
 		span.SetStatus(codes.Error, err.Error())
-		slog.ErrorContext(ctx, "failed to copy auth header", "error", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to create receipt: %s", err.Error())})
+		slog.ErrorContext(ctx, "failed to send receipts", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to upload receipts: %s", err.Error())})
 		return
 	}
 
-	_, err = d.ReceiptsClient.CreateReceipts(ctx, &req)
+	res, err := stream.CloseAndReceive()
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
-		slog.ErrorContext(ctx, "failed to create receipt", "error", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to create receipt: %s", err.Error())})
+		slog.ErrorContext(ctx, "failed to upload receipts", "error", err.Error())
+
+		status := http.StatusInternalServerError
+		switch connect.CodeOf(err) {
+		case connect.CodeInvalidArgument:
+			status = http.StatusBadRequest
+		case connect.CodeResourceExhausted:
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		c.JSON(status, gin.H{"error": fmt.Sprintf("unable to upload receipts: %s", err.Error())})
 		return
 	}
 
+	files := form.File["files"]
+	results := make([]UploadResultViewModel, len(res.Msg.Results))
+	for i, r := range res.Msg.Results {
+		results[i] = ToUploadResultViewModel(filepath.Base(files[r.FileIndex].Filename), r)
+	}
+
+	// The upload form sends files with JavaScript when it can, so that the
+	// ones which failed can be retried without picking them again.
+	if c.NegotiateFormat(gin.MIMEHTML, gin.MIMEJSON) == gin.MIMEJSON {
+		c.JSON(http.StatusOK, gin.H{"results": results})
+		return
+	}
+
+	c.Set(uploadResultsKey, results)
+
 	// NOTE: Since most controller functions rely on an existing span, starting a
 	// span here helps group all subspans.
 	_, span = xtrace.StartSpan(ctx, "List Receipts Page")
@@ -343,18 +723,107 @@ type ReceiptViewModel struct {
 	PendingReview string
 	ReceiptID     int
 	TotalAmount   string
+	// DuplicateOf is the ID of the receipt this one likely duplicates, if any.
+	DuplicateOf string
+	// Processing is set while the receipt is waiting to be parsed or being
+	// parsed.
+	Processing bool
+	Archived   bool
+	// FailedReason tells why the receipt couldn't be parsed, if it failed.
+	FailedReason string
+}
+
+// uploadResultsKey is where UploadReceipts leaves what happened to each file
+// for the listing rendered after it.
+const uploadResultsKey = "upload_results"
+
+type UploadResultViewModel struct {
+	Filename string `json:"filename"`
+	// ReceiptID is set when the file was uploaded.
+	ReceiptID string `json:"receipt_id,omitempty"`
+	// DuplicateOf is set when the file wasn't uploaded because it's a
+	// duplicate of the receipt.
+	DuplicateOf string `json:"duplicate_of,omitempty"`
+	// Error tells why the file wasn't uploaded otherwise.
+	Error     string `json:"error,omitempty"`
+	Retryable bool   `json:"retryable"`
+}
+
+func ToUploadResultViewModel(filename string, r *receiptsv1.FileResult) UploadResultViewModel {
+	vm := UploadResultViewModel{Filename: filename}
+
+	if created := r.GetReceipt(); created != nil {
+		vm.ReceiptID = fmt.Sprint(created.Id)
+		return vm
+	}
+
+	vm.Retryable = r.GetError().GetRetryable()
+
+	switch r.GetError().GetReason() {
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_DUPLICATE:
+		vm.DuplicateOf = fmt.Sprint(r.GetError().GetDuplicateOf().GetReceiptId())
+		vm.Error = "it looks like a duplicate"
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNSUPPORTED_TYPE:
+		vm.Error = "only PDFs and images can be uploaded"
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_TOO_LARGE:
+		vm.Error = fmt.Sprintf("it's larger than %d MiB", receipt.MaxFileSize>>20)
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_CORRUPTED:
+		vm.Error = "it got corrupted while uploading"
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNREADABLE:
+		vm.Error = "it's damaged and can't be read"
+	default:
+		vm.Error = "something went wrong storing it"
+	}
+
+	return vm
 }
 
 func ToSingleReceiptViewModel(r *receipt.Receipt) ReceiptViewModel {
 	pendingReview := "No"
-	if r.PendingReview {
+	if r.Status == receipt.StatusPendingReview {
 		pendingReview = "Yes"
 	}
 
+	var failedReason string
+	if r.Status == receipt.StatusFailed {
+		failedReason = r.StatusReason
+	}
+
+	var duplicateOf string
+	if r.DuplicateOf != nil {
+		duplicateOf = fmt.Sprint(r.DuplicateOf.ReceiptID)
+	}
+
 	return ReceiptViewModel{
 		ID:            fmt.Sprint(r.ID),
 		Date:          r.Date.Format("2006-01-02"),
 		Vendor:        strings.Title(r.Vendor),
 		PendingReview: pendingReview,
+		DuplicateOf:   duplicateOf,
+		Processing:    r.Status == receipt.StatusUploaded || r.Status == receipt.StatusParsing,
+		Archived:      r.Status == receipt.StatusArchived,
+		FailedReason:  failedReason,
 	}
 }
+
+type ItemViewModel struct {
+	Name      string
+	Quantity  string
+	UnitPrice string
+	Total     string
+	Category  string
+}
+
+func MapItems(items []receipt.Item) (models []ItemViewModel) {
+	for _, i := range items {
+		models = append(models, ItemViewModel{
+			Name:      i.Name,
+			Quantity:  strconv.FormatFloat(i.Quantity, 'f', -1, 64),
+			UnitPrice: fmt.Sprintf("%0.2f", i.UnitPrice),
+			Total:     fmt.Sprintf("%0.2f", i.Total),
+			Category:  strings.Title(i.Category),
+		})
+	}
+
+	return
+}
@@ -0,0 +1,273 @@
+package controllers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/internal/settle"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+type SettleController struct {
+	Settle     *settle.Repository
+	Expenses   *expense.Repository
+	Households *household.Repository
+}
+
+type BalanceViewModel struct {
+	Email  string
+	Amount string
+}
+
+type DebtViewModel struct {
+	From   string
+	To     string
+	Amount string
+}
+
+type SettlementViewModel struct {
+	Date   string
+	From   string
+	To     string
+	Amount string
+}
+
+func (d *SettleController) GetSettleUp(c *gin.Context) {
+	ctx, span := xtrace.GetSpan(c.Request.Context())
+
+	user := auth.GetUserEmail(c)
+
+	members, err := d.Households.LedgerMembers(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to list ledger members", "error", err.Error())
+		c.HTML(http.StatusOK, "error.html", gin.H{"error": err.Error()})
+		return
+	}
+
+	summary, err := d.Settle.GetSummary(ctx, members)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to get settle up summary", "error", err.Error())
+		c.HTML(http.StatusOK, "error.html", gin.H{"error": err.Error()})
+		return
+	}
+
+	var balances []BalanceViewModel
+	for email, amount := range summary.Balances {
+		balances = append(balances, BalanceViewModel{Email: email, Amount: formatCents(amount)})
+	}
+
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Email < balances[j].Email
+	})
+
+	var debts []DebtViewModel
+	for _, debt := range summary.Debts {
+		debts = append(debts, DebtViewModel{From: debt.From, To: debt.To, Amount: formatCents(debt.Amount)})
+	}
+
+	var settlements []SettlementViewModel
+	for _, s := range summary.Settlements {
+		settlements = append(settlements, SettlementViewModel{
+			Date:   s.Date.Format("2006-01-02"),
+			From:   s.FromEmail,
+			To:     s.ToEmail,
+			Amount: formatCents(s.Amount),
+		})
+	}
+
+	c.HTML(http.StatusOK, "settle.html", gin.H{
+		"User":        user,
+		"Members":     members,
+		"Balances":    balances,
+		"Debts":       debts,
+		"Settlements": settlements,
+	})
+}
+
+type SplitExpensePayload struct {
+	PaidBy       *string                   `json:"paid_by"`
+	Method       string                    `json:"method"`
+	Participants []SplitParticipantPayload `json:"participants"`
+}
+
+type SplitParticipantPayload struct {
+	Email      string  `json:"email"`
+	Percentage float64 `json:"percentage,string"`
+	Amount     float32 `json:"amount,string"`
+}
+
+func (d *SettleController) SplitExpense(c *gin.Context) {
+	ctx, span := xtrace.GetSpan(c.Request.Context())
+
+	payload := SplitExpensePayload{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to bind json", "error", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse request body: %s", err.Error())})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse expense id: %s", err.Error())})
+		return
+	}
+
+	exp, err := d.Expenses.FindExpense(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to find expense", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to find expense: %s", err.Error())})
+		return
+	}
+
+	members, err := d.Households.LedgerMembers(ctx, auth.GetUserEmail(c))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to list ledger members", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to list ledger members: %s", err.Error())})
+		return
+	}
+
+	paidBy := exp.UserEmail
+	if payload.PaidBy != nil {
+		paidBy = *payload.PaidBy
+	}
+
+	if !isLedgerMember(members, paidBy) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s doesn't share the ledger", paidBy)})
+		return
+	}
+
+	participants := make([]settle.Participant, len(payload.Participants))
+	for i, p := range payload.Participants {
+		if !isLedgerMember(members, p.Email) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s doesn't share the ledger", p.Email)})
+			return
+		}
+
+		participants[i] = settle.Participant{
+			Email:      p.Email,
+			Percentage: p.Percentage,
+			Amount:     int64(expense.ConvertToCents(p.Amount)),
+		}
+	}
+
+	method := settle.Method(payload.Method)
+	if method == "" {
+		method = settle.MethodEqual
+	}
+
+	shares, err := settle.Split(int64(expense.ConvertToCents(exp.Amount)), method, participants)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to split expense: %s", err.Error())})
+		return
+	}
+
+	err = d.Settle.SetSplit(ctx, settle.SplitRequest{
+		ExpenseID: exp.ID,
+		PaidBy:    paidBy,
+		Method:    method,
+		Shares:    shares,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to split expense", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to split expense: %s", err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, "")
+}
+
+type CreateSettlementPayload struct {
+	FromEmail string  `json:"from_email"`
+	ToEmail   string  `json:"to_email"`
+	Amount    float32 `json:"amount,string"`
+	Date      *string `json:"date"`
+}
+
+func (d *SettleController) CreateSettlement(c *gin.Context) {
+	ctx, span := xtrace.GetSpan(c.Request.Context())
+
+	payload := CreateSettlementPayload{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to bind json", "error", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse request body: %s", err.Error())})
+		return
+	}
+
+	user := auth.GetUserEmail(c)
+	if !strings.EqualFold(user, payload.FromEmail) && !strings.EqualFold(user, payload.ToEmail) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "only the people settling can record a settlement"})
+		return
+	}
+
+	members, err := d.Households.LedgerMembers(ctx, user)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to list ledger members", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to list ledger members: %s", err.Error())})
+		return
+	}
+
+	if !isLedgerMember(members, payload.FromEmail) || !isLedgerMember(members, payload.ToEmail) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "both people must share the ledger"})
+		return
+	}
+
+	date := time.Now()
+	if payload.Date != nil {
+		date, err = time.Parse("2006-01-02", *payload.Date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to parse date: %s", err.Error())})
+			return
+		}
+	}
+
+	_, err = d.Settle.CreateSettlement(ctx, settle.Settlement{
+		FromEmail: payload.FromEmail,
+		ToEmail:   payload.ToEmail,
+		Amount:    int64(expense.ConvertToCents(payload.Amount)),
+		Date:      date,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to create settlement", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable to create settlement: %s", err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusCreated, "")
+}
+
+func isLedgerMember(members []string, email string) bool {
+	for _, m := range members {
+		if strings.EqualFold(m, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%.2f", float64(cents)/100)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"embed"
 	"fmt"
 	"html/template"
@@ -14,9 +15,13 @@ import (
 	"github.com/manzanit0/mcduck/api/auth.v1/authv1connect"
 	"github.com/manzanit0/mcduck/api/receipts.v1/receiptsv1connect"
 	"github.com/manzanit0/mcduck/cmd/api/controllers"
+	"github.com/manzanit0/mcduck/internal/account"
+	"github.com/manzanit0/mcduck/internal/blob"
 	"github.com/manzanit0/mcduck/internal/client"
 	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
 	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/internal/settle"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/micro"
 	"github.com/manzanit0/mcduck/pkg/tgram"
@@ -75,24 +80,45 @@ func run() error {
 	}
 
 	expenseRepository := expense.NewRepository(db)
-	expensesController := controllers.ExpensesController{Expenses: expenseRepository}
+	accountRepository := account.NewRepository(db)
+	householdRepository := household.NewRepository(db)
+	expensesController := controllers.ExpensesController{
+		Expenses:   expenseRepository,
+		Accounts:   accountRepository,
+		Households: householdRepository,
+	}
 
 	receiptsClient := receiptsv1connect.NewReceiptsServiceClient(xhttp.NewClient(), micro.MustGetEnv("PRIVATE_DOTS_HOST"))
 	parserHost := micro.MustGetEnv("PARSER_HOST") // TODO: shouldn't throw.
 	parserClient := client.NewParserClient(parserHost)
-	receiptsRepository := receipt.NewRepository(db)
+	blobs, err := blob.NewStoreFromEnv(context.Background(), db)
+	if err != nil {
+		return fmt.Errorf("new blob store: %w", err)
+	}
+
+	receiptsRepository := receipt.NewRepository(db, blobs)
 	receiptsController := controllers.ReceiptsController{
-		Expenses:       expenseRepository,
-		Receipts:       receiptsRepository,
-		Parser:         parserClient,
-		ReceiptsClient: receiptsClient,
+		DB:                 db,
+		Expenses:           expenseRepository,
+		Receipts:           receiptsRepository,
+		Parser:             parserClient,
+		ReceiptsClient:     receiptsClient,
+		InboundEmailDomain: os.Getenv("INBOUND_EMAIL_DOMAIN"),
 	}
 
 	data, err := readSampleData()
 	if err != nil {
 		return fmt.Errorf("read sample data: %w", err)
 	}
-	dashController := controllers.DashboardController{Expenses: expenseRepository, SampleData: data}
+	dashController := controllers.DashboardController{Expenses: expenseRepository, Accounts: accountRepository, SampleData: data}
+
+	chartsController := controllers.ChartsController{Expenses: expenseRepository}
+
+	settleController := controllers.SettleController{
+		Settle:     settle.NewRepository(db),
+		Expenses:   expenseRepository,
+		Households: householdRepository,
+	}
 
 	nologin := r.
 		Group("/").
@@ -113,8 +139,9 @@ func run() error {
 
 	loggedIn.GET("/dashboard", dashController.Dashboard)
 	loggedIn.GET("/receipts", receiptsController.ListReceipts)
-	loggedIn.GET("/receipts/:id/review", receiptsController.ReviewReceipt)
+	loggedIn.GET("/receipts/:id/review", controllers.ReceiptOwnershipWall(receiptsRepository, householdRepository), receiptsController.ReviewReceipt)
 	loggedIn.GET("/expenses", expensesController.ListExpenses)
+	loggedIn.GET("/settle", settleController.GetSettleUp)
 
 	apiG := r.
 		Group("/").
@@ -127,24 +154,29 @@ func run() error {
 		Use(auth.CookieMiddleware).
 		Use(auth.BearerMiddleware).
 		Use(controllers.ForceAuthentication).
-		Use(controllers.ReceiptOwnershipWall(receiptsRepository))
+		Use(controllers.ReceiptOwnershipWall(receiptsRepository, householdRepository))
 
 	ownsReceipt.PATCH("/receipts/:id", receiptsController.UpdateReceipt)
 	ownsReceipt.DELETE("/receipts/:id", receiptsController.DeleteReceipt)
 	ownsReceipt.GET("/receipts/:id/image", receiptsController.GetImage)
 	apiG.POST("/receipts/upload", receiptsController.UploadReceipts)
+	apiG.GET("/reports/vat.csv", receiptsController.DownloadVATReport)
+	apiG.GET("/reports/expenses.pdf", receiptsController.DownloadExpenseReport)
 
 	ownsExpense := r.
 		Group("/").
 		Use(auth.CookieMiddleware).
 		Use(auth.BearerMiddleware).
 		Use(controllers.ForceAuthentication).
-		Use(controllers.ExpenseOwnershipWall(expenseRepository))
+		Use(controllers.ExpenseOwnershipWall(expenseRepository, householdRepository))
 
 	ownsExpense.PATCH("/expenses/:id", expensesController.UpdateExpense)
 	ownsExpense.DELETE("/expenses/:id", expensesController.DeleteExpense)
 	apiG.PUT("/expenses", expensesController.CreateExpense)
-	apiG.POST("/expenses/merge", expensesController.MergeExpenses) // TODO: this should be under receipts with authz?
+	apiG.POST("/expenses/merge", expensesController.MergeExpenses)
+	ownsExpense.PUT("/expenses/:id/split", settleController.SplitExpense)
+	apiG.POST("/settlements", settleController.CreateSettlement)
+	apiG.GET("/charts", chartsController.GetChart)
 
 	return svc.Run()
 }
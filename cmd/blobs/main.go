@@ -0,0 +1,79 @@
+// Command blobs manages the files stored through the blob store.
+//
+// Usage:
+//
+//	blobs migrate [-batch 100]
+//
+// migrate moves the receipt images still stored in the receipts table to the
+// blob store configured through BLOB_STORE. It's safe to run more than once,
+// and while the services are running.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	_ "github.com/jackc/pgx/v4/stdlib"
+
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/pkg/xlog"
+	"github.com/manzanit0/mcduck/pkg/xsql"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		slog.Error("exiting", "error", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	xlog.InitSlog()
+
+	if len(args) == 0 || args[0] != "migrate" {
+		return fmt.Errorf("usage: blobs migrate [-batch 100]")
+	}
+
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	batch := flags.Uint64("batch", 100, "amount of images to move at a time")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	dbx, err := xsql.OpenFromEnv()
+	if err != nil {
+		return err
+	}
+	defer xsql.Close(dbx)
+
+	blobs, err := blob.NewStoreFromEnv(ctx, dbx)
+	if err != nil {
+		return err
+	}
+
+	receipts := receipt.NewRepository(dbx, blobs)
+
+	var total int
+	for {
+		moved, err := receipts.MigrateImages(ctx, *batch)
+		total += moved
+		if err != nil {
+			return fmt.Errorf("migrate images after moving %d: %w", total, err)
+		}
+
+		if moved == 0 {
+			break
+		}
+
+		slog.Info("moved receipt images", "batch", moved, "total", total)
+	}
+
+	slog.Info("finished moving receipt images", "total", total)
+	return nil
+}
@@ -0,0 +1,40 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	usersv1 "github.com/manzanit0/mcduck/api/users.v1"
+	"github.com/manzanit0/mcduck/api/users.v1/usersv1connect"
+	"github.com/manzanit0/mcduck/pkg/auth"
+)
+
+// userToken finds the user linked to the Telegram chat and generates a JWT to
+// call dots on their behalf.
+func userToken(ctx context.Context, usersClient usersv1connect.UsersServiceClient, chatID int) (string, error) {
+	getUserReq := connect.Request[usersv1.GetUserRequest]{
+		Msg: &usersv1.GetUserRequest{
+			TelegramChatId: int64(chatID),
+		},
+	}
+
+	token, err := auth.GenerateJWT("bot@mcduck.com")
+	if err != nil {
+		return "", fmt.Errorf("generate JWT: %w", err)
+	}
+
+	getUserReq.Header().Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := usersClient.GetUser(ctx, &getUserReq)
+	if err != nil {
+		return "", fmt.Errorf("unable to find user: %w", err)
+	}
+
+	token, err = auth.GenerateJWT(resp.Msg.User.Email)
+	if err != nil {
+		return "", fmt.Errorf("generate JWT: %w", err)
+	}
+
+	return token, nil
+}
@@ -1,22 +1,18 @@
 package bot
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"log/slog"
-	"strings"
 
 	"connectrpc.com/connect"
 	receiptsv1 "github.com/manzanit0/mcduck/api/receipts.v1"
 	"github.com/manzanit0/mcduck/api/receipts.v1/receiptsv1connect"
 	usersv1 "github.com/manzanit0/mcduck/api/users.v1"
 	"github.com/manzanit0/mcduck/api/users.v1/usersv1connect"
-	"github.com/manzanit0/mcduck/internal/expense"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/tgram"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
-	"github.com/olekukonko/tablewriter"
 	"go.opentelemetry.io/otel/codes"
 )
 
@@ -123,34 +119,46 @@ func ParseReceipt(ctx context.Context, tgramClient tgram.Client, usersClient use
 		return tgram.NewHTMLResponse(fmt.Sprintf("unable to parser receipt: %s", err.Error()), r.GetFromID())
 	}
 
-	return tgram.NewMarkdownResponse(newBreakdownTgramMessage(map[string]float64{
-		res.Msg.Receipts[0].Expenses[0].Description: float64(expense.ConvertToDollar(int32(res.Msg.Receipts[0].Expenses[0].Amount))),
-	}), r.GetFromID())
-}
-
-func newBreakdownTgramMessage(amounts map[string]float64) string {
-	b := bytes.NewBuffer([]byte{})
-	table := tablewriter.NewWriter(b)
+	result := res.Msg.Results[0]
+	if result.GetError() != nil {
+		span.SetStatus(codes.Error, result.GetError().Message)
+		return tgram.NewHTMLResponse(fileErrorMessage(result.GetError()), r.GetFromID())
+	}
 
-	table.SetHeader([]string{"Item", "Amount"})
+	// Receipts are parsed in the background, and the user is told once it's
+	// done.
+	message := fmt.Sprintf("Got it! I'm reading receipt #%d, I'll let you know once it's ready for review.", result.GetReceipt().Id)
 
-	var total float64
-	for k, v := range amounts {
-		// We're trimming the item name because we want the table to render
-		// properly on small phones. The reference is an iPhone SE.
-		item := strings.TrimSpace(strings.Title(strings.ToLower(fmt.Sprintf("%.14s", k))))
-		table.Append([]string{item, fmt.Sprintf("%.2f%s", v, defaultCurrency)})
-		total += v
+	if d := result.GetReceipt().DuplicateOf; d != nil {
+		message += fmt.Sprintf(" It looks like a duplicate of receipt #%d, you might want to delete one of them.", d.ReceiptId)
 	}
 
-	// table.SetRowLine(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetRowSeparator("-")
-	table.SetAutoFormatHeaders(false)
-	table.SetBorder(false)
-	table.SetFooter([]string{"TOTAL", fmt.Sprintf("%.2f%s", total, defaultCurrency)})
+	return tgram.NewHTMLResponse(message, r.GetFromID())
+}
 
-	table.Render()
+// fileErrorMessage tells the user why the receipt they sent wasn't stored.
+// Every message is a single file, so retrying is just sending it again.
+func fileErrorMessage(e *receiptsv1.FileError) string {
+	var reason string
+	switch e.Reason {
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNSUPPORTED_TYPE:
+		reason = "I can only read receipts sent as PDFs or images."
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_TOO_LARGE:
+		reason = "The file is too large."
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_CORRUPTED:
+		reason = "The file got corrupted on its way."
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNREADABLE:
+		reason = "The file is damaged and I can't read it."
+	case receiptsv1.FileErrorReason_FILE_ERROR_REASON_DUPLICATE:
+		reason = fmt.Sprintf("It looks like a duplicate of receipt #%d.", e.DuplicateOf.GetReceiptId())
+	default:
+		reason = "Something went wrong storing it."
+	}
+
+	message := "I couldn't save that receipt. " + reason
+	if e.Retryable {
+		message += " Please, send it again."
+	}
 
-	return fmt.Sprintf("```%s```", b.String())
+	return message
 }
@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	settlementsv1 "github.com/manzanit0/mcduck/api/settlements.v1"
+	"github.com/manzanit0/mcduck/api/settlements.v1/settlementsv1connect"
+	"github.com/manzanit0/mcduck/api/users.v1/usersv1connect"
+	"github.com/manzanit0/mcduck/pkg/tgram"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+	"github.com/olekukonko/tablewriter"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Balances replies with who owes whom within the household of the user.
+func Balances(ctx context.Context, usersClient usersv1connect.UsersServiceClient, settlementsClient settlementsv1connect.SettlementsServiceClient, r *tgram.WebhookRequest) *tgram.WebhookResponse {
+	ctx, span := xtrace.StartSpan(ctx, "Get Balances")
+	defer span.End()
+
+	token, err := userToken(ctx, usersClient, r.GetFromID())
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to authenticate telegram user", "error", err.Error())
+		return tgram.NewHTMLResponse(err.Error(), r.GetFromID())
+	}
+
+	balancesReq := connect.Request[settlementsv1.GetBalancesRequest]{Msg: &settlementsv1.GetBalancesRequest{}}
+	balancesReq.Header().Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	res, err := settlementsClient.GetBalances(ctx, &balancesReq)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "GetBalances", "error", err.Error())
+		return tgram.NewHTMLResponse(fmt.Sprintf("unable to get balances: %s", err.Error()), r.GetFromID())
+	}
+
+	if len(res.Msg.Debts) == 0 {
+		return tgram.NewMarkdownResponse("Everybody is even\\!", r.GetFromID())
+	}
+
+	return tgram.NewMarkdownResponse(newDebtsTgramMessage(res.Msg.Debts), r.GetFromID())
+}
+
+func newDebtsTgramMessage(debts []*settlementsv1.Debt) string {
+	b := bytes.NewBuffer([]byte{})
+	table := tablewriter.NewWriter(b)
+
+	table.SetHeader([]string{"From", "To", "Amount"})
+
+	for _, d := range debts {
+		// Only the local part of the email so the table fits in small phones.
+		from, _, _ := strings.Cut(d.FromEmail, "@")
+		to, _, _ := strings.Cut(d.ToEmail, "@")
+		table.Append([]string{from, to, fmt.Sprintf("%.2f%s", float64(d.Amount)/100, defaultCurrency)})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAutoFormatHeaders(false)
+	table.SetBorder(false)
+
+	table.Render()
+
+	return fmt.Sprintf("```%s```", b.String())
+}
@@ -0,0 +1,146 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	dashboardv1 "github.com/manzanit0/mcduck/api/dashboard.v1"
+	"github.com/manzanit0/mcduck/api/dashboard.v1/dashboardv1connect"
+	"github.com/manzanit0/mcduck/api/users.v1/usersv1connect"
+	"github.com/manzanit0/mcduck/internal/chart"
+	"github.com/manzanit0/mcduck/internal/dashboard"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/pkg/tgram"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+	"github.com/olekukonko/tablewriter"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// SpendingSummary sends a donut chart with the spend per category of the most
+// recent month and replies with the totals of the last months.
+func SpendingSummary(ctx context.Context, tgramClient tgram.Client, usersClient usersv1connect.UsersServiceClient, dashboardClient dashboardv1connect.DashboardServiceClient, r *tgram.WebhookRequest) *tgram.WebhookResponse {
+	ctx, span := xtrace.StartSpan(ctx, "Spending Summary")
+	defer span.End()
+
+	token, err := userToken(ctx, usersClient, r.GetFromID())
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to authenticate telegram user", "error", err.Error())
+		return tgram.NewHTMLResponse(err.Error(), r.GetFromID())
+	}
+
+	req := connect.Request[dashboardv1.GetDashboardRequest]{Msg: &dashboardv1.GetDashboardRequest{}}
+	req.Header().Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	res, err := dashboardClient.GetDashboard(ctx, &req)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "GetDashboard", "error", err.Error())
+		return tgram.NewHTMLResponse(fmt.Sprintf("unable to get dashboard: %s", err.Error()), r.GetFromID())
+	}
+
+	if res.Msg.MostRecent == nil {
+		return tgram.NewMarkdownResponse("You don't have any expenses yet\\!", r.GetFromID())
+	}
+
+	err = sendChart(ctx, tgramClient, r.GetFromID(), mapChartData(res.Msg.CategoriesChart))
+	if err != nil {
+		// The summary is still worth sending without the chart.
+		span.RecordError(err)
+		slog.ErrorContext(ctx, "failed to send chart", "error", err.Error())
+	}
+
+	return tgram.NewMarkdownResponse(newSummaryTgramMessage(res.Msg), r.GetFromID())
+}
+
+func sendChart(ctx context.Context, tgramClient tgram.Client, chatID int, data dashboard.ChartData) error {
+	_, span := xtrace.StartSpan(ctx, "telegram.SendPhoto")
+	defer span.End()
+
+	c, err := chart.FromChartData(chart.KindDonut, "Spend per category", data)
+	if err != nil && errors.Is(err, chart.ErrNoData) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("build chart: %w", err)
+	}
+
+	var b bytes.Buffer
+	err = chart.RenderPNG(&b, c)
+	if err != nil && errors.Is(err, chart.ErrNoData) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("render chart: %w", err)
+	}
+
+	err = tgramClient.SendPhoto(tgram.SendPhotoRequest{
+		ChatID:   int64(chatID),
+		Photo:    b.Bytes(),
+		Filename: "summary.png",
+		Caption:  c.Title,
+	})
+	if err != nil {
+		return fmt.Errorf("send photo: %w", err)
+	}
+
+	return nil
+}
+
+func mapChartData(c *dashboardv1.Chart) dashboard.ChartData {
+	if c == nil {
+		return dashboard.ChartData{}
+	}
+
+	data := dashboard.ChartData{Title: c.Title, Labels: c.Labels}
+	for _, d := range c.Datasets {
+		values := make([]float32, len(d.Data))
+		for i, cents := range d.Data {
+			values[i] = expense.ConvertToDollar(int32(cents))
+		}
+
+		data.Datasets = append(data.Datasets, dashboard.Dataset{
+			Label:            d.Label,
+			BorderColour:     d.BorderColour,
+			BackgroundColour: d.BackgroundColour,
+			Hidden:           d.Hidden,
+			Data:             values,
+		})
+	}
+
+	return data
+}
+
+func newSummaryTgramMessage(res *dashboardv1.GetDashboardResponse) string {
+	b := bytes.NewBuffer([]byte{})
+
+	table := tablewriter.NewWriter(b)
+	table.SetHeader([]string{"Month", "Spent"})
+	for _, s := range res.TotalSpends {
+		table.Append([]string{s.MonthYear, fmt.Sprintf("%.2f%s", expense.ConvertToDollar(int32(s.Amount)), defaultCurrency)})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAutoFormatHeaders(false)
+	table.SetBorder(false)
+	table.Render()
+
+	if len(res.TopCategories) > 0 {
+		b.WriteString("\n")
+
+		table = tablewriter.NewWriter(b)
+		table.SetHeader([]string{"Top", "Spent"})
+		for _, c := range res.TopCategories {
+			table.Append([]string{fmt.Sprintf("%.14s", c.Category), fmt.Sprintf("%.2f%s", expense.ConvertToDollar(int32(c.TotalAmount)), defaultCurrency)})
+		}
+
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.SetAutoFormatHeaders(false)
+		table.SetBorder(false)
+		table.Render()
+	}
+
+	return fmt.Sprintf("```%s```", b.String())
+}
@@ -10,7 +10,9 @@ import (
 	"connectrpc.com/connect"
 	"connectrpc.com/otelconnect"
 	"github.com/gin-gonic/gin"
+	"github.com/manzanit0/mcduck/api/dashboard.v1/dashboardv1connect"
 	"github.com/manzanit0/mcduck/api/receipts.v1/receiptsv1connect"
+	"github.com/manzanit0/mcduck/api/settlements.v1/settlementsv1connect"
 	"github.com/manzanit0/mcduck/api/users.v1/usersv1connect"
 	"github.com/manzanit0/mcduck/cmd/bot/internal/bot"
 	"github.com/manzanit0/mcduck/pkg/micro"
@@ -34,8 +36,10 @@ func main() {
 	interceptor, _ := otelconnect.NewInterceptor()
 	receiptsClient := receiptsv1connect.NewReceiptsServiceClient(xhttp.NewClient(), micro.MustGetEnv("PRIVATE_DOTS_HOST"), connect.WithInterceptors(interceptor))
 	usersClient := usersv1connect.NewUsersServiceClient(xhttp.NewClient(), micro.MustGetEnv("PRIVATE_DOTS_HOST"), connect.WithInterceptors(interceptor))
+	settlementsClient := settlementsv1connect.NewSettlementsServiceClient(xhttp.NewClient(), micro.MustGetEnv("PRIVATE_DOTS_HOST"), connect.WithInterceptors(interceptor))
+	dashboardClient := dashboardv1connect.NewDashboardServiceClient(xhttp.NewClient(), micro.MustGetEnv("PRIVATE_DOTS_HOST"), connect.WithInterceptors(interceptor))
 	tgramClient := tgram.NewClient(xhttp.NewClient(), micro.MustGetEnv("TELEGRAM_BOT_TOKEN"))
-	svc.Engine.POST("/telegram/webhook", telegramWebhookController(tgramClient, usersClient, receiptsClient))
+	svc.Engine.POST("/telegram/webhook", telegramWebhookController(tgramClient, usersClient, receiptsClient, settlementsClient, dashboardClient))
 
 	if err := svc.Run(); err != nil {
 		slog.Error("run ended with error", "error", err.Error())
@@ -43,7 +47,7 @@ func main() {
 	}
 }
 
-func telegramWebhookController(tgramClient tgram.Client, usersClient usersv1connect.UsersServiceClient, receiptsClient receiptsv1connect.ReceiptsServiceClient) func(c *gin.Context) {
+func telegramWebhookController(tgramClient tgram.Client, usersClient usersv1connect.UsersServiceClient, receiptsClient receiptsv1connect.ReceiptsServiceClient, settlementsClient settlementsv1connect.SettlementsServiceClient, dashboardClient dashboardv1connect.DashboardServiceClient) func(c *gin.Context) {
 	return func(c *gin.Context) {
 		ctx, span := xtrace.GetSpan(c.Request.Context())
 
@@ -70,6 +74,18 @@ func telegramWebhookController(tgramClient tgram.Client, usersClient usersv1conn
 			res := bot.LoginLink(ctx, &r)
 			c.JSON(http.StatusOK, res)
 
+		case r.Message != nil && r.Message.Text != nil && strings.HasPrefix(*r.Message.Text, "/balances"):
+			span.SetAttributes(attribute.String("mduck.telegram.command", "balances"))
+
+			res := bot.Balances(ctx, usersClient, settlementsClient, &r)
+			c.JSON(http.StatusOK, res)
+
+		case r.Message != nil && r.Message.Text != nil && strings.HasPrefix(*r.Message.Text, "/summary"):
+			span.SetAttributes(attribute.String("mduck.telegram.command", "summary"))
+
+			res := bot.SpendingSummary(ctx, tgramClient, usersClient, dashboardClient, &r)
+			c.JSON(http.StatusOK, res)
+
 			// The message has either photos or a doc.
 		case r.Message != nil && (len(r.Message.Photos) > 0 || r.Message.Document != nil):
 			span.SetAttributes(attribute.String("mduck.telegram.command", "upload"))
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
 
 	_ "github.com/jackc/pgx/v4/stdlib"
 
@@ -13,11 +14,18 @@ import (
 	"connectrpc.com/otelconnect"
 	"github.com/rs/cors"
 
+	"github.com/manzanit0/mcduck/api/accounts.v1/accountsv1connect"
 	"github.com/manzanit0/mcduck/api/auth.v1/authv1connect"
+	"github.com/manzanit0/mcduck/api/dashboard.v1/dashboardv1connect"
+	"github.com/manzanit0/mcduck/api/households.v1/householdsv1connect"
 	"github.com/manzanit0/mcduck/api/receipts.v1/receiptsv1connect"
+	"github.com/manzanit0/mcduck/api/settlements.v1/settlementsv1connect"
 	"github.com/manzanit0/mcduck/api/users.v1/usersv1connect"
 	"github.com/manzanit0/mcduck/cmd/dots/servers"
+	"github.com/manzanit0/mcduck/cmd/dots/workers"
+	"github.com/manzanit0/mcduck/internal/blob"
 	"github.com/manzanit0/mcduck/internal/client"
+	"github.com/manzanit0/mcduck/internal/jobs"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/micro"
 	"github.com/manzanit0/mcduck/pkg/tgram"
@@ -29,6 +37,8 @@ import (
 
 const serviceName = "dots"
 
+const defaultWorkerCount = 2
+
 func main() {
 	if err := run(); err != nil {
 		slog.Error("exiting server", "error", err.Error())
@@ -54,15 +64,31 @@ func run() error {
 	tgramToken := micro.MustGetEnv("TELEGRAM_BOT_TOKEN")
 	tgramClient := tgram.NewClient(xhttp.NewClient(), tgramToken)
 
+	blobs, err := blob.NewStoreFromEnv(context.Background(), dbx)
+	if err != nil {
+		return err
+	}
+
 	parserHost := micro.MustGetEnv("PARSER_HOST")
 	parserClient := client.NewParserClient(parserHost)
 
+	// Workers run alongside the server until it shuts down. Jobs they leave
+	// halfway are picked up again once their lease expires.
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	worker := jobs.NewWorker(jobs.NewQueue(dbx), workers.NewReceiptParser(dbx, blobs, parserClient, tgramClient).Handlers())
+	for range workerCount() {
+		go worker.Run(workersCtx)
+	}
+
 	otelInterceptor, err := otelconnect.NewInterceptor(otelconnect.WithTrustRemote(), otelconnect.WithoutMetrics())
 	if err != nil {
 		return err
 	}
 
 	authInterceptor := auth.AuthenticationInterceptor()
+	authzInterceptor := servers.AuthorizationInterceptor(dbx, blobs)
 	traceEnhancer := xtrace.SpanEnhancerInterceptor()
 
 	mux := http.NewServeMux()
@@ -71,14 +97,21 @@ func run() error {
 		_, _ = w.Write([]byte(`{"message": "pong"}`))
 	}))
 
+	// Receiving receipts by email is optional, since it needs a domain whose
+	// mail is posted here by the provider.
+	inboundDomain, inboundSecret := os.Getenv("INBOUND_EMAIL_DOMAIN"), os.Getenv("INBOUND_EMAIL_SECRET")
+	if inboundDomain != "" && inboundSecret != "" {
+		mux.Handle("/inbound/email", servers.NewInboundEmailHandler(dbx, blobs, inboundDomain, inboundSecret))
+	}
+
 	mux.Handle(authv1connect.NewAuthServiceHandler(
 		servers.NewAuthServer(dbx, tgramClient),
 		connect.WithInterceptors(otelInterceptor, traceEnhancer),
 	))
 
 	mux.Handle(receiptsv1connect.NewReceiptsServiceHandler(
-		servers.NewReceiptsServer(dbx, parserClient, tgramClient),
-		connect.WithInterceptors(otelInterceptor, authInterceptor, traceEnhancer),
+		servers.NewReceiptsServer(dbx, blobs, parserClient, tgramClient),
+		connect.WithInterceptors(otelInterceptor, authInterceptor, authzInterceptor, traceEnhancer),
 	))
 
 	mux.Handle(usersv1connect.NewUsersServiceHandler(
@@ -86,9 +119,39 @@ func run() error {
 		connect.WithInterceptors(otelInterceptor, authInterceptor, traceEnhancer),
 	))
 
+	mux.Handle(accountsv1connect.NewAccountsServiceHandler(
+		servers.NewAccountsServer(dbx),
+		connect.WithInterceptors(otelInterceptor, authInterceptor, traceEnhancer),
+	))
+
+	mux.Handle(householdsv1connect.NewHouseholdsServiceHandler(
+		servers.NewHouseholdsServer(dbx),
+		connect.WithInterceptors(otelInterceptor, authInterceptor, traceEnhancer),
+	))
+
+	mux.Handle(settlementsv1connect.NewSettlementsServiceHandler(
+		servers.NewSettlementsServer(dbx),
+		connect.WithInterceptors(otelInterceptor, authInterceptor, authzInterceptor, traceEnhancer),
+	))
+
+	mux.Handle(dashboardv1connect.NewDashboardServiceHandler(
+		servers.NewDashboardServer(dbx),
+		connect.WithInterceptors(otelInterceptor, authInterceptor, traceEnhancer),
+	))
+
 	return micro.RunGracefully(withCORS(mux))
 }
 
+// workerCount reads how many jobs run at once from WORKER_COUNT.
+func workerCount() int {
+	count, err := strconv.Atoi(os.Getenv("WORKER_COUNT"))
+	if err != nil || count < 1 {
+		return defaultWorkerCount
+	}
+
+	return count
+}
+
 // withCORS adds CORS support to a Connect HTTP handler.
 func withCORS(h http.Handler) http.Handler {
 	allowedOrigins := micro.MustGetEnv("ALLOWED_ORIGINS")
@@ -0,0 +1,375 @@
+package servers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	accountsv1 "github.com/manzanit0/mcduck/api/accounts.v1"
+	"github.com/manzanit0/mcduck/api/accounts.v1/accountsv1connect"
+	"github.com/manzanit0/mcduck/internal/account"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type accountsServer struct {
+	Accounts *account.Repository
+}
+
+var _ accountsv1connect.AccountsServiceClient = &accountsServer{}
+
+func NewAccountsServer(db *sqlx.DB) accountsv1connect.AccountsServiceClient {
+	return &accountsServer{
+		Accounts: account.NewRepository(db),
+	}
+}
+
+func (s *accountsServer) CreateAccount(ctx context.Context, req *connect.Request[accountsv1.CreateAccountRequest]) (*connect.Response[accountsv1.CreateAccountResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	accountType, err := mapAccountTypeFromProto(req.Msg.Type)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account name must not be empty"))
+	}
+
+	openingDate := time.Now()
+	if req.Msg.OpeningDate != nil {
+		openingDate = req.Msg.OpeningDate.AsTime()
+	}
+
+	created, err := s.Accounts.CreateAccount(ctx, account.CreateAccountRequest{
+		UserEmail:      email,
+		Name:           req.Msg.Name,
+		Type:           accountType,
+		OpeningBalance: expense.ConvertToDollar(int32(req.Msg.OpeningBalance)),
+		OpeningDate:    openingDate,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create account", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to create account: %w", err))
+	}
+
+	return connect.NewResponse(&accountsv1.CreateAccountResponse{
+		Account: mapAccount(created, created.OpeningBalance),
+	}), nil
+}
+
+func (s *accountsServer) UpdateAccount(ctx context.Context, req *connect.Request[accountsv1.UpdateAccountRequest]) (*connect.Response[accountsv1.UpdateAccountResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("account.id", int(req.Msg.Id)))
+
+	_, err := s.findOwnedAccount(ctx, req.Msg.Id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var accountType *account.Type
+	if req.Msg.Type != nil {
+		t, err := mapAccountTypeFromProto(*req.Msg.Type)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		accountType = &t
+	}
+
+	var openingBalance *float32
+	if req.Msg.OpeningBalance != nil {
+		b := expense.ConvertToDollar(int32(*req.Msg.OpeningBalance))
+		openingBalance = &b
+	}
+
+	var openingDate *time.Time
+	if req.Msg.OpeningDate != nil {
+		d := req.Msg.OpeningDate.AsTime()
+		openingDate = &d
+	}
+
+	err = s.Accounts.UpdateAccount(ctx, account.UpdateAccountRequest{
+		ID:             req.Msg.Id,
+		Name:           req.Msg.Name,
+		Type:           accountType,
+		OpeningBalance: openingBalance,
+		OpeningDate:    openingDate,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update account", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to update account: %w", err))
+	}
+
+	return connect.NewResponse(&accountsv1.UpdateAccountResponse{}), nil
+}
+
+func (s *accountsServer) DeleteAccount(ctx context.Context, req *connect.Request[accountsv1.DeleteAccountRequest]) (*connect.Response[accountsv1.DeleteAccountResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("account.id", int(req.Msg.Id)))
+
+	_, err := s.findOwnedAccount(ctx, req.Msg.Id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	err = s.Accounts.DeleteAccount(ctx, req.Msg.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete account", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to delete account: %w", err))
+	}
+
+	return connect.NewResponse(&accountsv1.DeleteAccountResponse{}), nil
+}
+
+func (s *accountsServer) ListAccounts(ctx context.Context, req *connect.Request[accountsv1.ListAccountsRequest]) (*connect.Response[accountsv1.ListAccountsResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	accounts, err := s.Accounts.ListAccounts(ctx, email)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list accounts", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list accounts: %w", err))
+	}
+
+	accountIDs := make([]uint64, len(accounts))
+	for i, a := range accounts {
+		accountIDs[i] = a.ID
+	}
+
+	movements, err := s.Accounts.ListMovementsForAccounts(ctx, accountIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list account movements", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list account movements: %w", err))
+	}
+
+	res := connect.NewResponse(&accountsv1.ListAccountsResponse{})
+	for _, a := range accounts {
+		balance := account.CalculateBalance(a, movements[a.ID], time.Now())
+		res.Msg.Accounts = append(res.Msg.Accounts, mapAccount(&a, balance))
+	}
+
+	return res, nil
+}
+
+func (s *accountsServer) CreateTransfer(ctx context.Context, req *connect.Request[accountsv1.CreateTransferRequest]) (*connect.Response[accountsv1.CreateTransferResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	if req.Msg.FromAccountId == req.Msg.ToAccountId {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unable to transfer to the same account"))
+	}
+
+	if req.Msg.Amount == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("transfer amount must be positive"))
+	}
+
+	for _, id := range []uint64{req.Msg.FromAccountId, req.Msg.ToAccountId} {
+		_, err := s.findOwnedAccount(ctx, id)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	date := time.Now()
+	if req.Msg.Date != nil {
+		date = req.Msg.Date.AsTime()
+	}
+
+	created, err := s.Accounts.CreateTransfer(ctx, account.CreateTransferRequest{
+		UserEmail:     email,
+		FromAccountID: req.Msg.FromAccountId,
+		ToAccountID:   req.Msg.ToAccountId,
+		Amount:        expense.ConvertToDollar(int32(req.Msg.Amount)),
+		Date:          date,
+		Description:   req.Msg.Description,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create transfer", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to create transfer: %w", err))
+	}
+
+	return connect.NewResponse(&accountsv1.CreateTransferResponse{
+		Transfer: mapTransfer(*created),
+	}), nil
+}
+
+func (s *accountsServer) DeleteTransfer(ctx context.Context, req *connect.Request[accountsv1.DeleteTransferRequest]) (*connect.Response[accountsv1.DeleteTransferResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("transfer.id", int(req.Msg.Id)))
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	transfer, err := s.Accounts.FindTransfer(ctx, req.Msg.Id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("transfer with id %d doesn't exist", req.Msg.Id))
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find transfer: %w", err))
+	}
+
+	if transfer.UserEmail != email {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("transfer with id %d doesn't exist", req.Msg.Id))
+	}
+
+	err = s.Accounts.DeleteTransfer(ctx, req.Msg.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete transfer", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to delete transfer: %w", err))
+	}
+
+	return connect.NewResponse(&accountsv1.DeleteTransferResponse{}), nil
+}
+
+func (s *accountsServer) ListTransfers(ctx context.Context, req *connect.Request[accountsv1.ListTransfersRequest]) (*connect.Response[accountsv1.ListTransfersResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	var accountID uint64
+	if req.Msg.AccountId != nil {
+		accountID = *req.Msg.AccountId
+	}
+
+	transfers, err := s.Accounts.ListTransfers(ctx, email, accountID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list transfers", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list transfers: %w", err))
+	}
+
+	res := connect.NewResponse(&accountsv1.ListTransfersResponse{})
+	for _, t := range transfers {
+		res.Msg.Transfers = append(res.Msg.Transfers, mapTransfer(t))
+	}
+
+	return res, nil
+}
+
+func (s *accountsServer) GetBalanceHistory(ctx context.Context, req *connect.Request[accountsv1.GetBalanceHistoryRequest]) (*connect.Response[accountsv1.GetBalanceHistoryResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("account.id", int(req.Msg.AccountId)))
+
+	a, err := s.findOwnedAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	movements, err := s.Accounts.ListMovements(ctx, a.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list account movements", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list account movements: %w", err))
+	}
+
+	res := connect.NewResponse(&accountsv1.GetBalanceHistoryResponse{})
+	for _, p := range account.CalculateBalanceHistory(*a, movements, time.Now()) {
+		res.Msg.Points = append(res.Msg.Points, &accountsv1.BalancePoint{
+			Date:    timestamppb.New(p.Date),
+			Balance: balanceToCents(p.Balance),
+		})
+	}
+
+	return res, nil
+}
+
+// findOwnedAccount returns the account as long as it belongs to the user in
+// the context. Accounts of other users are reported as not found.
+func (s *accountsServer) findOwnedAccount(ctx context.Context, id uint64) (*account.Account, error) {
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	a, err := s.Accounts.FindAccount(ctx, id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account with id %d doesn't exist", id))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find account: %w", err))
+	}
+
+	if a.UserEmail != email {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account with id %d doesn't exist", id))
+	}
+
+	return a, nil
+}
+
+func mapAccount(a *account.Account, balance float32) *accountsv1.Account {
+	return &accountsv1.Account{
+		Id:             a.ID,
+		Name:           a.Name,
+		Type:           mapAccountType(a.Type),
+		OpeningBalance: int64(expense.ConvertToCents(a.OpeningBalance)),
+		OpeningDate:    timestamppb.New(a.OpeningDate),
+		CurrentBalance: balanceToCents(balance),
+	}
+}
+
+// balanceToCents is expense.ConvertToCents for balances, which may not fit in
+// an int32.
+func balanceToCents(balance float32) int64 {
+	return int64(math.Round(float64(balance) * 100))
+}
+
+func mapTransfer(t account.Transfer) *accountsv1.Transfer {
+	return &accountsv1.Transfer{
+		Id:            t.ID,
+		FromAccountId: t.FromAccountID,
+		ToAccountId:   t.ToAccountID,
+		Amount:        uint64(expense.ConvertToCents(t.Amount)),
+		Date:          timestamppb.New(t.Date),
+		Description:   t.Description,
+	}
+}
+
+func mapAccountType(t account.Type) accountsv1.AccountType {
+	switch t {
+	case account.TypeCash:
+		return accountsv1.AccountType_ACCOUNT_TYPE_CASH
+	case account.TypeCard:
+		return accountsv1.AccountType_ACCOUNT_TYPE_CARD
+	case account.TypeBank:
+		return accountsv1.AccountType_ACCOUNT_TYPE_BANK
+	default:
+		return accountsv1.AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	}
+}
+
+func mapAccountTypeFromProto(t accountsv1.AccountType) (account.Type, error) {
+	switch t {
+	case accountsv1.AccountType_ACCOUNT_TYPE_UNSPECIFIED, accountsv1.AccountType_ACCOUNT_TYPE_CASH:
+		return account.TypeCash, nil
+	case accountsv1.AccountType_ACCOUNT_TYPE_CARD:
+		return account.TypeCard, nil
+	case accountsv1.AccountType_ACCOUNT_TYPE_BANK:
+		return account.TypeBank, nil
+	default:
+		return "", fmt.Errorf("unknown account type %s", t)
+	}
+}
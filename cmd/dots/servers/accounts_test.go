@@ -0,0 +1,232 @@
+package servers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	accountsv1 "github.com/manzanit0/mcduck/api/accounts.v1"
+	"github.com/manzanit0/mcduck/api/accounts.v1/accountsv1connect"
+	"github.com/manzanit0/mcduck/cmd/dots/servers"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/pgtest"
+	"github.com/manzanit0/mcduck/internal/users"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	otherEmail := "bar@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: otherEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("accounts"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	openingDate := time.Now().AddDate(0, -2, 0)
+
+	createAccount := func(t *testing.T, ctx context.Context, s accountsv1connect.AccountsServiceClient, name string, openingBalance int64) *accountsv1.Account {
+		t.Helper()
+
+		res, err := s.CreateAccount(ctx, &connect.Request[accountsv1.CreateAccountRequest]{
+			Msg: &accountsv1.CreateAccountRequest{
+				Name:           name,
+				Type:           accountsv1.AccountType_ACCOUNT_TYPE_BANK,
+				OpeningBalance: openingBalance,
+				OpeningDate:    timestamppb.New(openingDate),
+			},
+		})
+		require.NoError(t, err)
+
+		return res.Msg.Account
+	}
+
+	t.Run("the balance takes the expenses paid from the account and its transfers", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("accounts"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewAccountsServer(db)
+		ctx := auth.WithInfo(ctx, userEmail)
+
+		bank := createAccount(t, ctx, s, "bank", 10000)
+		cash := createAccount(t, ctx, s, "cash", 0)
+
+		_, err = expense.NewRepository(db).CreateExpense(ctx, expense.CreateExpenseRequest{
+			UserEmail: userEmail,
+			Date:      time.Now().AddDate(0, 0, -1),
+			Amount:    12.5,
+			AccountID: &bank.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = s.CreateTransfer(ctx, &connect.Request[accountsv1.CreateTransferRequest]{
+			Msg: &accountsv1.CreateTransferRequest{
+				FromAccountId: bank.Id,
+				ToAccountId:   cash.Id,
+				Amount:        2000,
+				Date:          timestamppb.New(time.Now().AddDate(0, 0, -1)),
+			},
+		})
+		require.NoError(t, err)
+
+		res, err := s.ListAccounts(ctx, &connect.Request[accountsv1.ListAccountsRequest]{
+			Msg: &accountsv1.ListAccountsRequest{},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Accounts, 2)
+
+		balances := map[uint64]int64{}
+		for _, a := range res.Msg.Accounts {
+			balances[a.Id] = a.CurrentBalance
+		}
+
+		assert.EqualValues(t, 10000-1250-2000, balances[bank.Id])
+		assert.EqualValues(t, 2000, balances[cash.Id])
+
+		history, err := s.GetBalanceHistory(ctx, &connect.Request[accountsv1.GetBalanceHistoryRequest]{
+			Msg: &accountsv1.GetBalanceHistoryRequest{AccountId: bank.Id},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, history.Msg.Points)
+		assert.EqualValues(t, 10000, history.Msg.Points[0].Balance)
+		assert.EqualValues(t, 10000-1250-2000, history.Msg.Points[len(history.Msg.Points)-1].Balance)
+	})
+
+	t.Run("transfers only move money between accounts of the user", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("accounts"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewAccountsServer(db)
+		userCtx := auth.WithInfo(ctx, userEmail)
+		otherCtx := auth.WithInfo(ctx, otherEmail)
+
+		mine := createAccount(t, userCtx, s, "mine", 10000)
+		theirs := createAccount(t, otherCtx, s, "theirs", 10000)
+
+		_, err = s.CreateTransfer(userCtx, &connect.Request[accountsv1.CreateTransferRequest]{
+			Msg: &accountsv1.CreateTransferRequest{FromAccountId: mine.Id, ToAccountId: theirs.Id, Amount: 500},
+		})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = s.CreateTransfer(userCtx, &connect.Request[accountsv1.CreateTransferRequest]{
+			Msg: &accountsv1.CreateTransferRequest{FromAccountId: theirs.Id, ToAccountId: mine.Id, Amount: 500},
+		})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		savings := createAccount(t, userCtx, s, "savings", 0)
+		transfer, err := s.CreateTransfer(userCtx, &connect.Request[accountsv1.CreateTransferRequest]{
+			Msg: &accountsv1.CreateTransferRequest{FromAccountId: mine.Id, ToAccountId: savings.Id, Amount: 500},
+		})
+		require.NoError(t, err)
+
+		transfers, err := s.ListTransfers(otherCtx, &connect.Request[accountsv1.ListTransfersRequest]{
+			Msg: &accountsv1.ListTransfersRequest{},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, transfers.Msg.Transfers)
+
+		_, err = s.DeleteTransfer(otherCtx, &connect.Request[accountsv1.DeleteTransferRequest]{
+			Msg: &accountsv1.DeleteTransferRequest{Id: transfer.Msg.Transfer.Id},
+		})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		transfers, err = s.ListTransfers(userCtx, &connect.Request[accountsv1.ListTransfersRequest]{
+			Msg: &accountsv1.ListTransfersRequest{},
+		})
+		require.NoError(t, err)
+		require.Len(t, transfers.Msg.Transfers, 1)
+		assert.Equal(t, transfer.Msg.Transfer.Id, transfers.Msg.Transfers[0].Id)
+	})
+
+	t.Run("accounts of other users can't be seen nor changed", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("accounts"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewAccountsServer(db)
+		userCtx := auth.WithInfo(ctx, userEmail)
+		otherCtx := auth.WithInfo(ctx, otherEmail)
+
+		mine := createAccount(t, userCtx, s, "mine", 10000)
+
+		res, err := s.ListAccounts(otherCtx, &connect.Request[accountsv1.ListAccountsRequest]{
+			Msg: &accountsv1.ListAccountsRequest{},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Msg.Accounts)
+
+		name := "stolen"
+		_, err = s.UpdateAccount(otherCtx, &connect.Request[accountsv1.UpdateAccountRequest]{
+			Msg: &accountsv1.UpdateAccountRequest{Id: mine.Id, Name: &name},
+		})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = s.GetBalanceHistory(otherCtx, &connect.Request[accountsv1.GetBalanceHistoryRequest]{
+			Msg: &accountsv1.GetBalanceHistoryRequest{AccountId: mine.Id},
+		})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = s.DeleteAccount(otherCtx, &connect.Request[accountsv1.DeleteAccountRequest]{
+			Msg: &accountsv1.DeleteAccountRequest{Id: mine.Id},
+		})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		res, err = s.ListAccounts(userCtx, &connect.Request[accountsv1.ListAccountsRequest]{
+			Msg: &accountsv1.ListAccountsRequest{},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Accounts, 1)
+		assert.Equal(t, "mine", res.Msg.Accounts[0].Name)
+	})
+}
@@ -0,0 +1,124 @@
+package servers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	expensesv1 "github.com/manzanit0/mcduck/api/expenses.v1"
+	receiptsv1 "github.com/manzanit0/mcduck/api/receipts.v1"
+	settlementsv1 "github.com/manzanit0/mcduck/api/settlements.v1"
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// AuthorizationInterceptor verifies that the requesting user has access to the
+// expense or receipt referenced by the request, be it because it's theirs or
+// because it belongs to the ledger of their household. It must run after the
+// authentication interceptor.
+func AuthorizationInterceptor(db *sqlx.DB, blobs blob.Store) connect.UnaryInterceptorFunc {
+	households := household.NewRepository(db)
+	receipts := receipt.NewRepository(db, blobs)
+	expenses := expense.NewRepository(db)
+
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			var findOwner func(context.Context) (string, error)
+			var access household.Access
+
+			switch msg := req.Any().(type) {
+			case *receiptsv1.GetReceiptRequest:
+				findOwner = receiptOwner(receipts, msg.Id)
+				access = household.AccessRead
+			case *receiptsv1.UpdateReceiptRequest:
+				findOwner = receiptOwner(receipts, msg.Id)
+				access = household.AccessWrite
+			case *receiptsv1.DeleteReceiptRequest:
+				findOwner = receiptOwner(receipts, msg.Id)
+				access = household.AccessWrite
+			case *receiptsv1.ReparseReceiptRequest:
+				findOwner = receiptOwner(receipts, msg.Id)
+				access = household.AccessWrite
+			case *receiptsv1.ApplyReceiptChangesRequest:
+				findOwner = receiptOwner(receipts, msg.Id)
+				access = household.AccessWrite
+			case *receiptsv1.ReconcileReceiptRequest:
+				findOwner = receiptOwner(receipts, msg.ReceiptId)
+				access = household.AccessWrite
+			case *expensesv1.UpdateExpenseRequest:
+				findOwner = expenseOwner(expenses, msg.Id)
+				access = household.AccessWrite
+			case *expensesv1.DeleteExpenseRequest:
+				findOwner = expenseOwner(expenses, msg.Id)
+				access = household.AccessWrite
+			case *settlementsv1.SplitExpenseRequest:
+				findOwner = expenseOwner(expenses, msg.ExpenseId)
+				access = household.AccessWrite
+			case *settlementsv1.RemoveExpenseSplitRequest:
+				findOwner = expenseOwner(expenses, msg.ExpenseId)
+				access = household.AccessWrite
+			default:
+				return next(ctx, req)
+			}
+
+			spanCtx, span := xtrace.StartSpan(ctx, "Middleware: Authorization")
+
+			owner, err := findOwner(spanCtx)
+			if err != nil && errors.Is(err, sql.ErrNoRows) {
+				// Let the handler report the missing resource.
+				span.End()
+				return next(ctx, req)
+			} else if err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				span.End()
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find resource owner: %w", err))
+			}
+
+			email, _ := auth.GetUserEmailConnect(ctx)
+			ok, err := households.Authorize(spanCtx, email, owner, access)
+			if err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				span.End()
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to authorize user: %w", err))
+			}
+
+			span.End()
+
+			if !ok {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("the resource doesn't belong to requesting user"))
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+func receiptOwner(repo *receipt.Repository, id uint64) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		r, err := repo.GetReceipt(ctx, id)
+		if err != nil {
+			return "", err
+		}
+
+		return r.UserEmail, nil
+	}
+}
+
+func expenseOwner(repo *expense.Repository, id uint64) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		e, err := repo.FindExpense(ctx, int64(id))
+		if err != nil {
+			return "", err
+		}
+
+		return e.UserEmail, nil
+	}
+}
@@ -0,0 +1,102 @@
+package servers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	dashboardv1 "github.com/manzanit0/mcduck/api/dashboard.v1"
+	"github.com/manzanit0/mcduck/api/dashboard.v1/dashboardv1connect"
+	"github.com/manzanit0/mcduck/internal/dashboard"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type dashboardServer struct {
+	Expenses *expense.Repository
+}
+
+var _ dashboardv1connect.DashboardServiceClient = &dashboardServer{}
+
+func NewDashboardServer(db *sqlx.DB) dashboardv1connect.DashboardServiceClient {
+	return &dashboardServer{
+		Expenses: expense.NewRepository(db),
+	}
+}
+
+func (s *dashboardServer) GetDashboard(ctx context.Context, req *connect.Request[dashboardv1.GetDashboardRequest]) (*connect.Response[dashboardv1.GetDashboardResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	expenses, err := s.Expenses.ListExpenses(ctx, email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		slog.ErrorContext(ctx, "failed to list expenses", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list expenses: %w", err))
+	}
+
+	dash := dashboard.Build(expenses)
+
+	res := connect.NewResponse(&dashboardv1.GetDashboardResponse{
+		Categories:      dash.Categories,
+		CategoriesChart: mapChart(dash.CategoriesChart),
+	})
+
+	if len(expenses) > 0 {
+		res.Msg.MostRecent = timestamppb.New(dash.MostRecent)
+	}
+
+	for _, chart := range dash.SubcategoriesCharts {
+		res.Msg.SubcategoriesCharts = append(res.Msg.SubcategoriesCharts, mapChart(chart))
+	}
+
+	for _, aggregate := range dash.TopCategories {
+		res.Msg.TopCategories = append(res.Msg.TopCategories, &dashboardv1.CategoryAggregate{
+			Category:    aggregate.Category,
+			MonthYear:   aggregate.MonthYear,
+			TotalAmount: uint64(expense.ConvertToCents(aggregate.TotalAmount)),
+		})
+	}
+
+	for _, spend := range dash.TotalSpends {
+		res.Msg.TotalSpends = append(res.Msg.TotalSpends, &dashboardv1.MonthlySpend{
+			MonthYear: spend.MonthYear,
+			Date:      timestamppb.New(spend.Date),
+			Amount:    uint64(expense.ConvertToCents(spend.Amount)),
+		})
+	}
+
+	return res, nil
+}
+
+func mapChart(chart dashboard.ChartData) *dashboardv1.Chart {
+	mapped := &dashboardv1.Chart{
+		Title:  chart.Title,
+		Labels: chart.Labels,
+	}
+
+	for _, dataset := range chart.Datasets {
+		data := make([]uint64, len(dataset.Data))
+		for i, amount := range dataset.Data {
+			data[i] = uint64(expense.ConvertToCents(amount))
+		}
+
+		mapped.Datasets = append(mapped.Datasets, &dashboardv1.Dataset{
+			Label:            dataset.Label,
+			BorderColour:     dataset.BorderColour,
+			BackgroundColour: dataset.BackgroundColour,
+			Hidden:           dataset.Hidden,
+			Data:             data,
+		})
+	}
+
+	return mapped
+}
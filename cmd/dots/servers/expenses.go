@@ -15,12 +15,14 @@ import (
 	"github.com/jmoiron/sqlx"
 	expensesv1 "github.com/manzanit0/mcduck/api/expenses.v1"
 	"github.com/manzanit0/mcduck/api/expenses.v1/expensesv1connect"
+	"github.com/manzanit0/mcduck/internal/account"
 	"github.com/manzanit0/mcduck/internal/expense"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 )
 
 type expensesServer struct {
 	Expenses *expense.Repository
+	Accounts *account.Repository
 }
 
 var _ expensesv1connect.ExpensesServiceClient = &expensesServer{}
@@ -28,6 +30,7 @@ var _ expensesv1connect.ExpensesServiceClient = &expensesServer{}
 func NewExpensesServer(db *sqlx.DB) *expensesServer {
 	return &expensesServer{
 		Expenses: expense.NewRepository(db),
+		Accounts: account.NewRepository(db),
 	}
 }
 
@@ -59,7 +62,7 @@ type UpdateExpense struct {
 func (e *expensesServer) UpdateExpense(ctx context.Context, req *connect.Request[expensesv1.UpdateExpenseRequest]) (*connect.Response[expensesv1.UpdateExpenseResponse], error) {
 	ctx, span := xtrace.GetSpan(ctx)
 
-	_, err := e.Expenses.FindExpense(ctx, int64(req.Msg.Id))
+	existing, err := e.Expenses.FindExpense(ctx, int64(req.Msg.Id))
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		span.SetStatus(codes.Error, err.Error())
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expense with id %d doesn't exist", req.Msg.Id))
@@ -68,6 +71,22 @@ func (e *expensesServer) UpdateExpense(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find expense: %w", err))
 	}
 
+	// The expense may be in the ledger of a household the user belongs to, so
+	// the account must be of whoever owns the expense, not the user. Zero
+	// unassigns it.
+	if req.Msg.AccountId != nil && *req.Msg.AccountId != 0 {
+		a, err := e.Accounts.FindAccount(ctx, *req.Msg.AccountId)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, err.Error())
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find account: %w", err))
+		}
+
+		if err != nil || a.UserEmail != existing.UserEmail {
+			span.SetStatus(codes.Error, "account not found")
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account with id %d doesn't exist", *req.Msg.AccountId))
+		}
+	}
+
 	var date *time.Time
 	if req.Msg.Date != nil {
 		d := req.Msg.Date.AsTime()
@@ -88,6 +107,7 @@ func (e *expensesServer) UpdateExpense(ctx context.Context, req *connect.Request
 		Subcategory: req.Msg.Subcategory,
 		Description: req.Msg.Description,
 		ReceiptID:   req.Msg.ReceiptId,
+		AccountID:   req.Msg.AccountId,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to update expense", "error", err.Error())
@@ -107,6 +127,11 @@ func (e *expensesServer) UpdateExpense(ctx context.Context, req *connect.Request
 		receiptID = &exp.ReceiptID
 	}
 
+	var accountID *uint64
+	if exp.AccountID != 0 {
+		accountID = &exp.AccountID
+	}
+
 	res := connect.NewResponse(&expensesv1.UpdateExpenseResponse{
 		Expense: &expensesv1.Expense{
 			Id:          exp.ID,
@@ -116,6 +141,7 @@ func (e *expensesServer) UpdateExpense(ctx context.Context, req *connect.Request
 			Category:    exp.Category,
 			Subcategory: exp.Subcategory,
 			Description: exp.Description,
+			AccountId:   accountID,
 		},
 	})
 
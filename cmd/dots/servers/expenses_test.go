@@ -8,6 +8,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	expensesv1 "github.com/manzanit0/mcduck/api/expenses.v1"
 	"github.com/manzanit0/mcduck/cmd/dots/servers"
+	"github.com/manzanit0/mcduck/internal/account"
 	"github.com/manzanit0/mcduck/internal/expense"
 	"github.com/manzanit0/mcduck/internal/pgtest"
 	"github.com/manzanit0/mcduck/internal/users"
@@ -36,6 +37,10 @@ func TestUpdateExpense(t *testing.T) {
 	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
 	require.NoError(t, err)
 
+	otherEmail := "bar@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: otherEmail, Password: "foo"})
+	require.NoError(t, err)
+
 	err = db.Close()
 	require.NoError(t, err)
 
@@ -361,4 +366,87 @@ func TestUpdateExpense(t *testing.T) {
 		assert.Nil(t, res.Msg.Expense.ReceiptId)
 		assert.EqualValues(t, "24/02/1993", res.Msg.Expense.Date.AsTime().Format("02/01/2006"))
 	})
+
+	t.Run("only accounts of the owner of the expense can be set", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_expense"))
+			require.NoError(t, err)
+		})
+
+		repo := expense.NewRepository(db)
+		expenseID, err := repo.CreateExpense(ctx, expense.CreateExpenseRequest{
+			UserEmail: userEmail,
+			Date:      time.Now(),
+			Amount:    12,
+		})
+		require.NoError(t, err)
+
+		accounts := account.NewRepository(db)
+		own, err := accounts.CreateAccount(ctx, account.CreateAccountRequest{UserEmail: userEmail, Name: "card", Type: account.TypeCard, OpeningDate: time.Now()})
+		require.NoError(t, err)
+
+		other, err := accounts.CreateAccount(ctx, account.CreateAccountRequest{UserEmail: otherEmail, Name: "card", Type: account.TypeCard, OpeningDate: time.Now()})
+		require.NoError(t, err)
+
+		s := servers.NewExpensesServer(db)
+
+		_, err = s.UpdateExpense(ctx, &connect.Request[expensesv1.UpdateExpenseRequest]{
+			Msg: &expensesv1.UpdateExpenseRequest{Id: uint64(expenseID), AccountId: &other.ID},
+		})
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		got, err := repo.FindExpense(ctx, expenseID)
+		require.NoError(t, err)
+		assert.Zero(t, got.AccountID)
+
+		res, err := s.UpdateExpense(ctx, &connect.Request[expensesv1.UpdateExpenseRequest]{
+			Msg: &expensesv1.UpdateExpenseRequest{Id: uint64(expenseID), AccountId: &own.ID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, own.ID, *res.Msg.Expense.AccountId)
+	})
+
+	t.Run("account is unassigned with zero", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_expense"))
+			require.NoError(t, err)
+		})
+
+		own, err := account.NewRepository(db).CreateAccount(ctx, account.CreateAccountRequest{UserEmail: userEmail, Name: "card", Type: account.TypeCard, OpeningDate: time.Now()})
+		require.NoError(t, err)
+
+		repo := expense.NewRepository(db)
+		expenseID, err := repo.CreateExpense(ctx, expense.CreateExpenseRequest{
+			UserEmail: userEmail,
+			Date:      time.Now(),
+			Amount:    12,
+			AccountID: &own.ID,
+		})
+		require.NoError(t, err)
+
+		s := servers.NewExpensesServer(db)
+
+		unassigned := uint64(0)
+		res, err := s.UpdateExpense(ctx, &connect.Request[expensesv1.UpdateExpenseRequest]{
+			Msg: &expensesv1.UpdateExpenseRequest{Id: uint64(expenseID), AccountId: &unassigned},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, res.Msg.Expense.AccountId)
+
+		got, err := repo.FindExpense(ctx, expenseID)
+		require.NoError(t, err)
+		assert.Zero(t, got.AccountID)
+	})
 }
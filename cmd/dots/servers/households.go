@@ -0,0 +1,416 @@
+package servers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	householdsv1 "github.com/manzanit0/mcduck/api/households.v1"
+	"github.com/manzanit0/mcduck/api/households.v1/householdsv1connect"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type householdsServer struct {
+	Households *household.Repository
+}
+
+var _ householdsv1connect.HouseholdsServiceClient = &householdsServer{}
+
+func NewHouseholdsServer(db *sqlx.DB) householdsv1connect.HouseholdsServiceClient {
+	return &householdsServer{
+		Households: household.NewRepository(db),
+	}
+}
+
+func (s *householdsServer) CreateHousehold(ctx context.Context, req *connect.Request[householdsv1.CreateHouseholdRequest]) (*connect.Response[householdsv1.CreateHouseholdResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("household name must not be empty"))
+	}
+
+	_, err := s.Households.FindMembership(ctx, email)
+	if err == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("user already belongs to a household"))
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find membership: %w", err))
+	}
+
+	h, err := s.Households.CreateHousehold(ctx, req.Msg.Name, email)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create household", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to create household: %w", err))
+	}
+
+	res, err := s.mapHousehold(ctx, h)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return connect.NewResponse(&householdsv1.CreateHouseholdResponse{Household: res}), nil
+}
+
+func (s *householdsServer) GetHousehold(ctx context.Context, req *connect.Request[householdsv1.GetHouseholdRequest]) (*connect.Response[householdsv1.GetHouseholdResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	m, err := s.findMembership(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	h, err := s.Households.FindHousehold(ctx, m.HouseholdID)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find household: %w", err))
+	}
+
+	res, err := s.mapHousehold(ctx, h)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return connect.NewResponse(&householdsv1.GetHouseholdResponse{Household: res}), nil
+}
+
+func (s *householdsServer) InviteMember(ctx context.Context, req *connect.Request[householdsv1.InviteMemberRequest]) (*connect.Response[householdsv1.InviteMemberResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	m, err := s.findOwnership(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if req.Msg.Email == "" || strings.EqualFold(req.Msg.Email, m.UserEmail) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid invitee email"))
+	}
+
+	role, err := mapMemberRoleFromProto(req.Msg.Role)
+	if err != nil || role == household.RoleOwner {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid member role %s", req.Msg.Role))
+	}
+
+	invite, err := s.Households.CreateInvite(ctx, household.CreateInviteRequest{
+		HouseholdID:  m.HouseholdID,
+		InviterEmail: m.UserEmail,
+		InviteeEmail: req.Msg.Email,
+		Role:         role,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create invite", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to create invite: %w", err))
+	}
+
+	return connect.NewResponse(&householdsv1.InviteMemberResponse{Invite: mapInvite(invite)}), nil
+}
+
+func (s *householdsServer) ListInvites(ctx context.Context, req *connect.Request[householdsv1.ListInvitesRequest]) (*connect.Response[householdsv1.ListInvitesResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	invites, err := s.Households.ListPendingInvites(ctx, email)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list invites", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list invites: %w", err))
+	}
+
+	res := connect.NewResponse(&householdsv1.ListInvitesResponse{})
+	for i := range invites {
+		res.Msg.Invites = append(res.Msg.Invites, mapInvite(&invites[i]))
+	}
+
+	return res, nil
+}
+
+func (s *householdsServer) AcceptInvite(ctx context.Context, req *connect.Request[householdsv1.AcceptInviteRequest]) (*connect.Response[householdsv1.AcceptInviteResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	invite, err := s.findPendingInvite(ctx, req.Msg.InviteId)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	_, err = s.Households.FindMembership(ctx, email)
+	if err == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("user already belongs to a household"))
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find membership: %w", err))
+	}
+
+	err = s.Households.AcceptInvite(ctx, invite, email)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to accept invite", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to accept invite: %w", err))
+	}
+
+	h, err := s.Households.FindHousehold(ctx, invite.HouseholdID)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find household: %w", err))
+	}
+
+	res, err := s.mapHousehold(ctx, h)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return connect.NewResponse(&householdsv1.AcceptInviteResponse{Household: res}), nil
+}
+
+func (s *householdsServer) DeclineInvite(ctx context.Context, req *connect.Request[householdsv1.DeclineInviteRequest]) (*connect.Response[householdsv1.DeclineInviteResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	invite, err := s.findPendingInvite(ctx, req.Msg.InviteId)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	err = s.Households.DeclineInvite(ctx, invite.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to decline invite", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to decline invite: %w", err))
+	}
+
+	return connect.NewResponse(&householdsv1.DeclineInviteResponse{}), nil
+}
+
+// LeaveHousehold removes the requesting user from their household. When the
+// owner leaves, the household is dissolved.
+func (s *householdsServer) LeaveHousehold(ctx context.Context, req *connect.Request[householdsv1.LeaveHouseholdRequest]) (*connect.Response[householdsv1.LeaveHouseholdResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	m, err := s.findMembership(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if m.Role == household.RoleOwner {
+		err = s.Households.DeleteHousehold(ctx, m.HouseholdID)
+	} else {
+		err = s.Households.RemoveMember(ctx, m.HouseholdID, m.UserEmail)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to leave household", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to leave household: %w", err))
+	}
+
+	return connect.NewResponse(&householdsv1.LeaveHouseholdResponse{}), nil
+}
+
+func (s *householdsServer) RemoveMember(ctx context.Context, req *connect.Request[householdsv1.RemoveMemberRequest]) (*connect.Response[householdsv1.RemoveMemberResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	m, err := s.findOwnership(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	member, err := s.findMember(ctx, m.HouseholdID, req.Msg.Email)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if member.Role == household.RoleOwner {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the owner can't be removed from the household"))
+	}
+
+	err = s.Households.RemoveMember(ctx, m.HouseholdID, member.UserEmail)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to remove member", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to remove member: %w", err))
+	}
+
+	return connect.NewResponse(&householdsv1.RemoveMemberResponse{}), nil
+}
+
+func (s *householdsServer) UpdateMemberRole(ctx context.Context, req *connect.Request[householdsv1.UpdateMemberRoleRequest]) (*connect.Response[householdsv1.UpdateMemberRoleResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	m, err := s.findOwnership(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	role, err := mapMemberRoleFromProto(req.Msg.Role)
+	if err != nil || role == household.RoleOwner {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid member role %s", req.Msg.Role))
+	}
+
+	member, err := s.findMember(ctx, m.HouseholdID, req.Msg.Email)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if member.Role == household.RoleOwner {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the role of the owner can't be changed"))
+	}
+
+	err = s.Households.UpdateMemberRole(ctx, m.HouseholdID, member.UserEmail, role)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update member role", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to update member role: %w", err))
+	}
+
+	return connect.NewResponse(&householdsv1.UpdateMemberRoleResponse{}), nil
+}
+
+func (s *householdsServer) findMembership(ctx context.Context) (*household.Member, error) {
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	m, err := s.Households.FindMembership(ctx, email)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user doesn't belong to any household"))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find membership: %w", err))
+	}
+
+	return m, nil
+}
+
+// findOwnership returns the membership of the requesting user as long as they
+// own the household, since only owners can manage members.
+func (s *householdsServer) findOwnership(ctx context.Context) (*household.Member, error) {
+	m, err := s.findMembership(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Role != household.RoleOwner {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the owner can manage the household"))
+	}
+
+	return m, nil
+}
+
+func (s *householdsServer) findMember(ctx context.Context, householdID uint64, email string) (*household.Member, error) {
+	members, err := s.Households.ListMembers(ctx, householdID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list members: %w", err))
+	}
+
+	for i := range members {
+		if strings.EqualFold(members[i].UserEmail, email) {
+			return &members[i], nil
+		}
+	}
+
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s isn't a member of the household", email))
+}
+
+// findPendingInvite returns the invite as long as it's addressed to the
+// requesting user and hasn't been answered yet.
+func (s *householdsServer) findPendingInvite(ctx context.Context, id uint64) (*household.Invite, error) {
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	invite, err := s.Households.FindInvite(ctx, id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("invite with id %d doesn't exist", id))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find invite: %w", err))
+	}
+
+	if !strings.EqualFold(invite.InviteeEmail, email) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("invite with id %d doesn't exist", id))
+	}
+
+	if invite.Status != household.InviteStatusPending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invite with id %d is already %s", id, invite.Status))
+	}
+
+	return invite, nil
+}
+
+func (s *householdsServer) mapHousehold(ctx context.Context, h *household.Household) (*householdsv1.Household, error) {
+	members, err := s.Households.ListMembers(ctx, h.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list members: %w", err))
+	}
+
+	res := &householdsv1.Household{
+		Id:         h.ID,
+		Name:       h.Name,
+		OwnerEmail: h.OwnerEmail,
+	}
+
+	for _, m := range members {
+		res.Members = append(res.Members, &householdsv1.Member{
+			Email:    m.UserEmail,
+			Role:     mapMemberRole(m.Role),
+			JoinedAt: timestamppb.New(m.JoinedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func mapInvite(i *household.Invite) *householdsv1.Invite {
+	return &householdsv1.Invite{
+		Id:           i.ID,
+		HouseholdId:  i.HouseholdID,
+		InviterEmail: i.InviterEmail,
+		InviteeEmail: i.InviteeEmail,
+		Role:         mapMemberRole(i.Role),
+		CreatedAt:    timestamppb.New(i.CreatedAt),
+	}
+}
+
+func mapMemberRole(r household.Role) householdsv1.MemberRole {
+	switch r {
+	case household.RoleOwner:
+		return householdsv1.MemberRole_MEMBER_ROLE_OWNER
+	case household.RoleReadWrite:
+		return householdsv1.MemberRole_MEMBER_ROLE_READ_WRITE
+	case household.RoleRead:
+		return householdsv1.MemberRole_MEMBER_ROLE_READ
+	default:
+		return householdsv1.MemberRole_MEMBER_ROLE_UNSPECIFIED
+	}
+}
+
+func mapMemberRoleFromProto(r householdsv1.MemberRole) (household.Role, error) {
+	switch r {
+	case householdsv1.MemberRole_MEMBER_ROLE_OWNER:
+		return household.RoleOwner, nil
+	case householdsv1.MemberRole_MEMBER_ROLE_READ_WRITE:
+		return household.RoleReadWrite, nil
+	case householdsv1.MemberRole_MEMBER_ROLE_READ:
+		return household.RoleRead, nil
+	default:
+		return "", fmt.Errorf("unknown member role %s", r)
+	}
+}
@@ -0,0 +1,176 @@
+package servers_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	householdsv1 "github.com/manzanit0/mcduck/api/households.v1"
+	"github.com/manzanit0/mcduck/cmd/dots/servers"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/internal/pgtest"
+	"github.com/manzanit0/mcduck/internal/users"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestHouseholdInvites(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	ownerEmail := "owner@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: ownerEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	partnerEmail := "partner@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: partnerEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("household_invites"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("invited user joins the household with the invite role", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("household_invites"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewHouseholdsServer(db)
+		ownerCtx := auth.WithInfo(ctx, ownerEmail)
+		partnerCtx := auth.WithInfo(ctx, partnerEmail)
+
+		_, err = s.CreateHousehold(ownerCtx, &connect.Request[householdsv1.CreateHouseholdRequest]{
+			Msg: &householdsv1.CreateHouseholdRequest{Name: "home"},
+		})
+		require.NoError(t, err)
+
+		invite, err := s.InviteMember(ownerCtx, &connect.Request[householdsv1.InviteMemberRequest]{
+			Msg: &householdsv1.InviteMemberRequest{Email: partnerEmail, Role: householdsv1.MemberRole_MEMBER_ROLE_READ},
+		})
+		require.NoError(t, err)
+
+		res, err := s.AcceptInvite(partnerCtx, &connect.Request[householdsv1.AcceptInviteRequest]{
+			Msg: &householdsv1.AcceptInviteRequest{InviteId: invite.Msg.Invite.Id},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Household.Members, 2)
+		assert.Equal(t, partnerEmail, res.Msg.Household.Members[1].Email)
+		assert.Equal(t, householdsv1.MemberRole_MEMBER_ROLE_READ, res.Msg.Household.Members[1].Role)
+
+		repo := household.NewRepository(db)
+
+		ok, err := repo.Authorize(ctx, partnerEmail, ownerEmail, household.AccessRead)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = repo.Authorize(ctx, partnerEmail, ownerEmail, household.AccessWrite)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = repo.Authorize(ctx, ownerEmail, partnerEmail, household.AccessWrite)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("removed member loses access to the ledger", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("household_invites"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewHouseholdsServer(db)
+		ownerCtx := auth.WithInfo(ctx, ownerEmail)
+		partnerCtx := auth.WithInfo(ctx, partnerEmail)
+
+		_, err = s.CreateHousehold(ownerCtx, &connect.Request[householdsv1.CreateHouseholdRequest]{
+			Msg: &householdsv1.CreateHouseholdRequest{Name: "home"},
+		})
+		require.NoError(t, err)
+
+		invite, err := s.InviteMember(ownerCtx, &connect.Request[householdsv1.InviteMemberRequest]{
+			Msg: &householdsv1.InviteMemberRequest{Email: partnerEmail, Role: householdsv1.MemberRole_MEMBER_ROLE_READ_WRITE},
+		})
+		require.NoError(t, err)
+
+		_, err = s.AcceptInvite(partnerCtx, &connect.Request[householdsv1.AcceptInviteRequest]{
+			Msg: &householdsv1.AcceptInviteRequest{InviteId: invite.Msg.Invite.Id},
+		})
+		require.NoError(t, err)
+
+		_, err = s.RemoveMember(partnerCtx, &connect.Request[householdsv1.RemoveMemberRequest]{
+			Msg: &householdsv1.RemoveMemberRequest{Email: ownerEmail},
+		})
+		require.ErrorContains(t, err, "permission_denied: only the owner can manage the household")
+
+		_, err = s.RemoveMember(ownerCtx, &connect.Request[householdsv1.RemoveMemberRequest]{
+			Msg: &householdsv1.RemoveMemberRequest{Email: partnerEmail},
+		})
+		require.NoError(t, err)
+
+		ok, err := household.NewRepository(db).Authorize(ctx, partnerEmail, ownerEmail, household.AccessRead)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("invites can't be accepted by somebody else", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("household_invites"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewHouseholdsServer(db)
+		ownerCtx := auth.WithInfo(ctx, ownerEmail)
+
+		_, err = s.CreateHousehold(ownerCtx, &connect.Request[householdsv1.CreateHouseholdRequest]{
+			Msg: &householdsv1.CreateHouseholdRequest{Name: "home"},
+		})
+		require.NoError(t, err)
+
+		invite, err := s.InviteMember(ownerCtx, &connect.Request[householdsv1.InviteMemberRequest]{
+			Msg: &householdsv1.InviteMemberRequest{Email: "stranger@email.com", Role: householdsv1.MemberRole_MEMBER_ROLE_READ},
+		})
+		require.NoError(t, err)
+
+		_, err = s.AcceptInvite(auth.WithInfo(ctx, partnerEmail), &connect.Request[householdsv1.AcceptInviteRequest]{
+			Msg: &householdsv1.AcceptInviteRequest{InviteId: invite.Msg.Invite.Id},
+		})
+		require.ErrorContains(t, err, "not_found")
+	})
+}
@@ -0,0 +1,200 @@
+package servers
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	receiptsv1 "github.com/manzanit0/mcduck/api/receipts.v1"
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/inbound"
+	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/internal/users"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// maxEmailSize is the largest message accepted. Attachments are base64
+// encoded, which takes a third more than the files themselves.
+const maxEmailSize = receipt.MaxUploadSize * 3 / 2
+
+// InboundEmailHandler creates receipts out of the emails users forward to
+// their inbound address. It takes the raw message either as the body of the
+// request, or as the body-mime field of a form, like Mailgun sends it. Mail
+// providers are authenticated by the secret in the query.
+type InboundEmailHandler struct {
+	db       *sqlx.DB
+	receipts *receiptsServer
+	domain   string
+	secret   string
+}
+
+func NewInboundEmailHandler(db *sqlx.DB, blobs blob.Store, domain, secret string) *InboundEmailHandler {
+	return &InboundEmailHandler{
+		db: db,
+		receipts: &receiptsServer{
+			Receipts: receipt.NewRepository(db, blobs),
+			Expenses: expense.NewRepository(db),
+		},
+		domain: domain,
+		secret: secret,
+	}
+}
+
+type inboundEmailResponse struct {
+	Receipts []uint64 `json:"receipts"`
+	// Errors tell why the rest of the files weren't created.
+	Errors []string `json:"errors,omitempty"`
+}
+
+func (h *InboundEmailHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := xtrace.StartSpan(r.Context(), "Inbound Email")
+	defer span.End()
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("secret")), []byte(h.secret)) != 1 {
+		span.SetStatus(codes.Error, "invalid secret")
+		http.Error(w, "invalid secret", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxEmailSize)
+
+	message, recipient, err := readInboundMessage(r)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		http.Error(w, fmt.Sprintf("unable to read message: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	email, err := inbound.Parse(bytes.NewReader(message))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		http.Error(w, fmt.Sprintf("unable to parse message: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	// The envelope recipient is the one the provider delivered the message
+	// to, which the headers may not include.
+	recipients := email.Recipients
+	if recipient != "" {
+		recipients = []string{recipient}
+	}
+
+	var user *users.User
+	for _, address := range recipients {
+		token, ok := inbound.TokenFromAddress(address, h.domain)
+		if !ok {
+			continue
+		}
+
+		user, err = users.FindByInboundToken(ctx, h.db, token)
+		if err != nil && errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(ctx, "failed to find user by inbound token", "error", err.Error())
+			http.Error(w, fmt.Sprintf("unable to find user: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+
+		break
+	}
+
+	if user == nil {
+		span.SetStatus(codes.Error, "unknown recipient")
+		http.Error(w, "no user has any of the recipients as inbound address", http.StatusNotFound)
+		return
+	}
+
+	files := email.Receipts()
+	if len(files) == 0 {
+		span.SetStatus(codes.Error, "no receipts")
+		http.Error(w, "the message has no attachments nor text to read receipts from", http.StatusUnprocessableEntity)
+		return
+	}
+
+	span.SetAttributes(attribute.Int("email.attachments", len(email.Attachments)), attribute.Int("email.receipts", len(files)))
+
+	ctx = auth.WithInfo(ctx, user.Email)
+	res, err := h.receipts.createReceipts(ctx, newReceiptFiles(files), receiptsv1.ReceiptSplit_RECEIPT_SPLIT_NONE, receiptsv1.DuplicatePolicy_DUPLICATE_POLICY_FLAG)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to create receipts from email", "error", err.Error())
+		http.Error(w, fmt.Sprintf("unable to create receipts: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	body := inboundEmailResponse{Receipts: []uint64{}}
+	for _, r := range res.Msg.Results {
+		if r.GetReceipt() != nil {
+			body.Receipts = append(body.Receipts, r.GetReceipt().Id)
+		} else {
+			body.Errors = append(body.Errors, r.GetError().Message)
+		}
+	}
+
+	// Providers retry on server errors, which only helps when storing the
+	// receipts failed rather than reading them.
+	if len(body.Receipts) == 0 {
+		span.SetStatus(codes.Error, "no receipts created")
+		status := http.StatusUnprocessableEntity
+		for _, r := range res.Msg.Results {
+			if r.GetError().GetRetryable() {
+				status = http.StatusInternalServerError
+			}
+		}
+
+		http.Error(w, fmt.Sprintf("unable to create receipts: %s", strings.Join(body.Errors, "; ")), status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// readInboundMessage returns the raw message in the request, and the
+// envelope recipient when the provider sends it.
+func readInboundMessage(r *http.Request) ([]byte, string, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" && mediaType != "application/x-www-form-urlencoded" {
+		message, err := io.ReadAll(r.Body)
+		return message, r.URL.Query().Get("recipient"), err
+	}
+
+	err := r.ParseMultipartForm(maxEmailSize)
+	if err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return nil, "", fmt.Errorf("parse form: %w", err)
+	}
+
+	if message := r.FormValue("body-mime"); message != "" {
+		return []byte(message), r.FormValue("recipient"), nil
+	}
+
+	// Large messages are sent as a file instead.
+	file, _, err := r.FormFile("body-mime")
+	if err != nil {
+		return nil, "", fmt.Errorf("no body-mime field: %w", err)
+	}
+	defer file.Close()
+
+	message, err := io.ReadAll(file)
+	return message, r.FormValue("recipient"), err
+}
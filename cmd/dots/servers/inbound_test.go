@@ -0,0 +1,107 @@
+package servers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/cmd/dots/servers"
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/internal/pgtest"
+	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/internal/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboundEmail(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "jane@example.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	// The token in the address of the fixture.
+	_, err = db.ExecContext(ctx, `UPDATE users SET inbound_token = '0123456789abcdef0123456789abcdef' WHERE email = $1`, userEmail)
+	require.NoError(t, err)
+
+	message, err := os.ReadFile("testdata/invoice.eml")
+	require.NoError(t, err)
+
+	handler := servers.NewInboundEmailHandler(db, blob.NewPostgresStore(db), "in.mcduck.test", "secret")
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+
+	post := func(secret, contentType string, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/inbound/email?secret="+secret, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("raw message creates a receipt for its attachment", func(t *testing.T) {
+		rec := post("secret", "message/rfc822", message)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+		var res struct {
+			Receipts []uint64 `json:"receipts"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res.Receipts, 1)
+
+		r, err := repo.GetReceipt(ctx, res.Receipts[0])
+		require.NoError(t, err)
+		assert.Equal(t, receipt.StatusUploaded, r.Status)
+
+		image, err := repo.GetReceiptImage(ctx, res.Receipts[0])
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(image, []byte("%PDF-1.4")))
+	})
+
+	t.Run("message posted as a form, with the envelope recipient", func(t *testing.T) {
+		// Only the envelope recipient is the inbound address.
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		require.NoError(t, w.WriteField("recipient", "receipts+0123456789abcdef0123456789abcdef@in.mcduck.test"))
+		require.NoError(t, w.WriteField("body-mime", strings.ReplaceAll(string(message), "0123456789abcdef", "ffffffffffffffff")))
+		require.NoError(t, w.Close())
+
+		rec := post("secret", w.FormDataContentType(), body.Bytes())
+		assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		rec := post("guess", "message/rfc822", message)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("unknown recipient", func(t *testing.T) {
+		rec := post("secret", "message/rfc822", []byte(strings.ReplaceAll(string(message), "0123456789abcdef", "ffffffffffffffff")))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
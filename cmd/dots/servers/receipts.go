@@ -1,130 +1,328 @@
 package servers
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
-	"sort"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/jmoiron/sqlx"
 	receiptsv1 "github.com/manzanit0/mcduck/api/receipts.v1"
 	"github.com/manzanit0/mcduck/api/receipts.v1/receiptsv1connect"
+	"github.com/manzanit0/mcduck/internal/blob"
 	"github.com/manzanit0/mcduck/internal/client"
 	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/expensereport"
+	"github.com/manzanit0/mcduck/internal/imaging"
 	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/internal/reconcile"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/tgram"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
-	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type receiptsServer struct {
-	Telegram tgram.Client
-	Parser   client.ParserClient
-	Receipts *receipt.Repository
-	Expenses *expense.Repository
+	Telegram  tgram.Client
+	Parser    client.ParserClient
+	Receipts  *receipt.Repository
+	Expenses  *expense.Repository
+	Reconcile *reconcile.Repository
+	Reports   *expensereport.Repository
 }
 
-var _ receiptsv1connect.ReceiptsServiceClient = &receiptsServer{}
+var _ receiptsv1connect.ReceiptsServiceHandler = &receiptsServer{}
 
-func NewReceiptsServer(db *sqlx.DB, p client.ParserClient, t tgram.Client) receiptsv1connect.ReceiptsServiceClient {
+func NewReceiptsServer(db *sqlx.DB, blobs blob.Store, p client.ParserClient, t tgram.Client) receiptsv1connect.ReceiptsServiceHandler {
 	return &receiptsServer{
-		Telegram: t,
-		Parser:   p,
-		Receipts: receipt.NewRepository(db),
-		Expenses: expense.NewRepository(db),
+		Telegram:  t,
+		Parser:    p,
+		Receipts:  receipt.NewRepository(db, blobs),
+		Expenses:  expense.NewRepository(db),
+		Reconcile: reconcile.NewRepository(db),
+		Reports:   expensereport.NewRepository(db, blobs),
 	}
 }
 
 func (s *receiptsServer) CreateReceipts(ctx context.Context, req *connect.Request[receiptsv1.CreateReceiptsRequest]) (*connect.Response[receiptsv1.CreateReceiptsResponse], error) {
+	return s.createReceipts(ctx, newReceiptFiles(req.Msg.ReceiptFiles), req.Msg.Split, req.Msg.DuplicatePolicy)
+}
+
+func (s *receiptsServer) UploadReceipts(ctx context.Context, stream *connect.ClientStream[receiptsv1.UploadReceiptsRequest]) (*connect.Response[receiptsv1.CreateReceiptsResponse], error) {
 	span := trace.SpanFromContext(ctx)
 
-	email := auth.MustGetUserEmailConnect(ctx)
+	var options *receiptsv1.UploadOptions
+	var files []receiptFile
+	var current *receipt.Upload
+	var total, received uint64
+
+	// skipping is set while receiving the chunks of a file which was already
+	// refused. They're read, since the client sends them anyway, but dropped.
+	var skipping bool
 
-	type receiptWithExpenses struct {
-		receipt  *receipt.Receipt
-		expenses []expense.Expense
+	refuse := func(name string, err error) {
+		files = append(files, receiptFile{name: name, err: err})
+		current = nil
+		skipping = true
 	}
 
-	ch := make(chan receiptWithExpenses, len(req.Msg.ReceiptFiles))
+	// Uploads are only checked once complete, which is when the next file or
+	// the end of the stream arrives.
+	complete := func() {
+		if current == nil {
+			return
+		}
 
-	g, ctx := errgroup.WithContext(ctx)
-	for i, file := range req.Msg.ReceiptFiles {
-		g.Go(func() error {
-			ctx, span := xtrace.StartSpan(ctx, "Process Receipt")
-			defer span.End()
+		data, err := current.Bytes()
+		files = append(files, receiptFile{name: current.Name, data: data, err: err})
+		current = nil
+	}
 
-			parsed, err := s.Parser.ParseReceipt(ctx, email, file)
-			if err != nil {
-				slog.ErrorContext(ctx, "failed to parse receipt through parser service", "error", err.Error(), "index", i)
-				span.SetStatus(codes.Error, err.Error())
-				return fmt.Errorf("parse receipt: %w", err)
+	for stream.Receive() {
+		var err error
+		switch payload := stream.Msg().Payload.(type) {
+		case *receiptsv1.UploadReceiptsRequest_Options:
+			if options != nil || current != nil || len(files) > 0 {
+				err = fmt.Errorf("options must be sent once, before any file")
+				break
 			}
+			options = payload.Options
+		case *receiptsv1.UploadReceiptsRequest_File:
+			complete()
+			skipping = false
 
-			parsedTime, err := time.Parse("02/01/2006", parsed.PurchaseDate)
-			if err != nil {
-				slog.Info("failed to parse receipt date. Defaulting to 'now' ", "error", err.Error(), "index", i)
-				parsedTime = time.Now()
+			upload, uploadErr := receipt.NewUpload(payload.File.Name, payload.File.MimeType, payload.File.Size, payload.File.Sha256)
+			if uploadErr != nil {
+				refuse(payload.File.Name, uploadErr)
+				break
 			}
 
-			created, err := s.Receipts.CreateReceipt(ctx, receipt.CreateReceiptRequest{
-				Amount:      parsed.Amount,
-				Description: parsed.Description,
-				Vendor:      parsed.Vendor,
-				Image:       file,
-				Date:        parsedTime,
-				Email:       email,
-			})
-			if err != nil {
-				slog.ErrorContext(ctx, "failed to insert receipt", "error", err.Error(), "index", i)
-				span.SetStatus(codes.Error, err.Error())
-				return fmt.Errorf("create receipt: %w", err)
+			total += payload.File.Size
+			if total > receipt.MaxUploadSize {
+				err = fmt.Errorf("%w: the limit is %d bytes", receipt.ErrUploadTooLarge, receipt.MaxUploadSize)
+				break
 			}
 
-			expenses, err := s.Expenses.ListExpensesForReceipt(ctx, uint64(created.ID))
-			if err != nil {
-				slog.ErrorContext(ctx, "failed to list expenses for receipt", "error", err.Error())
-				span.SetStatus(codes.Error, err.Error())
-				return fmt.Errorf("list expenses: %w", err)
+			current = upload
+		case *receiptsv1.UploadReceiptsRequest_Chunk:
+			// The chunks of refused files count too, since they're received
+			// all the same.
+			received += uint64(len(payload.Chunk))
+			if received > receipt.MaxUploadSize {
+				err = fmt.Errorf("%w: the limit is %d bytes", receipt.ErrUploadTooLarge, receipt.MaxUploadSize)
+				break
+			}
+
+			if skipping {
+				break
 			}
 
-			ch <- receiptWithExpenses{receipt: created, expenses: expenses}
+			if current == nil {
+				err = fmt.Errorf("chunks must follow the header of their file")
+				break
+			}
+
+			if writeErr := current.Write(payload.Chunk); writeErr != nil {
+				refuse(current.Name, writeErr)
+			}
+		default:
+			err = fmt.Errorf("empty message")
+		}
 
-			return nil
-		})
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return nil, connect.NewError(uploadErrorCode(err), err)
+		}
 	}
 
-	if err := g.Wait(); err != nil {
-		slog.ErrorContext(ctx, "create receipt", "error", err.Error())
+	if err := stream.Err(); err != nil {
+		slog.ErrorContext(ctx, "failed to receive upload", "error", err.Error())
 		span.SetStatus(codes.Error, err.Error())
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
+	}
+
+	complete()
+
+	if len(files) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no files uploaded"))
+	}
+
+	span.SetAttributes(attribute.Int("upload.files", len(files)), attribute.Int("upload.bytes", int(received)))
+
+	return s.createReceipts(ctx, files, options.GetSplit(), options.GetDuplicatePolicy())
+}
+
+func uploadErrorCode(err error) connect.Code {
+	if errors.Is(err, receipt.ErrFileTooLarge) || errors.Is(err, receipt.ErrUploadTooLarge) {
+		return connect.CodeResourceExhausted
+	}
+
+	return connect.CodeInvalidArgument
+}
+
+// receiptFile is a file to create a receipt from. Files refused while being
+// uploaded have the reason instead of data, so that they're still reported
+// in their place.
+type receiptFile struct {
+	name string
+	data []byte
+	err  error
+}
+
+func newReceiptFiles(files [][]byte) []receiptFile {
+	out := make([]receiptFile, len(files))
+	for i, data := range files {
+		out[i] = receiptFile{data: data}
+	}
+
+	return out
+}
+
+// createReceipts stores the files as receipts to be parsed in the background.
+// Every file is stored on its own: the result of each, or why it failed, is
+// reported in the response.
+func (s *receiptsServer) createReceipts(ctx context.Context, files []receiptFile, split receiptsv1.ReceiptSplit, policy receiptsv1.DuplicatePolicy) (*connect.Response[receiptsv1.CreateReceiptsResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	reject := policy == receiptsv1.DuplicatePolicy_DUPLICATE_POLICY_REJECT
+
+	results := make([]*receiptsv1.FileResult, len(files))
+
+	var wg sync.WaitGroup
+	for i, file := range files {
+		results[i] = &receiptsv1.FileResult{FileIndex: uint32(i), Name: file.name}
+		if file.err != nil {
+			results[i].Result = &receiptsv1.FileResult_Error{Error: mapFileError(file.err)}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, span := xtrace.StartSpan(ctx, "Upload Receipt")
+			defer span.End()
+
+			created, duplicate, err := s.createReceipt(ctx, email, file.data, split, reject)
+			if err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				results[i].Result = &receiptsv1.FileResult_Error{Error: mapFileError(err)}
+				return
+			}
+
+			if created == nil {
+				results[i].Result = &receiptsv1.FileResult_Error{Error: &receiptsv1.FileError{
+					Reason:      receiptsv1.FileErrorReason_FILE_ERROR_REASON_DUPLICATE,
+					Message:     fmt.Sprintf("looks like a duplicate of receipt %d", duplicate.ReceiptID),
+					DuplicateOf: mapDuplicate(duplicate),
+				}}
+				return
+			}
+
+			results[i].Result = &receiptsv1.FileResult_Receipt{Receipt: &receiptsv1.Receipt{
+				Id:          uint64(created.ID),
+				Status:      mapReceiptStatus(created),
+				Vendor:      created.Vendor,
+				Date:        timestamppb.New(created.Date),
+				DuplicateOf: mapDuplicate(created.DuplicateOf),
+			}}
+		}()
 	}
 
-	close(ch)
+	wg.Wait()
 
-	res := connect.NewResponse(&receiptsv1.CreateReceiptsResponse{})
+	res := connect.NewResponse(&receiptsv1.CreateReceiptsResponse{Results: results})
 
-	for e := range ch {
-		res.Msg.Receipts = append(res.Msg.Receipts, &receiptsv1.Receipt{
-			Id:       uint64(e.receipt.ID),
-			Status:   mapReceiptStatus(e.receipt),
-			Vendor:   e.receipt.Vendor,
-			Date:     timestamppb.New(e.receipt.Date),
-			Expenses: mapExpenses(e.expenses),
-		})
+	var failed int
+	for _, r := range results {
+		if created := r.GetReceipt(); created != nil {
+			res.Msg.Receipts = append(res.Msg.Receipts, created)
+			continue
+		}
+
+		failed++
+		if r.GetError().GetReason() == receiptsv1.FileErrorReason_FILE_ERROR_REASON_DUPLICATE {
+			res.Msg.Rejected = append(res.Msg.Rejected, &receiptsv1.RejectedReceipt{FileIndex: r.FileIndex, DuplicateOf: r.GetError().DuplicateOf})
+		}
 	}
 
+	span.SetAttributes(attribute.Int("receipts.created", len(res.Msg.Receipts)), attribute.Int("receipts.failed", failed))
+
 	return res, nil
 }
 
+// createReceipt stores a single file. When it's a duplicate to be rejected,
+// no receipt is returned but the duplicate is.
+func (s *receiptsServer) createReceipt(ctx context.Context, email string, file []byte, split receiptsv1.ReceiptSplit, reject bool) (*receipt.Receipt, *receipt.Duplicate, error) {
+	if len(file) == 0 {
+		return nil, nil, fmt.Errorf("%w: empty file", receipt.ErrIncompleteFile)
+	}
+
+	if mimeType := receipt.DetectMIMEType(file); !slices.Contains(receipt.ParseableMIMETypes, mimeType) {
+		return nil, nil, fmt.Errorf("%w: %s", receipt.ErrUnsupportedType, mimeType)
+	}
+
+	if len(file) > receipt.MaxFileSize {
+		return nil, nil, fmt.Errorf("%w: %d bytes, the limit is %d", receipt.ErrFileTooLarge, len(file), receipt.MaxFileSize)
+	}
+
+	processed, err := imaging.Process(file)
+	if err != nil && errors.Is(err, imaging.ErrCorrupted) {
+		return nil, nil, err
+	} else if err != nil {
+		if !errors.Is(err, imaging.ErrUnsupported) {
+			slog.WarnContext(ctx, "failed to process receipt image", "error", err.Error())
+		}
+
+		// The original file is parsed and served in place of the copies.
+		processed = &imaging.Processed{}
+	}
+
+	var hash *uint64
+	if len(processed.Large) > 0 {
+		hash = &processed.Hash
+	}
+
+	duplicate, err := s.Receipts.FindDuplicateImage(ctx, email, file, hash)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to look for duplicate receipt image", "error", err.Error())
+		return nil, nil, fmt.Errorf("find duplicate image: %w", err)
+	}
+
+	if duplicate != nil && reject {
+		return nil, duplicate, nil
+	}
+
+	created, err := s.Receipts.UploadReceipt(ctx, receipt.UploadReceiptRequest{
+		Image:            file,
+		Large:            processed.Large,
+		Thumbnail:        processed.Thumbnail,
+		ImageHash:        hash,
+		Email:            email,
+		DuplicateOf:      duplicate,
+		Split:            mapReceiptSplit(split),
+		RejectDuplicates: reject,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to insert receipt", "error", err.Error())
+		return nil, nil, fmt.Errorf("create receipt: %w", err)
+	}
+
+	return created, nil, nil
+}
+
 func (s *receiptsServer) UpdateReceipt(ctx context.Context, req *connect.Request[receiptsv1.UpdateReceiptRequest]) (*connect.Response[receiptsv1.UpdateReceiptResponse], error) {
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.Int("receipt.id", int(req.Msg.Id)))
@@ -144,15 +342,41 @@ func (s *receiptsServer) UpdateReceipt(ctx context.Context, req *connect.Request
 		date = &d
 	}
 
+	var status *receipt.Status
+	if req.Msg.Status != nil {
+		st, ok := mapStatusFromRequest(*req.Msg.Status)
+		if !ok {
+			span.SetStatus(codes.Error, "unsupported status value")
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported status value"))
+		}
+
+		if !slices.Contains(clientStatuses, st) {
+			span.SetStatus(codes.Error, "status can't be set by clients")
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("receipts can't be moved to %s, re-parse them instead", st))
+		}
+
+		status = &st
+	} else if req.Msg.PendingReview != nil {
+		st := receipt.StatusReviewed
+		if *req.Msg.PendingReview {
+			st = receipt.StatusPendingReview
+		}
+
+		status = &st
+	}
+
 	dto := receipt.UpdateReceiptRequest{
-		ID:            int64(req.Msg.Id),
-		Vendor:        req.Msg.Vendor,
-		PendingReview: req.Msg.PendingReview,
-		Date:          date,
+		ID:     int64(req.Msg.Id),
+		Vendor: req.Msg.Vendor,
+		Status: status,
+		Date:   date,
 	}
 
 	err = s.Receipts.UpdateReceipt(ctx, dto)
-	if err != nil {
+	if err != nil && errors.Is(err, receipt.ErrInvalidTransition) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	} else if err != nil {
 		slog.ErrorContext(ctx, "failed to update receipt", "error", err.Error())
 		span.SetStatus(codes.Error, err.Error())
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to update receipt: %w", err))
@@ -178,97 +402,102 @@ func (s *receiptsServer) DeleteReceipt(ctx context.Context, req *connect.Request
 }
 
 func (s *receiptsServer) ListReceipts(ctx context.Context, req *connect.Request[receiptsv1.ListReceiptsRequest]) (*connect.Response[receiptsv1.ListReceiptsResponse], error) {
+	span := trace.SpanFromContext(ctx)
 	userEmail := auth.MustGetUserEmailConnect(ctx)
 
-	var receipts []receipt.Receipt
-	var err error
-
-	listCtx, span := xtrace.StartSpan(ctx, "List Receipts")
-	switch req.Msg.Since {
-	case receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_CURRENT_MONTH:
-		receipts, err = s.Receipts.ListReceiptsCurrentMonth(listCtx, userEmail)
-	case receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_PREVIOUS_MONTH:
-		receipts, err = s.Receipts.ListReceiptsPreviousMonth(listCtx, userEmail)
-	case receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_ALL_TIME, receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_UNSPECIFIED:
-		receipts, err = s.Receipts.ListReceipts(listCtx, userEmail)
-	default:
-		span.SetStatus(codes.Error, "unsupported since value")
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported since value"))
+	q := receipt.ListReceiptsQuery{
+		Email:  userEmail,
+		Vendor: req.Msg.Vendor,
+		Cursor: req.Msg.PageToken,
+		Limit:  uint64(req.Msg.PageSize),
 	}
 
-	if err != nil {
-		slog.ErrorContext(listCtx, "failed to list receipts", "error", err.Error())
-		span.SetStatus(codes.Error, err.Error())
-		span.End()
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list receipts: %w", err))
-	}
+	if req.Msg.DateRange != nil {
+		if req.Msg.DateRange.From != nil {
+			from := req.Msg.DateRange.From.AsTime()
+			q.From = &from
+		}
 
-	span.SetAttributes(attribute.Int("receipts.initial_amount", len(receipts)))
-	span.End()
+		if req.Msg.DateRange.To != nil {
+			to := req.Msg.DateRange.To.AsTime()
+			q.To = &to
+		}
+	} else {
+		var ok bool
+		q.From, q.To, ok = mapSince(req.Msg.Since, time.Now())
+		if !ok {
+			span.SetStatus(codes.Error, "unsupported since value")
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported since value"))
+		}
+	}
 
-	_, span = xtrace.StartSpan(ctx, "Filter Receipts")
-	// Note: iterate from the back so we don't have to worry about removed indexes.
-	for i := len(receipts) - 1; i >= 0; i-- {
-		switch req.Msg.Status {
-		case receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW:
-			if receipts[i].PendingReview {
-				delete(receipts, i)
-			}
-		case receiptsv1.ReceiptStatus_RECEIPT_STATUS_REVIEWED:
-			if !receipts[i].PendingReview {
-				delete(receipts, i)
-			}
-		default:
+	if req.Msg.Status != receiptsv1.ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED {
+		status, ok := mapStatusFromRequest(req.Msg.Status)
+		if !ok {
+			span.SetStatus(codes.Error, "unsupported status value")
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported status value"))
 		}
+
+		q.Statuses = []receipt.Status{status}
 	}
 
-	span.SetAttributes(attribute.Int("receipts.after_status_filter", len(receipts)))
-	span.End()
+	if req.Msg.MinAmount != nil {
+		amount := expense.ConvertToDollar(int32(*req.Msg.MinAmount))
+		q.MinAmount = &amount
+	}
 
-	// Sort the most recent first
-	_, span = xtrace.StartSpan(ctx, "Sort Receipts")
-	sort.Slice(receipts, func(i, j int) bool {
-		return receipts[i].Date.After(receipts[j].Date)
-	})
-	span.End()
+	if req.Msg.MaxAmount != nil {
+		amount := expense.ConvertToDollar(int32(*req.Msg.MaxAmount))
+		q.MaxAmount = &amount
+	}
 
-	mapCtx, span := xtrace.StartSpan(ctx, "Map Receipts to Response")
-	defer span.End()
+	order, ok := listOrders[req.Msg.Order]
+	if !ok {
+		span.SetStatus(codes.Error, "unsupported order value")
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported order value"))
+	}
+	q.Order = order
 
-	resReceipts := make([]*receiptsv1.Receipt, len(receipts))
-	for i, receipt := range receipts {
-		resReceipts[i] = &receiptsv1.Receipt{}
-		resReceipts[i].Id = uint64(receipt.ID)
-		resReceipts[i].Status = mapReceiptStatus(&receipt)
-		resReceipts[i].Vendor = receipt.Vendor
-		resReceipts[i].Date = timestamppb.New(receipt.Date)
+	page, err := s.Receipts.ListReceipts(ctx, q)
+	if err != nil && errors.Is(err, receipt.ErrInvalidCursor) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token"))
+	} else if err != nil {
+		slog.ErrorContext(ctx, "failed to list receipts", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list receipts: %w", err))
+	}
 
-		// FIXME(performance): We should probably do a bulk query before the loop.
-		expenses, err := s.Expenses.ListExpensesForReceipt(mapCtx, uint64(receipt.ID))
-		if err != nil {
-			slog.ErrorContext(mapCtx, "failed to list expenses for receipt", "error", err.Error())
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list expenses for receipt: %w", err))
-		}
+	span.SetAttributes(attribute.Int("receipts.amount", len(page.Receipts)))
 
-		resReceipts[i].Expenses = make([]*receiptsv1.Expense, len(expenses))
-		for j, e := range expenses {
-			resExp := receiptsv1.Expense{
-				Id:          e.ID,
-				Date:        timestamppb.New(e.Date),
-				Category:    e.Category,
-				Subcategory: e.Subcategory,
-				Description: e.Description,
-				Amount:      uint64(expense.ConvertToCents(e.Amount)),
-			}
+	receiptIDs := make([]uint64, len(page.Receipts))
+	for i, r := range page.Receipts {
+		receiptIDs[i] = uint64(r.ID)
+	}
 
-			resReceipts[i].Expenses[j] = &resExp
-		}
+	expenses, err := s.Expenses.ListExpensesForReceipts(ctx, receiptIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list expenses for receipts", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list expenses for receipts: %w", err))
+	}
 
+	resReceipts := make([]*receiptsv1.Receipt, len(page.Receipts))
+	for i, receipt := range page.Receipts {
+		resReceipts[i] = &receiptsv1.Receipt{
+			Id:          uint64(receipt.ID),
+			Status:      mapReceiptStatus(&receipt),
+			Vendor:      receipt.Vendor,
+			Date:        timestamppb.New(receipt.Date),
+			DuplicateOf: mapDuplicate(receipt.DuplicateOf),
+			Expenses:    mapExpenses(expenses[uint64(receipt.ID)]),
+		}
 	}
 
-	res := connect.NewResponse(&receiptsv1.ListReceiptsResponse{Receipts: resReceipts})
+	res := connect.NewResponse(&receiptsv1.ListReceiptsResponse{
+		Receipts:      resReceipts,
+		NextPageToken: page.NextCursor,
+	})
 	return res, nil
 }
 
@@ -276,7 +505,7 @@ func (s *receiptsServer) GetReceipt(ctx context.Context, req *connect.Request[re
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.Int("receipt.id", int(req.Msg.Id)))
 
-	receipt, err := s.Receipts.GetReceipt(ctx, req.Msg.Id)
+	r, err := s.Receipts.GetReceipt(ctx, req.Msg.Id)
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("receipt with id %d doesn't exist", req.Msg.Id))
 	} else if err != nil {
@@ -292,20 +521,427 @@ func (s *receiptsServer) GetReceipt(ctx context.Context, req *connect.Request[re
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get expenses for receipt: %w", err))
 	}
 
+	image, err := s.Receipts.GetReceiptImage(ctx, req.Msg.Id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to get receipt image", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get receipt image: %w", err))
+	}
+
+	items, err := s.Receipts.ListReceiptItems(ctx, req.Msg.Id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to list items for receipt", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get items for receipt: %w", err))
+	}
+
+	transitions, err := s.Receipts.ListTransitions(ctx, req.Msg.Id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to list status transitions for receipt", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get status transitions for receipt: %w", err))
+	}
+
+	tax, err := s.Receipts.GetTax(ctx, req.Msg.Id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		slog.ErrorContext(ctx, "failed to get tax for receipt", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get tax for receipt: %w", err))
+	}
+
+	var total int32
+	for _, e := range expenses {
+		total += expense.ConvertToCents(e.Amount)
+	}
+
 	res := connect.NewResponse(&receiptsv1.GetReceiptResponse{
 		Receipt: &receiptsv1.FullReceipt{
-			Id:       uint64(receipt.ID),
-			Status:   mapReceiptStatus(receipt),
-			Vendor:   receipt.Vendor,
-			Date:     timestamppb.New(receipt.Date),
-			File:     receipt.Image,
-			Expenses: mapExpenses(expenses),
+			Id:             uint64(r.ID),
+			Status:         mapReceiptStatus(r),
+			Vendor:         r.Vendor,
+			Date:           timestamppb.New(r.Date),
+			File:           image,
+			Expenses:       mapExpenses(expenses),
+			Items:          mapItems(items),
+			ItemsReconcile: receipt.ItemsReconcile(expense.ConvertToDollar(total), items),
+			DuplicateOf:    mapDuplicate(r.DuplicateOf),
+			StatusReason:   r.StatusReason,
+			Transitions:    mapTransitions(transitions),
+			Tax:            mapTax(tax),
 		},
 	})
 
 	return res, nil
 }
 
+func (s *receiptsServer) ReparseReceipt(ctx context.Context, req *connect.Request[receiptsv1.ReparseReceiptRequest]) (*connect.Response[receiptsv1.ReparseReceiptResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("receipt.id", int(req.Msg.Id)))
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	current, err := s.Receipts.GetDetails(ctx, req.Msg.Id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("receipt with id %d doesn't exist", req.Msg.Id))
+	} else if err != nil {
+		slog.ErrorContext(ctx, "failed to get receipt details", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get receipt details: %w", err))
+	}
+
+	image, err := s.getParseableImage(ctx, req.Msg.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get receipt image", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get receipt image: %w", err))
+	}
+
+	// The receipt is re-parsed because it was read wrong, so what was read
+	// before is no good.
+	parsed, err := s.Parser.ParseReceiptWith(ctx, email, image, client.ParseOptions{
+		Backend: mapParserBackend(req.Msg.Backend),
+		Prompt:  req.Msg.Prompt,
+		NoCache: true,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to parse receipt", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("unable to parse receipt: %w", err))
+	}
+
+	proposed := receipt.Details{
+		Vendor:      parsed.Vendor,
+		Date:        current.Date,
+		Amount:      float32(parsed.Amount),
+		Description: parsed.Description,
+	}
+
+	date, err := time.Parse("02/01/2006", parsed.PurchaseDate)
+	if err != nil {
+		slog.InfoContext(ctx, "failed to parse receipt date. Keeping current date", "error", err.Error())
+	} else {
+		proposed.Date = date
+	}
+
+	changed := receipt.Diff(*current, proposed)
+	resChanged := make([]receiptsv1.ReceiptField, len(changed))
+	for i, f := range changed {
+		resChanged[i] = mapField(f)
+	}
+
+	res := connect.NewResponse(&receiptsv1.ReparseReceiptResponse{
+		Current:  mapDetails(*current),
+		Proposed: mapDetails(proposed),
+		Changed:  resChanged,
+	})
+
+	return res, nil
+}
+
+func (s *receiptsServer) ApplyReceiptChanges(ctx context.Context, req *connect.Request[receiptsv1.ApplyReceiptChangesRequest]) (*connect.Response[receiptsv1.ApplyReceiptChangesResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("receipt.id", int(req.Msg.Id)))
+
+	if len(req.Msg.Fields) > 0 && req.Msg.Details == nil {
+		span.SetStatus(codes.Error, "missing details")
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("details are required to apply fields"))
+	}
+
+	fields := make([]receipt.Field, len(req.Msg.Fields))
+	for i, f := range req.Msg.Fields {
+		field, ok := mapFieldFromRequest(f)
+		if !ok {
+			span.SetStatus(codes.Error, "unsupported field value")
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported field value: %s", f))
+		}
+
+		fields[i] = field
+	}
+
+	// A missing date would otherwise be applied as the epoch.
+	if slices.Contains(fields, receipt.FieldDate) && req.Msg.Details.GetDate() == nil {
+		span.SetStatus(codes.Error, "missing date")
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the date is required to apply it"))
+	}
+
+	_, err := s.Receipts.GetReceipt(ctx, req.Msg.Id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("receipt with id %d doesn't exist", req.Msg.Id))
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find receipt: %w", err))
+	}
+
+	details := receipt.Details{
+		Vendor:      req.Msg.Details.GetVendor(),
+		Date:        req.Msg.Details.GetDate().AsTime(),
+		Amount:      expense.ConvertToDollar(int32(req.Msg.Details.GetAmount())),
+		Description: req.Msg.Details.GetDescription(),
+	}
+
+	err = s.Receipts.ApplyDetails(ctx, int64(req.Msg.Id), details, fields)
+	if err != nil && errors.Is(err, receipt.ErrSeveralExpenses) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	} else if err != nil {
+		slog.ErrorContext(ctx, "failed to apply receipt changes", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to apply receipt changes: %w", err))
+	}
+
+	res := connect.NewResponse(&receiptsv1.ApplyReceiptChangesResponse{})
+	return res, nil
+}
+
+func (s *receiptsServer) SearchReceipts(ctx context.Context, req *connect.Request[receiptsv1.SearchReceiptsRequest]) (*connect.Response[receiptsv1.SearchReceiptsResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	userEmail := auth.MustGetUserEmailConnect(ctx)
+
+	if strings.TrimSpace(req.Msg.Query) == "" {
+		span.SetStatus(codes.Error, "empty query")
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("query can't be empty"))
+	}
+
+	results, err := s.Receipts.SearchReceipts(ctx, userEmail, req.Msg.Query, uint64(req.Msg.Limit))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to search receipts", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to search receipts: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("receipts.results", len(results)))
+
+	receiptIDs := make([]uint64, len(results))
+	for i, result := range results {
+		receiptIDs[i] = uint64(result.Receipt.ID)
+	}
+
+	expenses, err := s.Expenses.ListExpensesForReceipts(ctx, receiptIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list expenses for receipts", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list expenses for receipts: %w", err))
+	}
+
+	resResults := make([]*receiptsv1.SearchResult, len(results))
+	for i, result := range results {
+
+		snippet := make([]*receiptsv1.SnippetPart, len(result.Snippet))
+		for j, part := range result.Snippet {
+			snippet[j] = &receiptsv1.SnippetPart{Text: part.Text, Match: part.Match}
+		}
+
+		resResults[i] = &receiptsv1.SearchResult{
+			Receipt: &receiptsv1.Receipt{
+				Id:          uint64(result.Receipt.ID),
+				Status:      mapReceiptStatus(&result.Receipt),
+				Vendor:      result.Receipt.Vendor,
+				Date:        timestamppb.New(result.Receipt.Date),
+				Expenses:    mapExpenses(expenses[uint64(result.Receipt.ID)]),
+				DuplicateOf: mapDuplicate(result.Receipt.DuplicateOf),
+			},
+			Snippet: snippet,
+		}
+	}
+
+	res := connect.NewResponse(&receiptsv1.SearchReceiptsResponse{Results: resResults})
+	return res, nil
+}
+
+func (s *receiptsServer) ListReconciliationProposals(ctx context.Context, req *connect.Request[receiptsv1.ListReconciliationProposalsRequest]) (*connect.Response[receiptsv1.ListReconciliationProposalsResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	userEmail := auth.MustGetUserEmailConnect(ctx)
+
+	receipts, transactions, err := s.Reconcile.ListCandidates(ctx, userEmail)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list reconciliation candidates", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list reconciliation candidates: %w", err))
+	}
+
+	proposals := reconcile.Match(receipts, transactions, reconcile.DefaultOptions)
+	span.SetAttributes(attribute.Int("reconciliation.proposals", len(proposals)))
+
+	res := connect.NewResponse(&receiptsv1.ListReconciliationProposalsResponse{
+		Proposals: make([]*receiptsv1.ReconciliationProposal, len(proposals)),
+	})
+
+	for i, p := range proposals {
+		res.Msg.Proposals[i] = &receiptsv1.ReconciliationProposal{
+			ReceiptId:     p.Receipt.ID,
+			Vendor:        p.Receipt.Vendor,
+			ReceiptDate:   timestamppb.New(p.Receipt.Date),
+			ReceiptAmount: uint64(p.Receipt.Amount),
+			Transaction: &receiptsv1.BankTransaction{
+				Id:          p.Transaction.ID,
+				AccountId:   p.Transaction.AccountID,
+				Date:        timestamppb.New(p.Transaction.Date),
+				Amount:      uint64(p.Transaction.Amount),
+				Description: p.Transaction.Description,
+				Category:    p.Transaction.Category,
+				Subcategory: p.Transaction.Subcategory,
+			},
+			Score: p.Score,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *receiptsServer) ReconcileReceipt(ctx context.Context, req *connect.Request[receiptsv1.ReconcileReceiptRequest]) (*connect.Response[receiptsv1.ReconcileReceiptResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("receipt.id", int(req.Msg.ReceiptId)), attribute.Int("expense.id", int(req.Msg.TransactionId)))
+
+	userEmail := auth.MustGetUserEmailConnect(ctx)
+
+	err := s.Reconcile.Reconcile(ctx, userEmail, req.Msg.ReceiptId, req.Msg.TransactionId)
+	if err != nil && errors.Is(err, reconcile.ErrNotFound) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil && errors.Is(err, reconcile.ErrAlreadyReconciled) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	} else if err != nil && (errors.Is(err, reconcile.ErrNoExpenses) || errors.Is(err, reconcile.ErrAmountMismatch)) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	} else if err != nil {
+		slog.ErrorContext(ctx, "failed to reconcile receipt", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to reconcile receipt: %w", err))
+	}
+
+	expenses, err := s.Expenses.ListExpensesForReceipt(ctx, req.Msg.ReceiptId)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list expenses for receipt", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list expenses for receipt: %w", err))
+	}
+
+	return connect.NewResponse(&receiptsv1.ReconcileReceiptResponse{Expenses: mapExpenses(expenses)}), nil
+}
+
+// GetVATReport sums the deductible VAT of the reviewed receipts of the quarter
+// by rate.
+func (s *receiptsServer) GetVATReport(ctx context.Context, req *connect.Request[receiptsv1.GetVATReportRequest]) (*connect.Response[receiptsv1.GetVATReportResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("report.year", int(req.Msg.Year)), attribute.Int("report.quarter", int(req.Msg.Quarter)))
+
+	userEmail := auth.MustGetUserEmailConnect(ctx)
+
+	from, to, err := receipt.Quarter(int(req.Msg.Year), int(req.Msg.Quarter))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rates, err := s.Receipts.VATReport(ctx, userEmail, from, to)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get VAT report", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get VAT report: %w", err))
+	}
+
+	res := connect.NewResponse(&receiptsv1.GetVATReportResponse{
+		Rates: make([]*receiptsv1.VATRate, len(rates)),
+	})
+
+	for i, r := range rates {
+		res.Msg.Rates[i] = &receiptsv1.VATRate{
+			Rate:     r.Rate,
+			Base:     uint64(expense.ConvertToCents(r.Base)),
+			Amount:   uint64(expense.ConvertToCents(r.Amount)),
+			Receipts: uint32(r.Receipts),
+		}
+
+		res.Msg.TotalBase += res.Msg.Rates[i].Base
+		res.Msg.TotalAmount += res.Msg.Rates[i].Amount
+	}
+
+	return res, nil
+}
+
+func (s *receiptsServer) GenerateExpenseReport(ctx context.Context, req *connect.Request[receiptsv1.GenerateExpenseReportRequest]) (*connect.Response[receiptsv1.GenerateExpenseReportResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	q := expensereport.Query{
+		Email:       auth.MustGetUserEmailConnect(ctx),
+		Category:    req.Msg.Category,
+		Subcategory: req.Msg.Subcategory,
+	}
+
+	if req.Msg.DateRange.GetFrom() != nil {
+		from := req.Msg.DateRange.From.AsTime()
+		q.From = &from
+	}
+
+	if req.Msg.DateRange.GetTo() != nil {
+		to := req.Msg.DateRange.To.AsTime()
+		q.To = &to
+	}
+
+	report, err := s.Reports.Build(ctx, q)
+	if err != nil && errors.Is(err, expensereport.ErrTooManyReceipts) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	} else if err != nil {
+		slog.ErrorContext(ctx, "failed to build expense report", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to build expense report: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("report.expenses", len(report.Lines)), attribute.Int("report.receipts", len(report.Receipts)))
+
+	var buf bytes.Buffer
+	err = expensereport.Render(&buf, *report)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to render expense report", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to render expense report: %w", err))
+	}
+
+	res := connect.NewResponse(&receiptsv1.GenerateExpenseReportResponse{
+		Pdf:      buf.Bytes(),
+		Receipts: uint32(len(report.Receipts)),
+		Total:    uint64(report.Total()),
+	})
+
+	return res, nil
+}
+
+// getParseableImage returns the copy of the receipt which is sent to the
+// parser, or the original file when there's no such copy.
+func (s *receiptsServer) getParseableImage(ctx context.Context, receiptID uint64) ([]byte, error) {
+	key, err := s.Receipts.GetReceiptImageKey(ctx, receiptID, receipt.ImageLarge)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return s.Receipts.GetReceiptImage(ctx, receiptID)
+	}
+
+	return s.Receipts.GetImage(ctx, key)
+}
+
+// mapFileError tells why a file couldn't be stored. Only the reasons the
+// client can do something about are detailed.
+func mapFileError(err error) *receiptsv1.FileError {
+	switch {
+	case errors.Is(err, receipt.ErrUnsupportedType):
+		return &receiptsv1.FileError{Reason: receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNSUPPORTED_TYPE, Message: err.Error()}
+	case errors.Is(err, receipt.ErrFileTooLarge):
+		return &receiptsv1.FileError{Reason: receiptsv1.FileErrorReason_FILE_ERROR_REASON_TOO_LARGE, Message: err.Error()}
+	case errors.Is(err, receipt.ErrChecksumMismatch), errors.Is(err, receipt.ErrIncompleteFile):
+		return &receiptsv1.FileError{Reason: receiptsv1.FileErrorReason_FILE_ERROR_REASON_CORRUPTED, Message: err.Error(), Retryable: true}
+	case errors.Is(err, imaging.ErrCorrupted):
+		return &receiptsv1.FileError{Reason: receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNREADABLE, Message: err.Error()}
+	default:
+		return &receiptsv1.FileError{Reason: receiptsv1.FileErrorReason_FILE_ERROR_REASON_INTERNAL, Message: "unable to store the receipt", Retryable: true}
+	}
+}
+
 func mapExpenses(expenses []expense.Expense) []*receiptsv1.Expense {
 	resExpenses := make([]*receiptsv1.Expense, len(expenses))
 	for i, e := range expenses {
@@ -324,15 +960,184 @@ func mapExpenses(expenses []expense.Expense) []*receiptsv1.Expense {
 	return resExpenses
 }
 
-func delete[T any](s []T, i int) []T {
-	s[i] = s[len(s)-1]
-	return s[:len(s)-1]
+func mapItems(items []receipt.Item) []*receiptsv1.LineItem {
+	resItems := make([]*receiptsv1.LineItem, len(items))
+	for i, item := range items {
+		resItems[i] = &receiptsv1.LineItem{
+			Id:        uint64(item.ID),
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: uint64(expense.ConvertToCents(item.UnitPrice)),
+			Total:     uint64(expense.ConvertToCents(item.Total)),
+			Category:  item.Category,
+		}
+	}
+
+	return resItems
+}
+
+func mapTax(tax *receipt.Tax) *receiptsv1.ReceiptTax {
+	lines := make([]*receiptsv1.TaxLine, len(tax.Lines))
+	for i, l := range tax.Lines {
+		lines[i] = &receiptsv1.TaxLine{
+			Rate:   l.Rate,
+			Base:   uint64(expense.ConvertToCents(l.Base)),
+			Amount: uint64(expense.ConvertToCents(l.Amount)),
+		}
+	}
+
+	return &receiptsv1.ReceiptTax{
+		Subtotal:      uint64(expense.ConvertToCents(tax.Subtotal)),
+		VendorTaxId:   tax.VendorTaxID,
+		InvoiceNumber: tax.InvoiceNumber,
+		Lines:         lines,
+	}
 }
 
+func mapReceiptSplit(split receiptsv1.ReceiptSplit) receipt.Split {
+	switch split {
+	case receiptsv1.ReceiptSplit_RECEIPT_SPLIT_PER_ITEM:
+		return receipt.SplitPerItem
+	case receiptsv1.ReceiptSplit_RECEIPT_SPLIT_PER_CATEGORY:
+		return receipt.SplitPerCategory
+	default:
+		return receipt.SplitNone
+	}
+}
+
+func mapDuplicate(d *receipt.Duplicate) *receiptsv1.Duplicate {
+	if d == nil {
+		return nil
+	}
+
+	reason := receiptsv1.DuplicateReason_DUPLICATE_REASON_UNSPECIFIED
+	switch d.Reason {
+	case receipt.DuplicateSameFile:
+		reason = receiptsv1.DuplicateReason_DUPLICATE_REASON_SAME_FILE
+	case receipt.DuplicateSimilarImage:
+		reason = receiptsv1.DuplicateReason_DUPLICATE_REASON_SIMILAR_IMAGE
+	case receipt.DuplicateSameDetails:
+		reason = receiptsv1.DuplicateReason_DUPLICATE_REASON_SAME_DETAILS
+	}
+
+	return &receiptsv1.Duplicate{ReceiptId: uint64(d.ReceiptID), Reason: reason}
+}
+
+func mapParserBackend(b receiptsv1.ParserBackend) string {
+	switch b {
+	case receiptsv1.ParserBackend_PARSER_BACKEND_VISION:
+		return "vision"
+	case receiptsv1.ParserBackend_PARSER_BACKEND_TEXTRACT:
+		return "textract"
+	case receiptsv1.ParserBackend_PARSER_BACKEND_PDF_TEXT:
+		return "pdf_text"
+	default:
+		return ""
+	}
+}
+
+var receiptFields = map[receipt.Field]receiptsv1.ReceiptField{
+	receipt.FieldVendor:      receiptsv1.ReceiptField_RECEIPT_FIELD_VENDOR,
+	receipt.FieldDate:        receiptsv1.ReceiptField_RECEIPT_FIELD_DATE,
+	receipt.FieldAmount:      receiptsv1.ReceiptField_RECEIPT_FIELD_AMOUNT,
+	receipt.FieldDescription: receiptsv1.ReceiptField_RECEIPT_FIELD_DESCRIPTION,
+}
+
+func mapField(f receipt.Field) receiptsv1.ReceiptField {
+	return receiptFields[f]
+}
+
+func mapFieldFromRequest(f receiptsv1.ReceiptField) (receipt.Field, bool) {
+	for field, v := range receiptFields {
+		if v == f {
+			return field, true
+		}
+	}
+
+	return "", false
+}
+
+func mapDetails(d receipt.Details) *receiptsv1.ReceiptDetails {
+	return &receiptsv1.ReceiptDetails{
+		Vendor:      d.Vendor,
+		Date:        timestamppb.New(d.Date),
+		Amount:      uint64(expense.ConvertToCents(d.Amount)),
+		Description: d.Description,
+	}
+}
+
+var listOrders = map[receiptsv1.ListReceiptsOrder]receipt.SortOrder{
+	receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_UNSPECIFIED: receipt.SortDateDesc,
+	receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_DATE_DESC:   receipt.SortDateDesc,
+	receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_DATE_ASC:    receipt.SortDateAsc,
+	receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_AMOUNT_DESC: receipt.SortAmountDesc,
+	receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_AMOUNT_ASC:  receipt.SortAmountAsc,
+}
+
+// mapSince turns the since value of older clients into the date range it
+// stands for, relative to now.
+func mapSince(since receiptsv1.ListReceiptsSince, now time.Time) (from, to *time.Time, ok bool) {
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	nextMonth := currentMonth.AddDate(0, 1, 0)
+	previousMonth := currentMonth.AddDate(0, -1, 0)
+
+	switch since {
+	case receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_CURRENT_MONTH:
+		return &currentMonth, &nextMonth, true
+	case receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_PREVIOUS_MONTH:
+		return &previousMonth, &currentMonth, true
+	case receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_ALL_TIME, receiptsv1.ListReceiptsSince_LIST_RECEIPTS_SINCE_UNSPECIFIED:
+		return nil, nil, true
+	default:
+		return nil, nil, false
+	}
+}
+
+var receiptStatuses = map[receipt.Status]receiptsv1.ReceiptStatus{
+	receipt.StatusUploaded:      receiptsv1.ReceiptStatus_RECEIPT_STATUS_UPLOADED,
+	receipt.StatusParsing:       receiptsv1.ReceiptStatus_RECEIPT_STATUS_PARSING,
+	receipt.StatusPendingReview: receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW,
+	receipt.StatusReviewed:      receiptsv1.ReceiptStatus_RECEIPT_STATUS_REVIEWED,
+	receipt.StatusFailed:        receiptsv1.ReceiptStatus_RECEIPT_STATUS_FAILED,
+	receipt.StatusArchived:      receiptsv1.ReceiptStatus_RECEIPT_STATUS_ARCHIVED,
+}
+
+// clientStatuses are the statuses clients can move receipts to. The rest are
+// reached by processing them, which only ReparseReceipt starts again.
+var clientStatuses = []receipt.Status{receipt.StatusPendingReview, receipt.StatusReviewed, receipt.StatusArchived}
+
 func mapReceiptStatus(r *receipt.Receipt) receiptsv1.ReceiptStatus {
-	if r.PendingReview {
-		return receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW
+	return mapStatus(r.Status)
+}
+
+func mapStatus(s receipt.Status) receiptsv1.ReceiptStatus {
+	if status, ok := receiptStatuses[s]; ok {
+		return status
+	}
+
+	return receiptsv1.ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED
+}
+
+func mapStatusFromRequest(s receiptsv1.ReceiptStatus) (receipt.Status, bool) {
+	for status, v := range receiptStatuses {
+		if v == s {
+			return status, true
+		}
+	}
+
+	return "", false
+}
+
+func mapTransitions(transitions []receipt.Transition) []*receiptsv1.StatusTransition {
+	res := make([]*receiptsv1.StatusTransition, len(transitions))
+	for i, t := range transitions {
+		res[i] = &receiptsv1.StatusTransition{
+			From:   mapStatus(t.From),
+			To:     mapStatus(t.To),
+			Reason: t.Reason,
+			At:     timestamppb.New(t.CreatedAt),
+		}
 	}
 
-	return receiptsv1.ReceiptStatus_RECEIPT_STATUS_REVIEWED
+	return res
 }
@@ -1,19 +1,34 @@
 package servers_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	receiptsv1 "github.com/manzanit0/mcduck/api/receipts.v1"
+	"github.com/manzanit0/mcduck/api/receipts.v1/receiptsv1connect"
 	"github.com/manzanit0/mcduck/cmd/dots/servers"
+	"github.com/manzanit0/mcduck/cmd/dots/workers"
+	"github.com/manzanit0/mcduck/internal/account"
+	"github.com/manzanit0/mcduck/internal/blob"
 	"github.com/manzanit0/mcduck/internal/client"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/internal/jobs"
 	"github.com/manzanit0/mcduck/internal/pgtest"
 	"github.com/manzanit0/mcduck/internal/receipt"
 	"github.com/manzanit0/mcduck/internal/users"
 	"github.com/manzanit0/mcduck/pkg/auth"
 	"github.com/manzanit0/mcduck/pkg/tgram"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"connectrpc.com/connect"
@@ -44,7 +59,7 @@ func TestCreateReceipt(t *testing.T) {
 
 	t.Run("when context doesn't have email, system panics", func(t *testing.T) {
 		ctx = auth.WithInfo(ctx, "") // no email
-		s := servers.NewReceiptsServer(nil, nil, nil)
+		s := servers.NewReceiptsServer(nil, nil, nil, nil)
 
 		require.PanicsWithValue(t, "empty user email", func() {
 			_, _ = s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
@@ -69,7 +84,7 @@ func TestCreateReceipt(t *testing.T) {
 
 		parserClient := client.NewMockParserClient(t)
 		tgramClient := tgram.NewMockClient(t)
-		s := servers.NewReceiptsServer(db, parserClient, tgramClient)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
 
 		userEmail := "user@email.com"
 		receiptBytes := []byte("foo")
@@ -97,9 +112,17 @@ func TestCreateReceipt(t *testing.T) {
 
 		receipts := res.Msg.Receipts
 		require.Len(t, receipts, 1)
+		assert.Equal(t, receipts[0].Status, receiptsv1.ReceiptStatus_RECEIPT_STATUS_UPLOADED)
+		assert.Empty(t, receipts[0].Expenses)
 
-		receipt := receipts[0]
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: receipts[0].Id},
+		})
 		require.NoError(t, err)
+
+		receipt := got.Msg.Receipt
 		assert.Equal(t, receipt.Vendor, "some vendor")
 		assert.Equal(t, receipt.Status, receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW)
 		assert.Equal(t, receipt.Date.AsTime().Format("02/01/2006"), "02/01/2006")
@@ -127,7 +150,7 @@ func TestCreateReceipt(t *testing.T) {
 
 		parserClient := client.NewMockParserClient(t)
 		tgramClient := tgram.NewMockClient(t)
-		s := servers.NewReceiptsServer(db, parserClient, tgramClient)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
 
 		userEmail := "user@email.com"
 		receiptBytes := []byte("foo")
@@ -155,15 +178,22 @@ func TestCreateReceipt(t *testing.T) {
 
 		receipts := res.Msg.Receipts
 		require.Len(t, receipts, 1)
+		assert.Equal(t, receipts[0].Status, receiptsv1.ReceiptStatus_RECEIPT_STATUS_UPLOADED)
+		assert.Empty(t, receipts[0].Expenses)
+
+		runJobs(t, ctx, db, parserClient, tgramClient)
 
-		receipt := receipts[0]
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: receipts[0].Id},
+		})
 		require.NoError(t, err)
+
+		receipt := got.Msg.Receipt
 		assert.Equal(t, receipt.Vendor, "some vendor")
 		assert.Equal(t, receipt.Status, receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW)
 		assert.Equal(t, receipt.Date.AsTime().Format("02/01/2006"), time.Now().Format("02/01/2006"))
 
 		expenses := receipt.Expenses
-		require.NoError(t, err)
 		require.Len(t, expenses, 1)
 		assert.EqualValues(t, expenses[0].Amount, 550)
 		assert.Equal(t, expenses[0].Category, "Receipt Upload")
@@ -172,7 +202,7 @@ func TestCreateReceipt(t *testing.T) {
 		assert.Equal(t, expenses[0].Date.AsTime().Format("02/01/2006"), time.Now().Format("02/01/2006"))
 	})
 
-	t.Run("empty images are rejected", func(t *testing.T) {
+	t.Run("receipt is split into one expense per category of items", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -186,10 +216,10 @@ func TestCreateReceipt(t *testing.T) {
 
 		parserClient := client.NewMockParserClient(t)
 		tgramClient := tgram.NewMockClient(t)
-		s := servers.NewReceiptsServer(db, parserClient, tgramClient)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
 
 		userEmail := "user@email.com"
-		receiptBytes := []byte("") // empty image
+		receiptBytes := []byte("foo")
 		parserClient.EXPECT().
 			ParseReceipt(mock.Anything, userEmail, receiptBytes).
 			Return(&client.ParseReceiptResponse{
@@ -198,6 +228,11 @@ func TestCreateReceipt(t *testing.T) {
 				Description:  "some description",
 				Vendor:       "some vendor",
 				PurchaseDate: "02/01/2006",
+				Items: []client.LineItem{
+					{Name: "milk", Quantity: 2, UnitPrice: 1, Total: 2, Category: "Groceries"},
+					{Name: "soap", Quantity: 1, UnitPrice: 1.5, Total: 1.5, Category: "Cleaning"},
+					{Name: "bread", Quantity: 1, UnitPrice: 2, Total: 2, Category: "Groceries"},
+				},
 			}, nil).
 			Once()
 
@@ -205,15 +240,39 @@ func TestCreateReceipt(t *testing.T) {
 		require.NoError(t, err)
 
 		ctx = auth.WithInfo(ctx, userEmail)
-		_, err = s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+		res, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
 			Msg: &receiptsv1.CreateReceiptsRequest{
 				ReceiptFiles: [][]byte{receiptBytes},
+				Split:        receiptsv1.ReceiptSplit_RECEIPT_SPLIT_PER_CATEGORY,
 			},
 		})
-		require.ErrorContains(t, err, "internal: create receipt: empty receipt")
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Receipts, 1)
+
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: res.Msg.Receipts[0].Id},
+		})
+		require.NoError(t, err)
+
+		expenses := got.Msg.Receipt.Expenses
+		require.Len(t, expenses, 2)
+
+		amounts := map[string]uint64{}
+		for _, e := range expenses {
+			assert.Equal(t, e.Category, "Receipt Upload")
+			amounts[e.Subcategory] = e.Amount
+		}
+		assert.EqualValues(t, map[string]uint64{"Groceries": 400, "Cleaning": 150}, amounts)
+
+		require.Len(t, got.Msg.Receipt.Items, 3)
+		assert.Equal(t, got.Msg.Receipt.Items[0].Name, "milk")
+		assert.EqualValues(t, got.Msg.Receipt.Items[0].Total, 200)
+		assert.True(t, got.Msg.Receipt.ItemsReconcile)
 	})
 
-	t.Run("when a receipt fails to be created, an error is returned", func(t *testing.T) {
+	t.Run("items which don't reconcile with the total are not split", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -227,7 +286,7 @@ func TestCreateReceipt(t *testing.T) {
 
 		parserClient := client.NewMockParserClient(t)
 		tgramClient := tgram.NewMockClient(t)
-		s := servers.NewReceiptsServer(db, parserClient, tgramClient)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
 
 		userEmail := "user@email.com"
 		receiptBytes := []byte("foo")
@@ -239,66 +298,100 @@ func TestCreateReceipt(t *testing.T) {
 				Description:  "some description",
 				Vendor:       "some vendor",
 				PurchaseDate: "02/01/2006",
+				Items: []client.LineItem{
+					{Name: "milk", Quantity: 2, UnitPrice: 1, Total: 2, Category: "Groceries"},
+				},
 			}, nil).
 			Once()
 
-		// Let's close the connection to force a DB error.
-		err = db.Close()
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
 		require.NoError(t, err)
 
 		ctx = auth.WithInfo(ctx, userEmail)
-		_, err = s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+		res, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
 			Msg: &receiptsv1.CreateReceiptsRequest{
 				ReceiptFiles: [][]byte{receiptBytes},
+				Split:        receiptsv1.ReceiptSplit_RECEIPT_SPLIT_PER_ITEM,
 			},
 		})
-		require.ErrorContains(t, err, "internal: create receipt: begin transaction: sql: database is close")
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Receipts, 1)
+
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: res.Msg.Receipts[0].Id},
+		})
+		require.NoError(t, err)
+
+		expenses := got.Msg.Receipt.Expenses
+		require.Len(t, expenses, 1)
+		assert.EqualValues(t, expenses[0].Amount, 550)
+		assert.Equal(t, expenses[0].Description, "some description")
 	})
-}
 
-func TestUpdateReceipt(t *testing.T) {
-	ctx := context.Background()
+	t.Run("the same file uploaded twice is flagged as a duplicate", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
 
-	dbContainer, err := pgtest.NewDBContainer(ctx)
-	require.NoError(t, err)
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
 
-	connectionString, err := dbContainer.ConnectionString(ctx)
-	require.NoError(t, err)
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
 
-	db, err := sqlx.Open("pgx", connectionString)
-	require.NoError(t, err)
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
 
-	userEmail := "foo@email.com"
-	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
-	require.NoError(t, err)
+		userEmail := "user@email.com"
+		receiptBytes := []byte("foo")
+		parserClient.EXPECT().
+			ParseReceipt(mock.Anything, userEmail, receiptBytes).
+			Return(&client.ParseReceiptResponse{
+				Amount:       5.5,
+				Currency:     "EUR",
+				Description:  "some description",
+				Vendor:       "some vendor",
+				PurchaseDate: "02/01/2006",
+			}, nil).
+			Twice()
 
-	repo := receipt.NewRepository(db)
-	existingReceipt, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
-		Amount:      5,
-		Description: "description",
-		Vendor:      "vendor",
-		Image:       []byte("foo"),
-		Date:        time.Now(),
-		Email:       userEmail,
-	})
-	require.NoError(t, err)
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+		require.NoError(t, err)
 
-	// Note: Create receipt only returns the ID.
-	existingReceipt, err = repo.GetReceipt(ctx, uint64(existingReceipt.ID))
-	require.NoError(t, err)
+		ctx = auth.WithInfo(ctx, userEmail)
+		first, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{receiptBytes}},
+		})
+		require.NoError(t, err)
+		require.Len(t, first.Msg.Receipts, 1)
+		assert.Nil(t, first.Msg.Receipts[0].DuplicateOf)
 
-	err = db.Close()
-	require.NoError(t, err)
+		runJobs(t, ctx, db, parserClient, tgramClient)
 
-	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("create_receipt"))
-	require.NoError(t, err)
+		second, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{receiptBytes}},
+		})
+		require.NoError(t, err)
+		require.Len(t, second.Msg.Receipts, 1)
+		require.NotNil(t, second.Msg.Receipts[0].DuplicateOf)
+		assert.Equal(t, first.Msg.Receipts[0].Id, second.Msg.Receipts[0].DuplicateOf.ReceiptId)
+		assert.Equal(t, receiptsv1.DuplicateReason_DUPLICATE_REASON_SAME_FILE, second.Msg.Receipts[0].DuplicateOf.Reason)
 
-	t.Cleanup(func() {
-		err = dbContainer.Terminate(ctx)
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: second.Msg.Receipts[0].Id},
+		})
 		require.NoError(t, err)
+		require.NotNil(t, got.Msg.Receipt.DuplicateOf)
+		assert.Equal(t, first.Msg.Receipts[0].Id, got.Msg.Receipt.DuplicateOf.ReceiptId)
 	})
 
-	t.Run("everything is updated", func(t *testing.T) {
+	t.Run("duplicates are rejected without parsing them when asked to", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -310,30 +403,49 @@ func TestUpdateReceipt(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		updateStr := "updated"
-		updateBool := true
-		updateDate := timestamppb.New(time.Date(1993, 2, 24, 0, 0, 0, 0, time.UTC))
-		s := servers.NewReceiptsServer(db, nil, nil)
-		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
-			Msg: &receiptsv1.UpdateReceiptRequest{
-				Id:            uint64(existingReceipt.ID),
-				Vendor:        &updateStr,
-				PendingReview: &updateBool,
-				Date:          updateDate,
-			},
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
+
+		userEmail := "user@email.com"
+		receiptBytes := []byte("foo")
+		parserClient.EXPECT().
+			ParseReceipt(mock.Anything, userEmail, receiptBytes).
+			Return(&client.ParseReceiptResponse{
+				Amount:       5.5,
+				Currency:     "EUR",
+				Description:  "some description",
+				Vendor:       "some vendor",
+				PurchaseDate: "02/01/2006",
+			}, nil).
+			Once()
+
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+		require.NoError(t, err)
+
+		ctx = auth.WithInfo(ctx, userEmail)
+		first, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{receiptBytes}},
 		})
 		require.NoError(t, err)
+		require.Len(t, first.Msg.Receipts, 1)
 
-		repo = receipt.NewRepository(db)
-		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
-		assert.NoError(t, err)
-		assert.Equal(t, updateStr, updatedReceipt.Vendor)
-		assert.Equal(t, updateBool, updatedReceipt.PendingReview)
-		assert.Equal(t, "24/02/1993", updatedReceipt.Date.Format("02/01/2006"))
-		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		second, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{
+				ReceiptFiles:    [][]byte{receiptBytes},
+				DuplicatePolicy: receiptsv1.DuplicatePolicy_DUPLICATE_POLICY_REJECT,
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, second.Msg.Receipts)
+		require.Len(t, second.Msg.Rejected, 1)
+		assert.EqualValues(t, 0, second.Msg.Rejected[0].FileIndex)
+		assert.Equal(t, first.Msg.Receipts[0].Id, second.Msg.Rejected[0].DuplicateOf.ReceiptId)
 	})
 
-	t.Run("only vendor is updated", func(t *testing.T) {
+	t.Run("receipts with the same vendor, date and amount are flagged as duplicates", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -345,26 +457,54 @@ func TestUpdateReceipt(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		updateValue := "updated"
-		s := servers.NewReceiptsServer(db, nil, nil)
-		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
-			Msg: &receiptsv1.UpdateReceiptRequest{
-				Id:     uint64(existingReceipt.ID),
-				Vendor: &updateValue,
-			},
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
+
+		userEmail := "user@email.com"
+		parserClient.EXPECT().
+			ParseReceipt(mock.Anything, userEmail, mock.Anything).
+			Return(&client.ParseReceiptResponse{
+				Amount:       5.5,
+				Currency:     "EUR",
+				Description:  "some description",
+				Vendor:       "some vendor",
+				PurchaseDate: "02/01/2006",
+			}, nil).
+			Twice()
+
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+		require.NoError(t, err)
+
+		ctx = auth.WithInfo(ctx, userEmail)
+		first, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{[]byte("foo")}},
 		})
 		require.NoError(t, err)
+		require.Len(t, first.Msg.Receipts, 1)
 
-		repo = receipt.NewRepository(db)
-		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
-		assert.NoError(t, err)
-		assert.Equal(t, updateValue, updatedReceipt.Vendor)
-		assert.Equal(t, existingReceipt.PendingReview, updatedReceipt.PendingReview)
-		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
-		assert.Equal(t, existingReceipt.Date, updatedReceipt.Date)
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		second, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{[]byte("bar")}},
+		})
+		require.NoError(t, err)
+		require.Len(t, second.Msg.Receipts, 1)
+		assert.Nil(t, second.Msg.Receipts[0].DuplicateOf)
+
+		// The details are only known once the receipt is parsed.
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: second.Msg.Receipts[0].Id},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, got.Msg.Receipt.DuplicateOf)
+		assert.Equal(t, first.Msg.Receipts[0].Id, got.Msg.Receipt.DuplicateOf.ReceiptId)
+		assert.Equal(t, receiptsv1.DuplicateReason_DUPLICATE_REASON_SAME_DETAILS, got.Msg.Receipt.DuplicateOf.Reason)
 	})
 
-	t.Run("only pending review is updated", func(t *testing.T) {
+	t.Run("receipts which can't be parsed are retried and then marked as failed", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -376,26 +516,65 @@ func TestUpdateReceipt(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		updateValue := true
-		s := servers.NewReceiptsServer(db, nil, nil)
-		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
-			Msg: &receiptsv1.UpdateReceiptRequest{
-				Id:            uint64(existingReceipt.ID),
-				PendingReview: &updateValue,
-			},
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
+
+		userEmail := "user@email.com"
+		receiptBytes := []byte("foo")
+		parserClient.EXPECT().
+			ParseReceipt(mock.Anything, userEmail, receiptBytes).
+			Return(nil, errors.New("parser is down")).
+			Twice()
+
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+		require.NoError(t, err)
+
+		ctx = auth.WithInfo(ctx, userEmail)
+		res, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{receiptBytes}},
 		})
 		require.NoError(t, err)
+		require.Len(t, res.Msg.Receipts, 1)
 
-		repo = receipt.NewRepository(db)
-		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
-		assert.NoError(t, err)
-		assert.Equal(t, updateValue, updatedReceipt.PendingReview)
-		assert.Equal(t, existingReceipt.Vendor, updatedReceipt.Vendor)
-		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
-		assert.Equal(t, existingReceipt.Date, updatedReceipt.Date)
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		var job struct {
+			Status    string `db:"status"`
+			Attempts  int    `db:"attempts"`
+			LastError string `db:"last_error"`
+		}
+		err = db.GetContext(ctx, &job, "SELECT status, attempts, last_error FROM jobs")
+		require.NoError(t, err)
+		assert.Equal(t, "pending", job.Status)
+		assert.Equal(t, 1, job.Attempts)
+		assert.Contains(t, job.LastError, "parser is down")
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: res.Msg.Receipts[0].Id},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, receiptsv1.ReceiptStatus_RECEIPT_STATUS_UPLOADED, got.Msg.Receipt.Status)
+
+		// Skip the backoff and make the next attempt the last one.
+		_, err = db.ExecContext(ctx, "UPDATE jobs SET run_at = NOW(), max_attempts = 2")
+		require.NoError(t, err)
+
+		runJobs(t, ctx, db, parserClient, tgramClient)
+
+		err = db.GetContext(ctx, &job, "SELECT status, attempts, last_error FROM jobs")
+		require.NoError(t, err)
+		assert.Equal(t, "dead", job.Status)
+
+		got, err = s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: res.Msg.Receipts[0].Id},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, receiptsv1.ReceiptStatus_RECEIPT_STATUS_FAILED, got.Msg.Receipt.Status)
+		assert.Contains(t, got.Msg.Receipt.StatusReason, "parser is down")
 	})
 
-	t.Run("only date is updated", func(t *testing.T) {
+	t.Run("owners are told the amount of parsed receipts in their currency", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -407,26 +586,38 @@ func TestUpdateReceipt(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		updateValue := timestamppb.New(time.Date(1993, 2, 24, 0, 0, 0, 0, time.UTC))
-		s := servers.NewReceiptsServer(db, nil, nil)
-		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
-			Msg: &receiptsv1.UpdateReceiptRequest{
-				Id:   uint64(existingReceipt.ID),
-				Date: updateValue,
-			},
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
+
+		userEmail := "user@email.com"
+		receiptBytes := []byte("foo")
+		parserClient.EXPECT().
+			ParseReceipt(mock.Anything, userEmail, receiptBytes).
+			Return(&client.ParseReceiptResponse{Amount: 12.5, Currency: "USD", Vendor: "Walgreens", PurchaseDate: "01/05/2024"}, nil).
+			Once()
+
+		chatID := int64(42)
+		tgramClient.EXPECT().
+			SendMessage(mock.MatchedBy(func(req tgram.SendMessageRequest) bool {
+				return req.ChatID == chatID && strings.Contains(req.Text, "from Walgreens for 12.50 USD is ready")
+			})).
+			Return(nil).
+			Once()
+
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo", TelegramChatID: &chatID})
+		require.NoError(t, err)
+
+		ctx = auth.WithInfo(ctx, userEmail)
+		_, err = s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{receiptBytes}},
 		})
 		require.NoError(t, err)
 
-		repo = receipt.NewRepository(db)
-		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
-		assert.NoError(t, err)
-		assert.Equal(t, "24/02/1993", updatedReceipt.Date.Format("02/01/2006"))
-		assert.Equal(t, existingReceipt.Vendor, updatedReceipt.Vendor)
-		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
-		assert.Equal(t, existingReceipt.PendingReview, updatedReceipt.PendingReview)
+		runJobs(t, ctx, db, parserClient, tgramClient)
 	})
 
-	t.Run("when invalid id is provided, error is returned", func(t *testing.T) {
+	t.Run("receipts which the parser rejects are marked as failed without retrying", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -438,56 +629,82 @@ func TestUpdateReceipt(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		s := servers.NewReceiptsServer(db, nil, nil)
-		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
-			Msg: &receiptsv1.UpdateReceiptRequest{
-				Id: 123123,
-			},
-		})
-
-		assert.ErrorContains(t, err, "invalid_argument: receipt with id 123123 doesn't exist")
-	})
-}
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
 
-func TestDeleteReceipt(t *testing.T) {
-	ctx := context.Background()
+		userEmail := "user@email.com"
+		receiptBytes := []byte("foo")
+		parserClient.EXPECT().
+			ParseReceipt(mock.Anything, userEmail, receiptBytes).
+			Return(nil, &client.ParserError{StatusCode: http.StatusUnprocessableEntity, Message: "no amount"}).
+			Once()
 
-	dbContainer, err := pgtest.NewDBContainer(ctx)
-	require.NoError(t, err)
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+		require.NoError(t, err)
 
-	connectionString, err := dbContainer.ConnectionString(ctx)
-	require.NoError(t, err)
+		ctx = auth.WithInfo(ctx, userEmail)
+		res, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{ReceiptFiles: [][]byte{receiptBytes}},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Receipts, 1)
 
-	db, err := sqlx.Open("pgx", connectionString)
-	require.NoError(t, err)
+		runJobs(t, ctx, db, parserClient, tgramClient)
 
-	userEmail := "foo@email.com"
-	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
-	require.NoError(t, err)
+		var job struct {
+			Status   string `db:"status"`
+			Attempts int    `db:"attempts"`
+		}
+		err = db.GetContext(ctx, &job, "SELECT status, attempts FROM jobs")
+		require.NoError(t, err)
+		assert.Equal(t, "dead", job.Status)
+		assert.Equal(t, 1, job.Attempts)
 
-	repo := receipt.NewRepository(db)
-	existingreceipt, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
-		Amount:      5,
-		Description: "description",
-		Vendor:      "vendor",
-		Image:       []byte("foo"),
-		Date:        time.Now(),
-		Email:       userEmail,
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: res.Msg.Receipts[0].Id},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, receiptsv1.ReceiptStatus_RECEIPT_STATUS_FAILED, got.Msg.Receipt.Status)
+		assert.Contains(t, got.Msg.Receipt.StatusReason, "no amount")
 	})
-	require.NoError(t, err)
 
-	err = db.Close()
-	require.NoError(t, err)
+	t.Run("empty images are rejected", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
 
-	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("delete_receipt"))
-	require.NoError(t, err)
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
 
-	t.Cleanup(func() {
-		err = dbContainer.Terminate(ctx)
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
+
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
+
+		userEmail := "user@email.com"
+		receiptBytes := []byte("") // empty image
+
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+		require.NoError(t, err)
+
+		ctx = auth.WithInfo(ctx, userEmail)
+		res, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{
+				ReceiptFiles: [][]byte{receiptBytes},
+			},
+		})
 		require.NoError(t, err)
+		assert.Empty(t, res.Msg.Receipts)
+
+		require.Len(t, res.Msg.Results, 1)
+		assert.Equal(t, receiptsv1.FileErrorReason_FILE_ERROR_REASON_CORRUPTED, res.Msg.Results[0].GetError().GetReason())
 	})
 
-	t.Run("receipt deleted successfully", func(t *testing.T) {
+	t.Run("each file is created or fails on its own", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -495,25 +712,40 @@ func TestDeleteReceipt(t *testing.T) {
 			err = db.Close()
 			require.NoError(t, err)
 
-			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
 			require.NoError(t, err)
 		})
 
-		s := servers.NewReceiptsServer(db, nil, nil)
-		_, err = s.DeleteReceipt(ctx, &connect.Request[receiptsv1.DeleteReceiptRequest]{
-			Msg: &receiptsv1.DeleteReceiptRequest{
-				Id: uint64(existingreceipt.ID),
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+
+		userEmail := "user@email.com"
+		_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+		require.NoError(t, err)
+
+		// A PNG cut short after its header.
+		truncated := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x10\x00\x00\x00\x10\x08\x02\x00\x00\x00\x90\x91h6")
+
+		ctx = auth.WithInfo(ctx, userEmail)
+		res, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{
+				ReceiptFiles: [][]byte{[]byte("foo"), {0x00, 0x01, 0x02, 0x03}, truncated},
 			},
 		})
 		require.NoError(t, err)
+		require.Len(t, res.Msg.Results, 3)
 
-		repo = receipt.NewRepository(db)
-		existingReceipt, err := repo.GetReceipt(ctx, uint64(existingreceipt.ID))
-		assert.ErrorIs(t, err, sql.ErrNoRows)
-		assert.Nil(t, existingReceipt)
+		require.NotNil(t, res.Msg.Results[0].GetReceipt())
+		require.Len(t, res.Msg.Receipts, 1)
+		assert.Equal(t, res.Msg.Receipts[0].Id, res.Msg.Results[0].GetReceipt().Id)
+
+		assert.EqualValues(t, 1, res.Msg.Results[1].FileIndex)
+		assert.Equal(t, receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNSUPPORTED_TYPE, res.Msg.Results[1].GetError().GetReason())
+		assert.False(t, res.Msg.Results[1].GetError().GetRetryable())
+
+		assert.Equal(t, receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNREADABLE, res.Msg.Results[2].GetError().GetReason())
 	})
 
-	t.Run("attempting to delete a receipt that doesn't exist returns success", func(t *testing.T) {
+	t.Run("when a receipt fails to be created, it's reported as retryable", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -521,26 +753,226 @@ func TestDeleteReceipt(t *testing.T) {
 			err = db.Close()
 			require.NoError(t, err)
 
-			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
 			require.NoError(t, err)
 		})
 
-		s := servers.NewReceiptsServer(db, nil, nil)
-		_, err = s.DeleteReceipt(ctx, &connect.Request[receiptsv1.DeleteReceiptRequest]{
-			Msg: &receiptsv1.DeleteReceiptRequest{
-				Id: 9999999,
+		parserClient := client.NewMockParserClient(t)
+		tgramClient := tgram.NewMockClient(t)
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, tgramClient)
+
+		userEmail := "user@email.com"
+		receiptBytes := []byte("foo")
+		// Let's close the connection to force a DB error.
+		err = db.Close()
+		require.NoError(t, err)
+
+		ctx = auth.WithInfo(ctx, userEmail)
+		res, err := s.CreateReceipts(ctx, &connect.Request[receiptsv1.CreateReceiptsRequest]{
+			Msg: &receiptsv1.CreateReceiptsRequest{
+				ReceiptFiles: [][]byte{receiptBytes},
 			},
 		})
 		require.NoError(t, err)
+		assert.Empty(t, res.Msg.Receipts)
 
-		repo = receipt.NewRepository(db)
-		existingReceipt, err := repo.GetReceipt(ctx, uint64(existingreceipt.ID))
+		require.Len(t, res.Msg.Results, 1)
+		assert.Equal(t, receiptsv1.FileErrorReason_FILE_ERROR_REASON_INTERNAL, res.Msg.Results[0].GetError().GetReason())
+		assert.True(t, res.Msg.Results[0].GetError().GetRetryable())
+	})
+}
+
+// runJobs runs the queued jobs until there are none left to run.
+func runJobs(t *testing.T, ctx context.Context, db *sqlx.DB, p client.ParserClient, tg tgram.Client) {
+	t.Helper()
+
+	parser := workers.NewReceiptParser(db, blob.NewPostgresStore(db), p, tg)
+	worker := jobs.NewWorker(jobs.NewQueue(db), parser.Handlers())
+
+	for {
+		ran, err := worker.RunOnce(ctx)
 		require.NoError(t, err)
-		assert.NotNil(t, existingReceipt)
+
+		if !ran {
+			return
+		}
+	}
+}
+
+func TestUploadReceipts(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	token, err := auth.GenerateJWT(userEmail)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle(receiptsv1connect.NewReceiptsServiceHandler(
+		servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil),
+		connect.WithInterceptors(auth.AuthenticationInterceptor()),
+	))
+
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	c := receiptsv1connect.NewReceiptsServiceClient(server.Client(), server.URL)
+
+	pdf := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+	sum := sha256.Sum256(pdf)
+
+	header := &receiptsv1.UploadedFile{
+		Name:     "receipt.pdf",
+		MimeType: "application/pdf",
+		Size:     uint64(len(pdf)),
+		Sha256:   hex.EncodeToString(sum[:]),
+	}
+
+	upload := func(token string, file *receiptsv1.UploadedFile, data []byte) (*connect.Response[receiptsv1.CreateReceiptsResponse], error) {
+		stream := c.UploadReceipts(ctx)
+		stream.RequestHeader().Set("Authorization", "Bearer "+token)
+
+		// Errors sending are returned on closing the stream.
+		_ = stream.Send(&receiptsv1.UploadReceiptsRequest{Payload: &receiptsv1.UploadReceiptsRequest_File{File: file}})
+		for len(data) > 0 {
+			n := min(len(data), 8)
+			_ = stream.Send(&receiptsv1.UploadReceiptsRequest{Payload: &receiptsv1.UploadReceiptsRequest_Chunk{Chunk: data[:n]}})
+			data = data[n:]
+		}
+
+		return stream.CloseAndReceive()
+	}
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+	countReceipts := func() int {
+		page, err := repo.ListReceipts(ctx, receipt.ListReceiptsQuery{Email: userEmail})
+		require.NoError(t, err)
+
+		return len(page.Receipts)
+	}
+
+	t.Run("file sent in chunks is stored as a receipt", func(t *testing.T) {
+		res, err := upload(token, header, pdf)
+		require.NoError(t, err)
+
+		require.Len(t, res.Msg.Receipts, 1)
+		assert.Equal(t, receiptsv1.ReceiptStatus_RECEIPT_STATUS_UPLOADED, res.Msg.Receipts[0].Status)
+
+		image, err := repo.GetReceiptImage(ctx, res.Msg.Receipts[0].Id)
+		require.NoError(t, err)
+		assert.Equal(t, pdf, image)
+	})
+
+	t.Run("unauthenticated uploads are refused", func(t *testing.T) {
+		_, err := upload("invalid", header, pdf)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("files which don't match their checksum aren't stored", func(t *testing.T) {
+		before := countReceipts()
+
+		tampered := append([]byte{}, pdf...)
+		tampered[len(tampered)-2] = 'X'
+
+		res, err := upload(token, header, tampered)
+		require.NoError(t, err)
+		assert.Equal(t, before, countReceipts())
+
+		require.Len(t, res.Msg.Results, 1)
+		assert.Equal(t, "receipt.pdf", res.Msg.Results[0].Name)
+		assert.Equal(t, receiptsv1.FileErrorReason_FILE_ERROR_REASON_CORRUPTED, res.Msg.Results[0].GetError().GetReason())
+		assert.True(t, res.Msg.Results[0].GetError().GetRetryable())
+	})
+
+	t.Run("files of types which aren't allowed are refused", func(t *testing.T) {
+		file := proto.Clone(header).(*receiptsv1.UploadedFile)
+		file.MimeType = "text/html"
+
+		res, err := upload(token, file, pdf)
+		require.NoError(t, err)
+
+		require.Len(t, res.Msg.Results, 1)
+		assert.Equal(t, receiptsv1.FileErrorReason_FILE_ERROR_REASON_UNSUPPORTED_TYPE, res.Msg.Results[0].GetError().GetReason())
+	})
+
+	t.Run("files over the limit are refused", func(t *testing.T) {
+		file := proto.Clone(header).(*receiptsv1.UploadedFile)
+		file.Size = receipt.MaxFileSize + 1
+
+		res, err := upload(token, file, nil)
+		require.NoError(t, err)
+
+		require.Len(t, res.Msg.Results, 1)
+		assert.Equal(t, receiptsv1.FileErrorReason_FILE_ERROR_REASON_TOO_LARGE, res.Msg.Results[0].GetError().GetReason())
+	})
+
+	t.Run("a refused file doesn't stop the rest", func(t *testing.T) {
+		other := []byte("%PDF-1.4\n2 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+		otherSum := sha256.Sum256(other)
+
+		stream := c.UploadReceipts(ctx)
+		stream.RequestHeader().Set("Authorization", "Bearer "+token)
+
+		refused := proto.Clone(header).(*receiptsv1.UploadedFile)
+		refused.Name = "notes.html"
+		refused.MimeType = "text/html"
+
+		accepted := &receiptsv1.UploadedFile{Name: "other.pdf", MimeType: "application/pdf", Size: uint64(len(other)), Sha256: hex.EncodeToString(otherSum[:])}
+
+		require.NoError(t, stream.Send(&receiptsv1.UploadReceiptsRequest{Payload: &receiptsv1.UploadReceiptsRequest_File{File: refused}}))
+		require.NoError(t, stream.Send(&receiptsv1.UploadReceiptsRequest{Payload: &receiptsv1.UploadReceiptsRequest_Chunk{Chunk: pdf}}))
+		require.NoError(t, stream.Send(&receiptsv1.UploadReceiptsRequest{Payload: &receiptsv1.UploadReceiptsRequest_File{File: accepted}}))
+		require.NoError(t, stream.Send(&receiptsv1.UploadReceiptsRequest{Payload: &receiptsv1.UploadReceiptsRequest_Chunk{Chunk: other}}))
+
+		res, err := stream.CloseAndReceive()
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Results, 2)
+
+		assert.Equal(t, "notes.html", res.Msg.Results[0].Name)
+		assert.NotNil(t, res.Msg.Results[0].GetError())
+
+		assert.Equal(t, "other.pdf", res.Msg.Results[1].Name)
+		require.NotNil(t, res.Msg.Results[1].GetReceipt())
+	})
+
+	t.Run("uploads over the total limit are refused", func(t *testing.T) {
+		stream := c.UploadReceipts(ctx)
+		stream.RequestHeader().Set("Authorization", "Bearer "+token)
+
+		file := proto.Clone(header).(*receiptsv1.UploadedFile)
+		file.Size = receipt.MaxFileSize
+
+		for range receipt.MaxUploadSize/receipt.MaxFileSize + 1 {
+			_ = stream.Send(&receiptsv1.UploadReceiptsRequest{Payload: &receiptsv1.UploadReceiptsRequest_File{File: file}})
+		}
+
+		_, err := stream.CloseAndReceive()
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
 	})
 }
 
-func TestGetReceipt(t *testing.T) {
+func TestUpdateReceipt(t *testing.T) {
 	ctx := context.Background()
 
 	dbContainer, err := pgtest.NewDBContainer(ctx)
@@ -556,9 +988,9 @@ func TestGetReceipt(t *testing.T) {
 	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
 	require.NoError(t, err)
 
-	repo := receipt.NewRepository(db)
-	existingreceipt, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
-		Amount:      5.00,
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+	existingReceipt, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+		Amount:      5,
 		Description: "description",
 		Vendor:      "vendor",
 		Image:       []byte("foo"),
@@ -567,10 +999,14 @@ func TestGetReceipt(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// Note: Create receipt only returns the ID.
+	existingReceipt, err = repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+	require.NoError(t, err)
+
 	err = db.Close()
 	require.NoError(t, err)
 
-	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("delete_receipt"))
+	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("create_receipt"))
 	require.NoError(t, err)
 
 	t.Cleanup(func() {
@@ -578,7 +1014,7 @@ func TestGetReceipt(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	t.Run("receipt gotten successfully", func(t *testing.T) {
+	t.Run("everything is updated", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -586,31 +1022,34 @@ func TestGetReceipt(t *testing.T) {
 			err = db.Close()
 			require.NoError(t, err)
 
-			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
 			require.NoError(t, err)
 		})
 
-		s := servers.NewReceiptsServer(db, nil, nil)
-		res, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
-			Msg: &receiptsv1.GetReceiptRequest{
-				Id: uint64(existingreceipt.ID),
+		updateStr := "updated"
+		updateBool := true
+		updateDate := timestamppb.New(time.Date(1993, 2, 24, 0, 0, 0, 0, time.UTC))
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+			Msg: &receiptsv1.UpdateReceiptRequest{
+				Id:            uint64(existingReceipt.ID),
+				Vendor:        &updateStr,
+				PendingReview: &updateBool,
+				Date:          updateDate,
 			},
 		})
-
 		require.NoError(t, err)
-		require.NotNil(t, res.Msg.Receipt)
-		assert.Equal(t, res.Msg.Receipt.Id, uint64(existingreceipt.ID))
-		assert.Equal(t, res.Msg.Receipt.Vendor, "vendor")
-		assert.Equal(t, res.Msg.Receipt.File, []byte("foo"))
-		assert.Equal(t, res.Msg.Receipt.Status, receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW)
-		assert.Equal(t, res.Msg.Receipt.Date.AsTime().Format("02/01/2006"), time.Now().Format("02/01/2006"))
 
-		require.Len(t, res.Msg.Receipt.Expenses, 1)
-		assert.Equal(t, res.Msg.Receipt.Expenses[0].Date.AsTime().Format("02/01/2006"), time.Now().Format("02/01/2006"))
-		assert.EqualValues(t, res.Msg.Receipt.Expenses[0].Amount, 500)
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, updateStr, updatedReceipt.Vendor)
+		assert.Equal(t, receipt.StatusPendingReview, updatedReceipt.Status)
+		assert.Equal(t, "24/02/1993", updatedReceipt.Date.Format("02/01/2006"))
+		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
 	})
 
-	t.Run("when receipt doesn't exist, returns not found", func(t *testing.T) {
+	t.Run("only vendor is updated", func(t *testing.T) {
 		db, err := sqlx.Open("pgx", connectionString)
 		require.NoError(t, err)
 
@@ -618,18 +1057,1254 @@ func TestGetReceipt(t *testing.T) {
 			err = db.Close()
 			require.NoError(t, err)
 
-			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
 			require.NoError(t, err)
 		})
 
-		s := servers.NewReceiptsServer(db, nil, nil)
-		res, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
-			Msg: &receiptsv1.GetReceiptRequest{
-				Id: 9999999,
+		updateValue := "updated"
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+			Msg: &receiptsv1.UpdateReceiptRequest{
+				Id:     uint64(existingReceipt.ID),
+				Vendor: &updateValue,
 			},
 		})
+		require.NoError(t, err)
 
-		require.ErrorContains(t, err, "invalid_argument: receipt with id 9999999 doesn't exist")
-		require.Nil(t, res)
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, updateValue, updatedReceipt.Vendor)
+		assert.Equal(t, existingReceipt.Status, updatedReceipt.Status)
+		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
+		assert.Equal(t, existingReceipt.Date, updatedReceipt.Date)
 	})
+
+	t.Run("only pending review is updated", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
+
+		updateValue := false
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+			Msg: &receiptsv1.UpdateReceiptRequest{
+				Id:            uint64(existingReceipt.ID),
+				PendingReview: &updateValue,
+			},
+		})
+		require.NoError(t, err)
+
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, receipt.StatusReviewed, updatedReceipt.Status)
+		assert.Equal(t, existingReceipt.Vendor, updatedReceipt.Vendor)
+		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
+		assert.Equal(t, existingReceipt.Date, updatedReceipt.Date)
+	})
+
+	t.Run("status is updated and recorded as a transition", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
+
+		archived := receiptsv1.ReceiptStatus_RECEIPT_STATUS_ARCHIVED
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+			Msg: &receiptsv1.UpdateReceiptRequest{
+				Id:     uint64(existingReceipt.ID),
+				Status: &archived,
+			},
+		})
+		require.NoError(t, err)
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: uint64(existingReceipt.ID)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, archived, got.Msg.Receipt.Status)
+
+		require.Len(t, got.Msg.Receipt.Transitions, 2)
+		assert.Equal(t, receiptsv1.ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED, got.Msg.Receipt.Transitions[0].From)
+		assert.Equal(t, receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW, got.Msg.Receipt.Transitions[0].To)
+		assert.Equal(t, receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW, got.Msg.Receipt.Transitions[1].From)
+		assert.Equal(t, archived, got.Msg.Receipt.Transitions[1].To)
+	})
+
+	t.Run("when the status can't be reached, failed precondition is returned", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
+
+		// Receipts waiting to be parsed can't be reviewed yet.
+		_, err = db.ExecContext(ctx, "UPDATE receipts SET status = 'uploaded' WHERE id = $1", existingReceipt.ID)
+		require.NoError(t, err)
+
+		reviewed := receiptsv1.ReceiptStatus_RECEIPT_STATUS_REVIEWED
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+			Msg: &receiptsv1.UpdateReceiptRequest{
+				Id:     uint64(existingReceipt.ID),
+				Status: &reviewed,
+			},
+		})
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, receipt.StatusUploaded, updatedReceipt.Status)
+	})
+
+	t.Run("statuses reached by processing receipts can't be set", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		for _, status := range []receiptsv1.ReceiptStatus{
+			receiptsv1.ReceiptStatus_RECEIPT_STATUS_UPLOADED,
+			receiptsv1.ReceiptStatus_RECEIPT_STATUS_PARSING,
+			receiptsv1.ReceiptStatus_RECEIPT_STATUS_FAILED,
+		} {
+			_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+				Msg: &receiptsv1.UpdateReceiptRequest{
+					Id:     uint64(existingReceipt.ID),
+					Status: &status,
+				},
+			})
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), status.String())
+		}
+
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, existingReceipt.Status, updatedReceipt.Status)
+	})
+
+	t.Run("only date is updated", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
+
+		updateValue := timestamppb.New(time.Date(1993, 2, 24, 0, 0, 0, 0, time.UTC))
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+			Msg: &receiptsv1.UpdateReceiptRequest{
+				Id:   uint64(existingReceipt.ID),
+				Date: updateValue,
+			},
+		})
+		require.NoError(t, err)
+
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		updatedReceipt, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, "24/02/1993", updatedReceipt.Date.Format("02/01/2006"))
+		assert.Equal(t, existingReceipt.Vendor, updatedReceipt.Vendor)
+		assert.Equal(t, existingReceipt.UserEmail, updatedReceipt.UserEmail)
+		assert.Equal(t, existingReceipt.Status, updatedReceipt.Status)
+	})
+
+	t.Run("when invalid id is provided, error is returned", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("create_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.UpdateReceipt(ctx, &connect.Request[receiptsv1.UpdateReceiptRequest]{
+			Msg: &receiptsv1.UpdateReceiptRequest{
+				Id: 123123,
+			},
+		})
+
+		assert.ErrorContains(t, err, "invalid_argument: receipt with id 123123 doesn't exist")
+	})
+}
+
+func TestListReceipts(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+	ids := map[receipt.Status]uint64{}
+	for _, status := range []receipt.Status{receipt.StatusPendingReview, receipt.StatusReviewed, receipt.StatusArchived} {
+		r, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+			Vendor: string(status),
+			Image:  []byte(status),
+			Date:   time.Now(),
+			Email:  userEmail,
+		})
+		require.NoError(t, err)
+
+		err = repo.TransitionReceipt(ctx, r.ID, status, "")
+		require.NoError(t, err)
+
+		ids[status] = uint64(r.ID)
+	}
+
+	s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+	ctx = auth.WithInfo(ctx, userEmail)
+
+	list := func(status receiptsv1.ReceiptStatus) []uint64 {
+		res, err := s.ListReceipts(ctx, &connect.Request[receiptsv1.ListReceiptsRequest]{
+			Msg: &receiptsv1.ListReceiptsRequest{Status: status},
+		})
+		require.NoError(t, err)
+
+		var listed []uint64
+		for _, r := range res.Msg.Receipts {
+			listed = append(listed, r.Id)
+		}
+
+		return listed
+	}
+
+	t.Run("only receipts in the status are listed", func(t *testing.T) {
+		assert.Equal(t, []uint64{ids[receipt.StatusPendingReview]}, list(receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW))
+		assert.Equal(t, []uint64{ids[receipt.StatusReviewed]}, list(receiptsv1.ReceiptStatus_RECEIPT_STATUS_REVIEWED))
+		assert.Equal(t, []uint64{ids[receipt.StatusArchived]}, list(receiptsv1.ReceiptStatus_RECEIPT_STATUS_ARCHIVED))
+		assert.Empty(t, list(receiptsv1.ReceiptStatus_RECEIPT_STATUS_FAILED))
+	})
+
+	t.Run("archived receipts are left out by default", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{ids[receipt.StatusPendingReview], ids[receipt.StatusReviewed]}, list(receiptsv1.ReceiptStatus_RECEIPT_STATUS_UNSPECIFIED))
+	})
+}
+
+func TestListReceiptsFilters(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+
+	create := func(vendor string, amount float64, date time.Time) uint64 {
+		r, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+			Amount: amount,
+			Vendor: vendor,
+			Image:  []byte(vendor),
+			Date:   date,
+			Email:  userEmail,
+		})
+		require.NoError(t, err)
+
+		return uint64(r.ID)
+	}
+
+	january := create("Mercadona", 30, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	february := create("Lidl", 10, time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC))
+	march := create("Mercadona Online", 20, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+	ctx = auth.WithInfo(ctx, userEmail)
+
+	list := func(req *receiptsv1.ListReceiptsRequest) ([]uint64, string) {
+		res, err := s.ListReceipts(ctx, &connect.Request[receiptsv1.ListReceiptsRequest]{Msg: req})
+		require.NoError(t, err)
+
+		var listed []uint64
+		for _, r := range res.Msg.Receipts {
+			listed = append(listed, r.Id)
+		}
+
+		return listed, res.Msg.NextPageToken
+	}
+
+	t.Run("most recent first by default, with their expenses", func(t *testing.T) {
+		res, err := s.ListReceipts(ctx, &connect.Request[receiptsv1.ListReceiptsRequest]{Msg: &receiptsv1.ListReceiptsRequest{}})
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Receipts, 3)
+
+		assert.Equal(t, march, res.Msg.Receipts[0].Id)
+		assert.Equal(t, february, res.Msg.Receipts[1].Id)
+		assert.Equal(t, january, res.Msg.Receipts[2].Id)
+		assert.Empty(t, res.Msg.NextPageToken)
+
+		require.Len(t, res.Msg.Receipts[0].Expenses, 1)
+		assert.EqualValues(t, 2000, res.Msg.Receipts[0].Expenses[0].Amount)
+	})
+
+	t.Run("within the date range", func(t *testing.T) {
+		listed, _ := list(&receiptsv1.ListReceiptsRequest{
+			DateRange: &receiptsv1.DateRange{
+				From: timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+				To:   timestamppb.New(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)),
+			},
+		})
+		assert.Equal(t, []uint64{february}, listed)
+	})
+
+	t.Run("by vendor regardless of case", func(t *testing.T) {
+		listed, _ := list(&receiptsv1.ListReceiptsRequest{Vendor: "mercadona"})
+		assert.Equal(t, []uint64{march, january}, listed)
+	})
+
+	t.Run("within the amount bounds", func(t *testing.T) {
+		listed, _ := list(&receiptsv1.ListReceiptsRequest{MinAmount: proto.Uint64(1500), MaxAmount: proto.Uint64(3000)})
+		assert.Equal(t, []uint64{march, january}, listed)
+	})
+
+	t.Run("by amount", func(t *testing.T) {
+		listed, _ := list(&receiptsv1.ListReceiptsRequest{Order: receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_AMOUNT_ASC})
+		assert.Equal(t, []uint64{february, march, january}, listed)
+	})
+
+	t.Run("page by page", func(t *testing.T) {
+		for _, order := range []receiptsv1.ListReceiptsOrder{
+			receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_DATE_ASC,
+			receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_AMOUNT_DESC,
+		} {
+			all, _ := list(&receiptsv1.ListReceiptsRequest{Order: order})
+
+			var paged []uint64
+			token := ""
+			for {
+				listed, next := list(&receiptsv1.ListReceiptsRequest{Order: order, PageSize: 2, PageToken: token})
+				assert.LessOrEqual(t, len(listed), 2)
+				paged = append(paged, listed...)
+
+				if next == "" {
+					break
+				}
+				token = next
+			}
+
+			assert.Equal(t, all, paged, order.String())
+		}
+	})
+
+	t.Run("page token of another order", func(t *testing.T) {
+		_, token := list(&receiptsv1.ListReceiptsRequest{PageSize: 1})
+		require.NotEmpty(t, token)
+
+		_, err := s.ListReceipts(ctx, &connect.Request[receiptsv1.ListReceiptsRequest]{
+			Msg: &receiptsv1.ListReceiptsRequest{
+				Order:     receiptsv1.ListReceiptsOrder_LIST_RECEIPTS_ORDER_AMOUNT_ASC,
+				PageSize:  1,
+				PageToken: token,
+			},
+		})
+
+		var connectErr *connect.Error
+		require.ErrorAs(t, err, &connectErr)
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	})
+}
+
+func TestDeleteReceipt(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+	existingreceipt, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+		Amount:      5,
+		Description: "description",
+		Vendor:      "vendor",
+		Image:       []byte("foo"),
+		Date:        time.Now(),
+		Email:       userEmail,
+	})
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("delete_receipt"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("receipt deleted successfully", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.DeleteReceipt(ctx, &connect.Request[receiptsv1.DeleteReceiptRequest]{
+			Msg: &receiptsv1.DeleteReceiptRequest{
+				Id: uint64(existingreceipt.ID),
+			},
+		})
+		require.NoError(t, err)
+
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		existingReceipt, err := repo.GetReceipt(ctx, uint64(existingreceipt.ID))
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, existingReceipt)
+	})
+
+	t.Run("attempting to delete a receipt that doesn't exist returns success", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.DeleteReceipt(ctx, &connect.Request[receiptsv1.DeleteReceiptRequest]{
+			Msg: &receiptsv1.DeleteReceiptRequest{
+				Id: 9999999,
+			},
+		})
+		require.NoError(t, err)
+
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		existingReceipt, err := repo.GetReceipt(ctx, uint64(existingreceipt.ID))
+		require.NoError(t, err)
+		assert.NotNil(t, existingReceipt)
+	})
+}
+
+func TestGetReceipt(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+	existingreceipt, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+		Amount:      5.00,
+		Description: "description",
+		Vendor:      "vendor",
+		Image:       []byte("foo"),
+		Date:        time.Now(),
+		Email:       userEmail,
+	})
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("delete_receipt"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("receipt gotten successfully", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		res, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{
+				Id: uint64(existingreceipt.ID),
+			},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, res.Msg.Receipt)
+		assert.Equal(t, res.Msg.Receipt.Id, uint64(existingreceipt.ID))
+		assert.Equal(t, res.Msg.Receipt.Vendor, "vendor")
+		assert.Equal(t, res.Msg.Receipt.File, []byte("foo"))
+		assert.Equal(t, res.Msg.Receipt.Status, receiptsv1.ReceiptStatus_RECEIPT_STATUS_PENDING_REVIEW)
+		assert.Equal(t, res.Msg.Receipt.Date.AsTime().Format("02/01/2006"), time.Now().Format("02/01/2006"))
+
+		require.Len(t, res.Msg.Receipt.Expenses, 1)
+		assert.Equal(t, res.Msg.Receipt.Expenses[0].Date.AsTime().Format("02/01/2006"), time.Now().Format("02/01/2006"))
+		assert.EqualValues(t, res.Msg.Receipt.Expenses[0].Amount, 500)
+	})
+
+	t.Run("when receipt doesn't exist, returns not found", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("delete_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		res, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{
+				Id: 9999999,
+			},
+		})
+
+		require.ErrorContains(t, err, "invalid_argument: receipt with id 9999999 doesn't exist")
+		require.Nil(t, res)
+	})
+}
+
+func TestReparseReceipt(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+	existingReceipt, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+		Amount:      5,
+		Description: "description",
+		Vendor:      "vendor",
+		Image:       []byte("foo"),
+		Date:        time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Email:       userEmail,
+	})
+	require.NoError(t, err)
+
+	otherEmail := "bar@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: otherEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	err = dbContainer.Snapshot(ctx, postgres.WithSnapshotName("reparse_receipt"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	ctx = auth.WithInfo(ctx, userEmail)
+
+	t.Run("changes are returned without applying them", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("reparse_receipt"))
+			require.NoError(t, err)
+		})
+
+		parserClient := client.NewMockParserClient(t)
+		parserClient.EXPECT().
+			ParseReceiptWith(mock.Anything, userEmail, []byte("foo"), client.ParseOptions{Backend: "textract", Prompt: "the vendor is in the footer", NoCache: true}).
+			Return(&client.ParseReceiptResponse{
+				Amount:       7.25,
+				Description:  "description",
+				Vendor:       "better vendor",
+				PurchaseDate: "01/05/2024",
+			}, nil).
+			Once()
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), parserClient, nil)
+		res, err := s.ReparseReceipt(ctx, &connect.Request[receiptsv1.ReparseReceiptRequest]{
+			Msg: &receiptsv1.ReparseReceiptRequest{
+				Id:      uint64(existingReceipt.ID),
+				Backend: receiptsv1.ParserBackend_PARSER_BACKEND_TEXTRACT,
+				Prompt:  "the vendor is in the footer",
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []receiptsv1.ReceiptField{
+			receiptsv1.ReceiptField_RECEIPT_FIELD_VENDOR,
+			receiptsv1.ReceiptField_RECEIPT_FIELD_AMOUNT,
+		}, res.Msg.Changed)
+		assert.Equal(t, "vendor", res.Msg.Current.Vendor)
+		assert.EqualValues(t, 500, res.Msg.Current.Amount)
+		assert.Equal(t, "better vendor", res.Msg.Proposed.Vendor)
+		assert.EqualValues(t, 725, res.Msg.Proposed.Amount)
+
+		repo = receipt.NewRepository(db, blob.NewPostgresStore(db))
+		got, err := repo.GetReceipt(ctx, uint64(existingReceipt.ID))
+		require.NoError(t, err)
+		assert.Equal(t, "vendor", got.Vendor)
+	})
+
+	t.Run("only the chosen fields are applied", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("reparse_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.ApplyReceiptChanges(ctx, &connect.Request[receiptsv1.ApplyReceiptChangesRequest]{
+			Msg: &receiptsv1.ApplyReceiptChangesRequest{
+				Id: uint64(existingReceipt.ID),
+				Details: &receiptsv1.ReceiptDetails{
+					Vendor:      "better vendor",
+					Date:        timestamppb.New(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)),
+					Amount:      725,
+					Description: "better description",
+				},
+				Fields: []receiptsv1.ReceiptField{
+					receiptsv1.ReceiptField_RECEIPT_FIELD_AMOUNT,
+					receiptsv1.ReceiptField_RECEIPT_FIELD_DESCRIPTION,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		got, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{
+			Msg: &receiptsv1.GetReceiptRequest{Id: uint64(existingReceipt.ID)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "vendor", got.Msg.Receipt.Vendor)
+		assert.Equal(t, "01/05/2024", got.Msg.Receipt.Date.AsTime().Format("02/01/2006"))
+
+		require.Len(t, got.Msg.Receipt.Expenses, 1)
+		assert.EqualValues(t, 725, got.Msg.Receipt.Expenses[0].Amount)
+		assert.Equal(t, "better description", got.Msg.Receipt.Expenses[0].Description)
+	})
+
+	t.Run("the date can't be applied without one", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("reparse_receipt"))
+			require.NoError(t, err)
+		})
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.ApplyReceiptChanges(ctx, &connect.Request[receiptsv1.ApplyReceiptChangesRequest]{
+			Msg: &receiptsv1.ApplyReceiptChangesRequest{
+				Id:      uint64(existingReceipt.ID),
+				Details: &receiptsv1.ReceiptDetails{Vendor: "better vendor"},
+				Fields:  []receiptsv1.ReceiptField{receiptsv1.ReceiptField_RECEIPT_FIELD_DATE},
+			},
+		})
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		got, err := receipt.NewRepository(db, blob.NewPostgresStore(db)).GetReceipt(ctx, uint64(existingReceipt.ID))
+		require.NoError(t, err)
+		assert.Equal(t, existingReceipt.Date, got.Date)
+	})
+
+	t.Run("when the receipt has several expenses, the amount can't be applied", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("reparse_receipt"))
+			require.NoError(t, err)
+		})
+
+		_, err = db.ExecContext(ctx, "INSERT INTO expenses (user_email, expense_date, amount, receipt_id) VALUES ($1, NOW(), 100, $2)", userEmail, existingReceipt.ID)
+		require.NoError(t, err)
+
+		s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+		_, err = s.ApplyReceiptChanges(ctx, &connect.Request[receiptsv1.ApplyReceiptChangesRequest]{
+			Msg: &receiptsv1.ApplyReceiptChangesRequest{
+				Id:      uint64(existingReceipt.ID),
+				Details: &receiptsv1.ReceiptDetails{Amount: 725},
+				Fields:  []receiptsv1.ReceiptField{receiptsv1.ReceiptField_RECEIPT_FIELD_AMOUNT},
+			},
+		})
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("other users can't re-parse the receipt nor apply changes to it", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+		})
+
+		handler := servers.AuthorizationInterceptor(db, blob.NewPostgresStore(db))(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+			t.Fatal("the handler shouldn't be called")
+			return nil, nil
+		})
+
+		otherCtx := auth.WithInfo(context.Background(), otherEmail)
+
+		_, err = handler(otherCtx, connect.NewRequest(&receiptsv1.ReparseReceiptRequest{Id: uint64(existingReceipt.ID)}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = handler(otherCtx, connect.NewRequest(&receiptsv1.ApplyReceiptChangesRequest{
+			Id:      uint64(existingReceipt.ID),
+			Details: &receiptsv1.ReceiptDetails{Vendor: "stolen"},
+			Fields:  []receiptsv1.ReceiptField{receiptsv1.ReceiptField_RECEIPT_FIELD_VENDOR},
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("household members who can only read can't re-parse the receipt", func(t *testing.T) {
+		db, err := sqlx.Open("pgx", connectionString)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			err = db.Close()
+			require.NoError(t, err)
+
+			err = dbContainer.Restore(ctx, postgres.WithSnapshotName("reparse_receipt"))
+			require.NoError(t, err)
+		})
+
+		households := household.NewRepository(db)
+		h, err := households.CreateHousehold(ctx, "home", userEmail)
+		require.NoError(t, err)
+
+		invite, err := households.CreateInvite(ctx, household.CreateInviteRequest{HouseholdID: h.ID, InviterEmail: userEmail, InviteeEmail: otherEmail, Role: household.RoleRead})
+		require.NoError(t, err)
+		require.NoError(t, households.AcceptInvite(ctx, invite, otherEmail))
+
+		handler := servers.AuthorizationInterceptor(db, blob.NewPostgresStore(db))(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+			t.Fatal("the handler shouldn't be called")
+			return nil, nil
+		})
+
+		otherCtx := auth.WithInfo(context.Background(), otherEmail)
+
+		_, err = handler(otherCtx, connect.NewRequest(&receiptsv1.ReparseReceiptRequest{Id: uint64(existingReceipt.ID)}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
+
+func TestSearchReceipts(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "foo@email.com"
+	otherEmail := "bar@email.com"
+	for _, email := range []string{userEmail, otherEmail} {
+		_, err = users.Create(ctx, db, users.User{Email: email, Password: "foo"})
+		require.NoError(t, err)
+	}
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+	create := func(email, vendor, description, text string) int64 {
+		r, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+			Amount:      10,
+			Vendor:      vendor,
+			Description: description,
+			Text:        text,
+			Image:       []byte(vendor + email),
+			Date:        time.Now(),
+			Email:       email,
+		})
+		require.NoError(t, err)
+		return r.ID
+	}
+
+	electronics := create(userEmail, "Tech Store", "electronics", "TECH STORE\n1x HDMI cable 2m 9.99\nTOTAL 9.99")
+	groceries := create(userEmail, "Supermarket", "groceries", "SUPERMARKET\nmilk 1.20\nbread 0.90")
+	create(otherEmail, "Tech Store", "electronics", "TECH STORE\n1x HDMI cable 1m 4.99")
+
+	s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+	ctx = auth.WithInfo(ctx, userEmail)
+
+	search := func(query string) *receiptsv1.SearchReceiptsResponse {
+		res, err := s.SearchReceipts(ctx, &connect.Request[receiptsv1.SearchReceiptsRequest]{
+			Msg: &receiptsv1.SearchReceiptsRequest{Query: query},
+		})
+		require.NoError(t, err)
+		return res.Msg
+	}
+
+	t.Run("the text of the receipt is searched", func(t *testing.T) {
+		res := search("hdmi cable")
+
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, uint64(electronics), res.Results[0].Receipt.Id)
+
+		var matches []string
+		for _, part := range res.Results[0].Snippet {
+			if part.Match {
+				matches = append(matches, part.Text)
+			}
+		}
+		assert.Contains(t, matches, "HDMI")
+		assert.Contains(t, matches, "cable")
+	})
+
+	t.Run("the vendor and the description of the expenses are searched", func(t *testing.T) {
+		res := search("supermarket")
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, uint64(groceries), res.Results[0].Receipt.Id)
+
+		res = search("groceries")
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, uint64(groceries), res.Results[0].Receipt.Id)
+	})
+
+	t.Run("empty queries are rejected", func(t *testing.T) {
+		_, err := s.SearchReceipts(ctx, &connect.Request[receiptsv1.SearchReceiptsRequest]{
+			Msg: &receiptsv1.SearchReceiptsRequest{Query: " "},
+		})
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
+func TestReconcileReceipts(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	card, err := account.NewRepository(db).CreateAccount(ctx, account.CreateAccountRequest{
+		UserEmail:   userEmail,
+		Name:        "Visa",
+		Type:        account.TypeCard,
+		OpeningDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	r, err := receipt.NewRepository(db, blob.NewPostgresStore(db)).CreateReceipt(ctx, receipt.CreateReceiptRequest{
+		Amount: 40,
+		Vendor: "Bar Manolo",
+		Image:  []byte("Bar Manolo"),
+		Date:   time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+		Email:  userEmail,
+	})
+	require.NoError(t, err)
+
+	// The statement charged the receipt with a tip, a couple of days later.
+	expenses := expense.NewRepository(db)
+	err = expenses.CreateExpenses(ctx, expense.ExpensesBatch{
+		UserEmail: userEmail,
+		AccountID: card.ID,
+		Records: []expense.Expense{
+			{Date: time.Date(2024, 5, 12, 0, 0, 0, 0, time.UTC), Amount: 44, Category: "Food", Description: "TARJ. BAR MANOLO MADRID"},
+			{Date: time.Date(2024, 5, 12, 0, 0, 0, 0, time.UTC), Amount: 12, Category: "Transport", Description: "METRO MADRID"},
+		},
+	})
+	require.NoError(t, err)
+
+	s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+	ctx = auth.WithInfo(ctx, userEmail)
+
+	res, err := s.ListReconciliationProposals(ctx, &connect.Request[receiptsv1.ListReconciliationProposalsRequest]{Msg: &receiptsv1.ListReconciliationProposalsRequest{}})
+	require.NoError(t, err)
+	require.Len(t, res.Msg.Proposals, 1)
+
+	proposal := res.Msg.Proposals[0]
+	assert.Equal(t, uint64(r.ID), proposal.ReceiptId)
+	assert.EqualValues(t, 4000, proposal.ReceiptAmount)
+	assert.EqualValues(t, 4400, proposal.Transaction.Amount)
+	assert.Equal(t, card.ID, proposal.Transaction.AccountId)
+
+	reconciled, err := s.ReconcileReceipt(ctx, &connect.Request[receiptsv1.ReconcileReceiptRequest]{
+		Msg: &receiptsv1.ReconcileReceiptRequest{ReceiptId: proposal.ReceiptId, TransactionId: proposal.Transaction.Id},
+	})
+	require.NoError(t, err)
+
+	// The money is counted once, as much as was charged.
+	require.Len(t, reconciled.Msg.Expenses, 1)
+	assert.EqualValues(t, 4400, reconciled.Msg.Expenses[0].Amount)
+
+	merged, err := expenses.FindExpense(ctx, int64(reconciled.Msg.Expenses[0].Id))
+	require.NoError(t, err)
+	assert.Equal(t, card.ID, merged.AccountID)
+	assert.Equal(t, uint64(r.ID), merged.ReceiptID)
+
+	_, err = expenses.FindExpense(ctx, int64(proposal.Transaction.Id))
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	all, err := expenses.ListExpenses(ctx, userEmail)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	t.Run("reconciled receipts aren't proposed again", func(t *testing.T) {
+		res, err := s.ListReconciliationProposals(ctx, &connect.Request[receiptsv1.ListReconciliationProposalsRequest]{Msg: &receiptsv1.ListReconciliationProposalsRequest{}})
+		require.NoError(t, err)
+		assert.Empty(t, res.Msg.Proposals)
+	})
+
+	t.Run("receipts can't be reconciled twice", func(t *testing.T) {
+		_, err := s.ReconcileReceipt(ctx, &connect.Request[receiptsv1.ReconcileReceiptRequest]{
+			Msg: &receiptsv1.ReconcileReceiptRequest{ReceiptId: proposal.ReceiptId, TransactionId: all[0].ID},
+		})
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("transactions of other users can't be merged", func(t *testing.T) {
+		_, err := s.ReconcileReceipt(auth.WithInfo(context.Background(), "bar@email.com"), &connect.Request[receiptsv1.ReconcileReceiptRequest]{
+			Msg: &receiptsv1.ReconcileReceiptRequest{ReceiptId: proposal.ReceiptId, TransactionId: all[0].ID},
+		})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("household members reconcile the receipts of the ledger", func(t *testing.T) {
+		partnerEmail := "partner@email.com"
+		_, err = users.Create(ctx, db, users.User{Email: partnerEmail, Password: "foo"})
+		require.NoError(t, err)
+
+		households := household.NewRepository(db)
+		h, err := households.CreateHousehold(ctx, "home", userEmail)
+		require.NoError(t, err)
+
+		invite, err := households.CreateInvite(ctx, household.CreateInviteRequest{HouseholdID: h.ID, InviterEmail: userEmail, InviteeEmail: partnerEmail, Role: household.RoleReadWrite})
+		require.NoError(t, err)
+		require.NoError(t, households.AcceptInvite(ctx, invite, partnerEmail))
+
+		r, err := receipt.NewRepository(db, blob.NewPostgresStore(db)).CreateReceipt(ctx, receipt.CreateReceiptRequest{
+			Amount: 25,
+			Vendor: "Mercadona",
+			Image:  []byte("Mercadona"),
+			Date:   time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC),
+			Email:  userEmail,
+		})
+		require.NoError(t, err)
+
+		err = expenses.CreateExpenses(ctx, expense.ExpensesBatch{
+			UserEmail: userEmail,
+			AccountID: card.ID,
+			Records: []expense.Expense{
+				{Date: time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC), Amount: 25, Category: "Groceries", Description: "MERCADONA"},
+			},
+		})
+		require.NoError(t, err)
+
+		partnerCtx := auth.WithInfo(context.Background(), partnerEmail)
+		res, err := s.ListReconciliationProposals(partnerCtx, &connect.Request[receiptsv1.ListReconciliationProposalsRequest]{Msg: &receiptsv1.ListReconciliationProposalsRequest{}})
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Proposals, 1)
+		assert.Equal(t, uint64(r.ID), res.Msg.Proposals[0].ReceiptId)
+
+		_, err = s.ReconcileReceipt(partnerCtx, &connect.Request[receiptsv1.ReconcileReceiptRequest]{
+			Msg: &receiptsv1.ReconcileReceiptRequest{ReceiptId: res.Msg.Proposals[0].ReceiptId, TransactionId: res.Msg.Proposals[0].Transaction.Id},
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestGetVATReport(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+
+	createReceipt := func(image string, date time.Time, tax receipt.Tax, status receipt.Status) *receipt.Receipt {
+		r, err := repo.CreateReceipt(ctx, receipt.CreateReceiptRequest{
+			Amount: 100,
+			Vendor: "Office Supplies SL",
+			Image:  []byte(image),
+			Date:   date,
+			Email:  userEmail,
+			Tax:    tax,
+		})
+		require.NoError(t, err)
+
+		if status != receipt.StatusPendingReview {
+			err = repo.TransitionReceipt(ctx, r.ID, status, "")
+			require.NoError(t, err)
+		}
+
+		return r
+	}
+
+	invoice := receipt.Tax{
+		Subtotal:      85.16,
+		VendorTaxID:   "B12345678",
+		InvoiceNumber: "F-2024-001",
+		Lines: []receipt.TaxLine{
+			{Rate: 21, Base: 60, Amount: 12.6},
+			{Rate: 10, Base: 25.16, Amount: 2.52},
+			// Exempt lines aren't kept.
+			{Rate: 0, Base: 0, Amount: 0},
+		},
+	}
+
+	deductible := createReceipt("invoice", time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), invoice, receipt.StatusReviewed)
+	createReceipt("another invoice", time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), receipt.Tax{VendorTaxID: "B12345678", Lines: []receipt.TaxLine{{Rate: 21, Base: 10, Amount: 2.1}}}, receipt.StatusReviewed)
+
+	// Neither a simplified ticket, nor a receipt pending review, nor one of
+	// the next quarter count.
+	createReceipt("ticket", time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), receipt.Tax{Lines: []receipt.TaxLine{{Rate: 21, Base: 10, Amount: 2.1}}}, receipt.StatusReviewed)
+	createReceipt("pending", time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), invoice, receipt.StatusPendingReview)
+	createReceipt("next quarter", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), invoice, receipt.StatusReviewed)
+
+	s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+	ctx = auth.WithInfo(ctx, userEmail)
+
+	t.Run("taxes are returned with the receipt", func(t *testing.T) {
+		res, err := s.GetReceipt(ctx, &connect.Request[receiptsv1.GetReceiptRequest]{Msg: &receiptsv1.GetReceiptRequest{Id: uint64(deductible.ID)}})
+		require.NoError(t, err)
+
+		tax := res.Msg.Receipt.Tax
+		assert.EqualValues(t, 8516, tax.Subtotal)
+		assert.Equal(t, "B12345678", tax.VendorTaxId)
+		assert.Equal(t, "F-2024-001", tax.InvoiceNumber)
+		require.Len(t, tax.Lines, 2)
+		assert.Equal(t, 21.0, tax.Lines[0].Rate)
+		assert.EqualValues(t, 1260, tax.Lines[0].Amount)
+		assert.Equal(t, 10.0, tax.Lines[1].Rate)
+		assert.EqualValues(t, 2516, tax.Lines[1].Base)
+	})
+
+	t.Run("deductible VAT is grouped by rate", func(t *testing.T) {
+		res, err := s.GetVATReport(ctx, &connect.Request[receiptsv1.GetVATReportRequest]{Msg: &receiptsv1.GetVATReportRequest{Year: 2024, Quarter: 1}})
+		require.NoError(t, err)
+
+		require.Len(t, res.Msg.Rates, 2)
+		assert.Equal(t, 21.0, res.Msg.Rates[0].Rate)
+		assert.EqualValues(t, 7000, res.Msg.Rates[0].Base)
+		assert.EqualValues(t, 1470, res.Msg.Rates[0].Amount)
+		assert.EqualValues(t, 2, res.Msg.Rates[0].Receipts)
+		assert.Equal(t, 10.0, res.Msg.Rates[1].Rate)
+		assert.EqualValues(t, 252, res.Msg.Rates[1].Amount)
+		assert.EqualValues(t, 1, res.Msg.Rates[1].Receipts)
+		assert.EqualValues(t, 9516, res.Msg.TotalBase)
+		assert.EqualValues(t, 1722, res.Msg.TotalAmount)
+	})
+
+	t.Run("quarters out of range are refused", func(t *testing.T) {
+		_, err := s.GetVATReport(ctx, &connect.Request[receiptsv1.GetVATReportRequest]{Msg: &receiptsv1.GetVATReportRequest{Year: 2024, Quarter: 5}})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
+func TestGenerateExpenseReport(t *testing.T) {
+	ctx := context.Background()
+
+	dbContainer, err := pgtest.NewDBContainer(ctx)
+	require.NoError(t, err)
+
+	connectionString, err := dbContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sqlx.Open("pgx", connectionString)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = db.Close()
+		require.NoError(t, err)
+
+		err = dbContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	userEmail := "foo@email.com"
+	_, err = users.Create(ctx, db, users.User{Email: userEmail, Password: "foo"})
+	require.NoError(t, err)
+
+	repo := receipt.NewRepository(db, blob.NewPostgresStore(db))
+
+	for _, r := range []receipt.CreateReceiptRequest{
+		{Amount: 35.4, Vendor: "Renfe", Description: "Train to Valencia", Image: []byte("train ticket"), Date: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)},
+		{Amount: 22, Vendor: "Bar Manolo", Description: "Lunch", Image: []byte("lunch"), Date: time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)},
+		// Out of the dates of the report.
+		{Amount: 10, Vendor: "Bar Manolo", Description: "Coffee", Image: []byte("coffee"), Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		r.Email = userEmail
+		_, err = repo.CreateReceipt(ctx, r)
+		require.NoError(t, err)
+	}
+
+	s := servers.NewReceiptsServer(db, blob.NewPostgresStore(db), nil, nil)
+	ctx = auth.WithInfo(ctx, userEmail)
+
+	res, err := s.GenerateExpenseReport(ctx, &connect.Request[receiptsv1.GenerateExpenseReportRequest]{
+		Msg: &receiptsv1.GenerateExpenseReportRequest{
+			DateRange: &receiptsv1.DateRange{
+				From: timestamppb.New(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+				To:   timestamppb.New(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 2, res.Msg.Receipts)
+	assert.EqualValues(t, 5740, res.Msg.Total)
+	assert.True(t, bytes.HasPrefix(res.Msg.Pdf, []byte("%PDF-")))
 }
@@ -0,0 +1,260 @@
+package servers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jmoiron/sqlx"
+	settlementsv1 "github.com/manzanit0/mcduck/api/settlements.v1"
+	"github.com/manzanit0/mcduck/api/settlements.v1/settlementsv1connect"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/internal/settle"
+	"github.com/manzanit0/mcduck/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type settlementsServer struct {
+	Settle     *settle.Repository
+	Expenses   *expense.Repository
+	Households *household.Repository
+}
+
+var _ settlementsv1connect.SettlementsServiceClient = &settlementsServer{}
+
+func NewSettlementsServer(db *sqlx.DB) settlementsv1connect.SettlementsServiceClient {
+	return &settlementsServer{
+		Settle:     settle.NewRepository(db),
+		Expenses:   expense.NewRepository(db),
+		Households: household.NewRepository(db),
+	}
+}
+
+func (s *settlementsServer) SplitExpense(ctx context.Context, req *connect.Request[settlementsv1.SplitExpenseRequest]) (*connect.Response[settlementsv1.SplitExpenseResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("expense.id", int(req.Msg.ExpenseId)))
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	exp, err := s.Expenses.FindExpense(ctx, int64(req.Msg.ExpenseId))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("expense with id %d doesn't exist", req.Msg.ExpenseId))
+	} else if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to find expense: %w", err))
+	}
+
+	members, err := s.Households.LedgerMembers(ctx, email)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list ledger members: %w", err))
+	}
+
+	paidBy := exp.UserEmail
+	if req.Msg.PaidBy != nil {
+		paidBy = *req.Msg.PaidBy
+	}
+
+	if !containsEmail(members, paidBy) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s doesn't share the ledger", paidBy))
+	}
+
+	method, err := mapSplitMethodFromProto(req.Msg.Method)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	participants := make([]settle.Participant, len(req.Msg.Participants))
+	for i, p := range req.Msg.Participants {
+		if !containsEmail(members, p.Email) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s doesn't share the ledger", p.Email))
+		}
+
+		participants[i] = settle.Participant{Email: p.Email, Percentage: p.Percentage, Amount: int64(p.Amount)}
+	}
+
+	shares, err := settle.Split(int64(expense.ConvertToCents(exp.Amount)), method, participants)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unable to split expense: %w", err))
+	}
+
+	err = s.Settle.SetSplit(ctx, settle.SplitRequest{
+		ExpenseID: exp.ID,
+		PaidBy:    paidBy,
+		Method:    method,
+		Shares:    shares,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to split expense", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to split expense: %w", err))
+	}
+
+	res := connect.NewResponse(&settlementsv1.SplitExpenseResponse{})
+	for _, share := range shares {
+		res.Msg.Shares = append(res.Msg.Shares, &settlementsv1.Share{Email: share.Email, Amount: uint64(share.Amount)})
+	}
+
+	return res, nil
+}
+
+func (s *settlementsServer) RemoveExpenseSplit(ctx context.Context, req *connect.Request[settlementsv1.RemoveExpenseSplitRequest]) (*connect.Response[settlementsv1.RemoveExpenseSplitResponse], error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("expense.id", int(req.Msg.ExpenseId)))
+
+	err := s.Settle.RemoveSplit(ctx, req.Msg.ExpenseId)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to remove expense split", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to remove expense split: %w", err))
+	}
+
+	return connect.NewResponse(&settlementsv1.RemoveExpenseSplitResponse{}), nil
+}
+
+func (s *settlementsServer) GetBalances(ctx context.Context, req *connect.Request[settlementsv1.GetBalancesRequest]) (*connect.Response[settlementsv1.GetBalancesResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	summary, err := s.getSummary(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	res := connect.NewResponse(&settlementsv1.GetBalancesResponse{})
+
+	for email, amount := range summary.Balances {
+		res.Msg.Balances = append(res.Msg.Balances, &settlementsv1.Balance{Email: email, Amount: amount})
+	}
+
+	sort.Slice(res.Msg.Balances, func(i, j int) bool {
+		return res.Msg.Balances[i].Email < res.Msg.Balances[j].Email
+	})
+
+	for _, d := range summary.Debts {
+		res.Msg.Debts = append(res.Msg.Debts, &settlementsv1.Debt{FromEmail: d.From, ToEmail: d.To, Amount: uint64(d.Amount)})
+	}
+
+	return res, nil
+}
+
+func (s *settlementsServer) CreateSettlement(ctx context.Context, req *connect.Request[settlementsv1.CreateSettlementRequest]) (*connect.Response[settlementsv1.CreateSettlementResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	if !strings.EqualFold(email, req.Msg.FromEmail) && !strings.EqualFold(email, req.Msg.ToEmail) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the people settling can record a settlement"))
+	}
+
+	members, err := s.Households.LedgerMembers(ctx, email)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list ledger members: %w", err))
+	}
+
+	if !containsEmail(members, req.Msg.FromEmail) || !containsEmail(members, req.Msg.ToEmail) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("both people must share the ledger"))
+	}
+
+	if req.Msg.Amount == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settlement amount must be positive"))
+	}
+
+	date := time.Now()
+	if req.Msg.Date != nil {
+		date = req.Msg.Date.AsTime()
+	}
+
+	created, err := s.Settle.CreateSettlement(ctx, settle.Settlement{
+		FromEmail: req.Msg.FromEmail,
+		ToEmail:   req.Msg.ToEmail,
+		Amount:    int64(req.Msg.Amount),
+		Date:      date,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create settlement", "error", err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to create settlement: %w", err))
+	}
+
+	return connect.NewResponse(&settlementsv1.CreateSettlementResponse{Settlement: mapSettlement(*created)}), nil
+}
+
+func (s *settlementsServer) ListSettlements(ctx context.Context, req *connect.Request[settlementsv1.ListSettlementsRequest]) (*connect.Response[settlementsv1.ListSettlementsResponse], error) {
+	span := trace.SpanFromContext(ctx)
+
+	summary, err := s.getSummary(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	res := connect.NewResponse(&settlementsv1.ListSettlementsResponse{})
+	for _, settlement := range summary.Settlements {
+		res.Msg.Settlements = append(res.Msg.Settlements, mapSettlement(settlement))
+	}
+
+	return res, nil
+}
+
+func (s *settlementsServer) getSummary(ctx context.Context) (*settle.Summary, error) {
+	email := auth.MustGetUserEmailConnect(ctx)
+
+	members, err := s.Households.LedgerMembers(ctx, email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list ledger members: %w", err))
+	}
+
+	summary, err := s.Settle.GetSummary(ctx, members)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get settle up summary", "error", err.Error())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get balances: %w", err))
+	}
+
+	return summary, nil
+}
+
+func containsEmail(emails []string, email string) bool {
+	for _, e := range emails {
+		if strings.EqualFold(e, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mapSettlement(s settle.Settlement) *settlementsv1.Settlement {
+	return &settlementsv1.Settlement{
+		Id:        s.ID,
+		FromEmail: s.FromEmail,
+		ToEmail:   s.ToEmail,
+		Amount:    uint64(s.Amount),
+		Date:      timestamppb.New(s.Date),
+	}
+}
+
+func mapSplitMethodFromProto(m settlementsv1.SplitMethod) (settle.Method, error) {
+	switch m {
+	case settlementsv1.SplitMethod_SPLIT_METHOD_UNSPECIFIED, settlementsv1.SplitMethod_SPLIT_METHOD_EQUAL:
+		return settle.MethodEqual, nil
+	case settlementsv1.SplitMethod_SPLIT_METHOD_PERCENTAGE:
+		return settle.MethodPercentage, nil
+	case settlementsv1.SplitMethod_SPLIT_METHOD_EXACT:
+		return settle.MethodExact, nil
+	default:
+		return "", fmt.Errorf("unknown split method %s", m)
+	}
+}
@@ -0,0 +1,220 @@
+// Package workers runs the background jobs of dots.
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/internal/client"
+	"github.com/manzanit0/mcduck/internal/jobs"
+	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/internal/users"
+	"github.com/manzanit0/mcduck/pkg/tgram"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ReceiptParser runs the jobs which parse uploaded receipts and tells their
+// owners through Telegram once they're done.
+type ReceiptParser struct {
+	DB       *sqlx.DB
+	Telegram tgram.Client
+	Parser   client.ParserClient
+	Receipts *receipt.Repository
+}
+
+func NewReceiptParser(db *sqlx.DB, blobs blob.Store, p client.ParserClient, t tgram.Client) *ReceiptParser {
+	return &ReceiptParser{
+		DB:       db,
+		Telegram: t,
+		Parser:   p,
+		Receipts: receipt.NewRepository(db, blobs),
+	}
+}
+
+// Handlers returns the job handlers of the parser, to register in a
+// jobs.Worker.
+func (p *ReceiptParser) Handlers() map[string]jobs.Handler {
+	return map[string]jobs.Handler{receipt.ParseJob: p.Parse}
+}
+
+// Parse parses the receipt of the job. When the last attempt fails, or the
+// parser rejects the receipt for good, the receipt is marked as failed instead.
+func (p *ReceiptParser) Parse(ctx context.Context, job *jobs.Job) error {
+	ctx, span := xtrace.StartSpan(ctx, "Parse Receipt")
+	defer span.End()
+
+	var payload receipt.ParseJobPayload
+	err := job.Decode(&payload)
+	if err != nil {
+		return err
+	}
+
+	span.SetAttributes(attribute.Int64("receipt.id", payload.ReceiptID))
+
+	r, err := p.Receipts.GetReceipt(ctx, uint64(payload.ReceiptID))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		// The receipt was deleted before it could be parsed.
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("get receipt: %w", err)
+	}
+
+	if r.Status != receipt.StatusUploaded && r.Status != receipt.StatusParsing {
+		return nil
+	}
+
+	err = p.Receipts.TransitionReceipt(ctx, r.ID, receipt.StatusParsing, "")
+	if err != nil {
+		return fmt.Errorf("mark receipt as parsing: %w", err)
+	}
+
+	err = p.parse(ctx, r, payload)
+
+	// Only failures of the parser or of reaching it are worth retrying: the
+	// receipts it rejects won't be read on the next attempt either.
+	var parserErr *client.ParserError
+	if errors.As(err, &parserErr) && !parserErr.Temporary() {
+		err = jobs.Permanent(err)
+	}
+
+	if err != nil && (job.LastAttempt() || jobs.IsPermanent(err)) {
+		failErr := p.Receipts.FailProcessing(ctx, r.ID, err.Error())
+		if failErr != nil {
+			return fmt.Errorf("mark receipt as failed: %w", failErr)
+		}
+
+		p.notify(ctx, r.UserEmail, fmt.Sprintf("Receipt #%d couldn't be read, you'll have to fill in its details by hand.", r.ID))
+	}
+
+	return err
+}
+
+func (p *ReceiptParser) parse(ctx context.Context, r *receipt.Receipt, payload receipt.ParseJobPayload) error {
+	key, err := p.Receipts.GetReceiptImageKey(ctx, uint64(r.ID), receipt.ImageLarge)
+	if err != nil {
+		return fmt.Errorf("get image key: %w", err)
+	}
+
+	image, err := p.Receipts.GetImage(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := p.Parser.ParseReceipt(ctx, r.UserEmail, image)
+	if err != nil {
+		return fmt.Errorf("parse receipt: %w", err)
+	}
+
+	date, err := time.Parse("02/01/2006", parsed.PurchaseDate)
+	if err != nil {
+		slog.InfoContext(ctx, "failed to parse receipt date. Defaulting to upload date", "error", err.Error(), "receipt_id", r.ID)
+		date = r.Date
+	}
+
+	duplicate := r.DuplicateOf
+	if duplicate == nil {
+		duplicate, err = p.Receipts.FindDuplicateDetails(ctx, r.UserEmail, parsed.Vendor, date, parsed.Amount)
+		if err != nil {
+			return fmt.Errorf("find duplicate details: %w", err)
+		}
+
+		if duplicate != nil && payload.RejectDuplicates {
+			err = p.Receipts.DeleteReceipt(ctx, r.ID)
+			if err != nil {
+				return fmt.Errorf("delete duplicate receipt: %w", err)
+			}
+
+			p.notify(ctx, r.UserEmail, fmt.Sprintf("Receipt #%d was discarded because it looks like a duplicate of receipt #%d.", r.ID, duplicate.ReceiptID))
+			return nil
+		}
+	}
+
+	err = p.Receipts.CompleteProcessing(ctx, r.ID, receipt.CreateReceiptRequest{
+		Amount:      parsed.Amount,
+		Description: parsed.Description,
+		Vendor:      parsed.Vendor,
+		Date:        date,
+		Text:        parsed.Text,
+		Items:       mapParsedItems(parsed.Items),
+		Split:       payload.Split,
+		DuplicateOf: duplicate,
+		Tax:         mapParsedTax(parsed),
+	})
+	if err != nil {
+		return fmt.Errorf("complete processing: %w", err)
+	}
+
+	amount := fmt.Sprintf("%.2f", parsed.Amount)
+	if parsed.Currency != "" {
+		amount += " " + parsed.Currency
+	}
+
+	message := fmt.Sprintf("Receipt #%d from %s for %s is ready for review.", r.ID, parsed.Vendor, amount)
+	if duplicate != nil {
+		message += fmt.Sprintf(" It looks like a duplicate of receipt #%d.", duplicate.ReceiptID)
+	}
+
+	p.notify(ctx, r.UserEmail, message)
+
+	return nil
+}
+
+// notify sends the message to the user through Telegram, if they have linked
+// their account. Failing to notify doesn't fail the job, since the receipt is
+// already parsed.
+func (p *ReceiptParser) notify(ctx context.Context, email, message string) {
+	user, err := users.Find(ctx, p.DB, email)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to find user to notify", "error", err.Error())
+		return
+	}
+
+	if user.TelegramChatID == nil {
+		return
+	}
+
+	err = p.Telegram.SendMessage(tgram.SendMessageRequest{ChatID: *user.TelegramChatID, Text: message})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to notify user through telegram", "error", err.Error())
+	}
+}
+
+func mapParsedItems(items []client.LineItem) []receipt.Item {
+	parsed := make([]receipt.Item, len(items))
+	for i, item := range items {
+		parsed[i] = receipt.Item{
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: float32(item.UnitPrice),
+			Total:     float32(item.Total),
+			Category:  item.Category,
+		}
+	}
+
+	return parsed
+}
+
+func mapParsedTax(parsed *client.ParseReceiptResponse) receipt.Tax {
+	lines := make([]receipt.TaxLine, len(parsed.Taxes))
+	for i, t := range parsed.Taxes {
+		lines[i] = receipt.TaxLine{
+			Rate:   t.Rate,
+			Base:   float32(t.Base),
+			Amount: float32(t.Amount),
+		}
+	}
+
+	return receipt.Tax{
+		Subtotal:      float32(parsed.Subtotal),
+		VendorTaxID:   parsed.VendorTaxID,
+		InvoiceNumber: parsed.InvoiceNumber,
+		Lines:         lines,
+	}
+}
@@ -3,17 +3,21 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
-	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/gin-gonic/gin"
 	"github.com/manzanit0/mcduck/internal/parser"
+	"github.com/manzanit0/mcduck/pkg/llm"
 	"github.com/manzanit0/mcduck/pkg/micro"
-	"github.com/manzanit0/mcduck/pkg/openai"
+	"github.com/manzanit0/mcduck/pkg/xsql"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -22,6 +26,10 @@ import (
 const (
 	serviceName = "parser"
 	awsRegion   = "eu-west-1"
+
+	// memoryCacheSize is how many receipts the memory cache holds, which at a
+	// few KB each is some tens of MB.
+	memoryCacheSize = 10_000
 )
 
 func main() {
@@ -30,7 +38,10 @@ func main() {
 		panic(err)
 	}
 
-	apiKey := micro.MustGetEnv("OPENAI_API_KEY")
+	model, err := llm.New(llmConfig())
+	if err != nil {
+		panic(err)
+	}
 
 	// Just crash the service if these aren't available.
 	_ = micro.MustGetEnv("AWS_ACCESS_KEY")
@@ -41,8 +52,33 @@ func main() {
 		panic(err)
 	}
 
-	textractParser := parser.NewTextractParser(config, apiKey)
-	aivisionParser := parser.NewAIVisionParser(apiKey)
+	parsers := map[string]parser.ReceiptParser{
+		parser.BackendTextract: parser.NewTextractParser(config, model),
+		parser.BackendVision:   parser.NewAIVisionParser(model),
+		parser.BackendPDFText:  parser.NewNaivePDFParser(model),
+		parser.BackendText:     parser.NewTextParser(model),
+	}
+
+	chains, err := parser.ParseChains(os.Getenv("PARSER_CHAINS"))
+	if err != nil {
+		panic(err)
+	}
+
+	router, err := parser.NewRouter(parsers, chains)
+	if err != nil {
+		panic(err)
+	}
+
+	cache, ttl, err := cacheFromEnv()
+	if err != nil {
+		panic(err)
+	}
+
+	cachedRouter := parser.NewCachedParser(router, "auto", cache, ttl)
+	cachedParsers := map[string]*parser.CachedParser{}
+	for backend, p := range parsers {
+		cachedParsers[backend] = parser.NewCachedParser(p, backend, cache, ttl)
+	}
 
 	svc.Engine.POST("/receipt", func(c *gin.Context) {
 		ctx, span := xtrace.GetSpan(c.Request.Context())
@@ -54,61 +90,59 @@ func main() {
 			return
 		}
 
-		dir := os.TempDir()
-		filePath := filepath.Join(dir, file.Filename)
-		err = c.SaveUploadedFile(file, filePath)
+		f, err := file.Open()
 		if err != nil {
 			span.SetStatus(codes.Error, err.Error())
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to save file to disk: %s", err.Error())})
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to open file: %s", err.Error())})
 			return
 		}
+		defer f.Close()
 
-		span.SetAttributes(attribute.String("file.path", filePath))
-
-		data, err := os.ReadFile(filePath)
+		data, err := io.ReadAll(f)
 		if err != nil {
 			span.SetStatus(codes.Error, err.Error())
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to read file from disk: %s", err.Error())})
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unable to read file: %s", err.Error())})
 			return
 		}
 
 		span.SetAttributes(attribute.Int("file.size", len(data)))
+		span.SetAttributes(attribute.String("file.content_type", parser.Sniff(data)))
 
-		contentType := http.DetectContentType(data)
-		span.SetAttributes(attribute.String("file.content_type", contentType))
-
-		var receipt *parser.Receipt
-		var openAIRes *openai.Response
-
-		switch contentType {
-		case "application/pdf":
-			receipt, openAIRes, err = textractParser.ExtractReceipt(ctx, data)
-			if err != nil {
-				marshalledRes, _ := json.Marshal(openAIRes)
-				span.SetAttributes(attribute.String("openai.response", string(marshalledRes)))
-				span.SetStatus(codes.Error, err.Error())
-				slog.ErrorContext(c.Request.Context(), "failed to extract receipt", "error", err.Error(), "open_ai_response", marshalledRes)
-				c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable extract data from receipt: %s", err.Error())})
+		// Unless a backend is asked for, the router picks it.
+		cached := cachedRouter
+		if backend := c.PostForm("backend"); backend != "" {
+			var ok bool
+			cached, ok = cachedParsers[backend]
+			if !ok {
+				span.SetStatus(codes.Error, "unsupported backend")
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unsupported backend: %s", backend)})
 				return
 			}
+		}
 
-		// Default to images
-		default:
-			receipt, openAIRes, err = aivisionParser.ExtractReceipt(ctx, data)
-			if err != nil {
-				marshalledRes, _ := json.Marshal(openAIRes)
-				span.SetAttributes(attribute.String("openai.response", string(marshalledRes)))
-				span.SetStatus(codes.Error, err.Error())
-				slog.ErrorContext(c.Request.Context(), "chatGPT response", "error", err.Error(), "open_ai_response", marshalledRes)
-				c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("unable extract data from receipt: %s", err.Error())})
-				return
-			}
+		var p parser.ReceiptParser = cached
+		if c.PostForm("no_cache") == "true" {
+			p = cached.Bypass()
+		}
+
+		if instructions := c.PostForm("prompt"); instructions != "" {
+			p = p.WithInstructions(instructions)
+		}
+
+		receipt, llmRes, err := p.ExtractReceipt(ctx, data)
+		if err != nil {
+			marshalledRes, _ := json.Marshal(llmRes)
+			span.SetAttributes(attribute.String("llm.response", string(marshalledRes)))
+			span.SetStatus(codes.Error, err.Error())
+			slog.ErrorContext(c.Request.Context(), "failed to extract receipt", "error", err.Error(), "llm_response", marshalledRes)
+			c.JSON(errorStatus(err), gin.H{"error": fmt.Sprintf("unable extract data from receipt: %s", err.Error())})
+			return
 		}
 
 		marshalled, _ := json.Marshal(receipt)
-		marshalledRes, _ := json.Marshal(openAIRes)
-		span.SetAttributes(attribute.String("openai.response", string(marshalledRes)))
-		slog.InfoContext(c.Request.Context(), "chatGPT response", "processed_receipt", marshalled, "open_ai_response", marshalledRes)
+		marshalledRes, _ := json.Marshal(llmRes)
+		span.SetAttributes(attribute.String("llm.response", string(marshalledRes)))
+		slog.InfoContext(c.Request.Context(), "llm response", "processed_receipt", marshalled, "llm_response", marshalledRes)
 
 		c.JSON(http.StatusOK, receipt)
 	})
@@ -118,3 +152,112 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// errorStatus tells apart the receipts the model couldn't read from the
+// failures of its provider, which may be worth retrying.
+func errorStatus(err error) int {
+	if errors.Is(err, parser.ErrUnsupportedType) {
+		return http.StatusUnsupportedMediaType
+	}
+
+	var invalid *parser.ValidationError
+	if errors.As(err, &invalid) {
+		return http.StatusUnprocessableEntity
+	}
+
+	var apiErr *llm.APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.Temporary() {
+			return http.StatusServiceUnavailable
+		}
+
+		return http.StatusBadGateway
+	}
+
+	if errors.Is(err, llm.ErrEmptyResponse) {
+		return http.StatusBadGateway
+	}
+
+	return http.StatusInternalServerError
+}
+
+// cacheFromEnv builds the cache of receipts selected by PARSER_CACHE, which can
+// be "memory", "postgres" or "none". It defaults to memory, since the parser
+// needs no database otherwise. PARSER_CACHE_TTL is how long receipts are kept,
+// 30 days by default.
+func cacheFromEnv() (parser.Cache, time.Duration, error) {
+	ttl := 30 * 24 * time.Hour
+	if s := os.Getenv("PARSER_CACHE_TTL"); s != "" {
+		var err error
+		ttl, err = time.ParseDuration(s)
+		if err != nil {
+			return nil, 0, fmt.Errorf("parse PARSER_CACHE_TTL: %w", err)
+		}
+	}
+
+	switch kind := os.Getenv("PARSER_CACHE"); kind {
+	case "", "memory":
+		return parser.NewMemoryCache(memoryCacheSize), ttl, nil
+
+	case "postgres":
+		dbx, err := xsql.OpenFromEnv()
+		if err != nil {
+			return nil, 0, fmt.Errorf("open db: %w", err)
+		}
+
+		cache := parser.NewPostgresCache(dbx)
+		go deleteExpired(cache)
+
+		return cache, ttl, nil
+
+	case "none":
+		return parser.NoCache{}, ttl, nil
+
+	default:
+		return nil, 0, fmt.Errorf("unknown parser cache %q", kind)
+	}
+}
+
+// deleteExpired removes the expired receipts of the cache every hour.
+func deleteExpired(cache *parser.PostgresCache) {
+	for range time.Tick(time.Hour) {
+		deleted, err := cache.DeleteExpired(context.Background())
+		if err != nil {
+			slog.Error("failed to delete expired receipts", "error", err.Error())
+			continue
+		}
+
+		slog.Info("deleted expired receipts from the cache", "count", deleted)
+	}
+}
+
+// llmConfig reads the model which reads the receipts from the environment.
+// OPENAI_API_KEY and ANTHROPIC_API_KEY still work when LLM_API_KEY isn't set.
+func llmConfig() llm.Config {
+	cfg := llm.Config{
+		Provider: llm.Provider(os.Getenv("LLM_PROVIDER")),
+		Model:    os.Getenv("LLM_MODEL"),
+		BaseURL:  os.Getenv("LLM_BASE_URL"),
+		APIKey:   os.Getenv("LLM_API_KEY"),
+	}
+
+	if cfg.APIKey == "" {
+		switch cfg.Provider {
+		case llm.ProviderOpenAI, "":
+			cfg.APIKey = os.Getenv("OPENAI_API_KEY")
+		case llm.ProviderAnthropic:
+			cfg.APIKey = os.Getenv("ANTHROPIC_API_KEY")
+		}
+	}
+
+	if s := os.Getenv("LLM_MAX_TOKENS"); s != "" {
+		maxTokens, err := strconv.Atoi(s)
+		if err != nil {
+			panic(fmt.Errorf("parse LLM_MAX_TOKENS: %w", err))
+		}
+
+		cfg.MaxTokens = maxTokens
+	}
+
+	return cfg
+}
@@ -0,0 +1,557 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+type Type string
+
+const (
+	TypeCash Type = "cash"
+	TypeCard Type = "card"
+	TypeBank Type = "bank"
+)
+
+func (t Type) Valid() bool {
+	switch t {
+	case TypeCash, TypeCard, TypeBank:
+		return true
+	default:
+		return false
+	}
+}
+
+type Account struct {
+	ID             uint64
+	UserEmail      string
+	Name           string
+	Type           Type
+	OpeningBalance float32
+	OpeningDate    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// dbAccount is the representation of an account in the database. Just like
+// with expenses, balances are saved as cents.
+type dbAccount struct {
+	ID             uint64    `db:"id"`
+	UserEmail      string    `db:"user_email"`
+	Name           string    `db:"name"`
+	Type           string    `db:"account_type"`
+	OpeningBalance int64     `db:"opening_balance"`
+	OpeningDate    time.Time `db:"opening_date"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+func (a *dbAccount) MapAccount() *Account {
+	return &Account{
+		ID:             a.ID,
+		UserEmail:      a.UserEmail,
+		Name:           a.Name,
+		Type:           Type(a.Type),
+		OpeningBalance: expense.ConvertToDollar(int32(a.OpeningBalance)),
+		OpeningDate:    a.OpeningDate,
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+	}
+}
+
+type Transfer struct {
+	ID            uint64
+	UserEmail     string
+	FromAccountID uint64
+	ToAccountID   uint64
+	Amount        float32
+	Date          time.Time
+	Description   string
+}
+
+type dbTransfer struct {
+	ID            uint64    `db:"id"`
+	UserEmail     string    `db:"user_email"`
+	FromAccountID uint64    `db:"from_account_id"`
+	ToAccountID   uint64    `db:"to_account_id"`
+	Amount        int64     `db:"amount"`
+	Date          time.Time `db:"transfer_date"`
+	Description   *string   `db:"description"`
+}
+
+func (t *dbTransfer) MapTransfer() Transfer {
+	var description string
+	if t.Description != nil {
+		description = *t.Description
+	}
+
+	return Transfer{
+		ID:            t.ID,
+		UserEmail:     t.UserEmail,
+		FromAccountID: t.FromAccountID,
+		ToAccountID:   t.ToAccountID,
+		Amount:        expense.ConvertToDollar(int32(t.Amount)),
+		Date:          t.Date,
+		Description:   description,
+	}
+}
+
+// Movement is anything that changes the balance of an account: an expense
+// paid from it or a transfer in or out of it. Amount is signed: money leaving
+// the account is negative.
+type Movement struct {
+	Date   time.Time
+	Amount float32
+}
+
+// BalancePoint is the closing balance of an account for a given month.
+type BalancePoint struct {
+	MonthYear string
+	Date      time.Time
+	Balance   float32
+}
+
+// CalculateBalance returns the balance of the account after applying all the
+// movements up to and including the given date. Movements previous to opening
+// the account are considered part of the opening balance.
+func CalculateBalance(a Account, movements []Movement, at time.Time) float32 {
+	balance := int64(expense.ConvertToCents(a.OpeningBalance))
+	for _, m := range movements {
+		if m.Date.Before(a.OpeningDate) || m.Date.After(at) {
+			continue
+		}
+
+		balance += int64(expense.ConvertToCents(m.Amount))
+	}
+
+	return toDollars(balance)
+}
+
+// CalculateBalanceHistory returns the closing balance of every month from the
+// opening date of the account until the given date, both included.
+func CalculateBalanceHistory(a Account, movements []Movement, until time.Time) []BalancePoint {
+	sorted := make([]Movement, len(movements))
+	copy(sorted, movements)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	var points []BalancePoint
+
+	balance := int64(expense.ConvertToCents(a.OpeningBalance))
+	month := time.Date(a.OpeningDate.Year(), a.OpeningDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(until.Year(), until.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	i := 0
+	for i < len(sorted) && sorted[i].Date.Before(a.OpeningDate) {
+		i++
+	}
+
+	for !month.After(last) {
+		next := month.AddDate(0, 1, 0)
+		for i < len(sorted) && sorted[i].Date.Before(next) {
+			balance += int64(expense.ConvertToCents(sorted[i].Amount))
+			i++
+		}
+
+		points = append(points, BalancePoint{
+			MonthYear: expense.NewMonthYear(month),
+			Date:      next.AddDate(0, 0, -1),
+			Balance:   toDollars(balance),
+		})
+
+		month = next
+	}
+
+	return points
+}
+
+// toDollars converts a balance in cents, which as the sum of many amounts may
+// not fit in an int32, to dollars.
+func toDollars(cents int64) float32 {
+	return float32(float64(cents) / 100)
+}
+
+type Repository struct {
+	dbx *sqlx.DB
+}
+
+func NewRepository(dbx *sqlx.DB) *Repository {
+	return &Repository{dbx: dbx}
+}
+
+type CreateAccountRequest struct {
+	UserEmail      string
+	Name           string
+	Type           Type
+	OpeningBalance float32
+	OpeningDate    time.Time
+}
+
+func (r *Repository) CreateAccount(ctx context.Context, input CreateAccountRequest) (*Account, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Create Account")
+	defer span.End()
+
+	if input.Name == "" {
+		return nil, fmt.Errorf("empty account name")
+	}
+
+	if !input.Type.Valid() {
+		return nil, fmt.Errorf("invalid account type %q", input.Type)
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Insert("accounts").
+		Columns("user_email", "name", "account_type", "opening_balance", "opening_date").
+		Values(input.UserEmail, input.Name, string(input.Type), expense.ConvertToCents(input.OpeningBalance), input.OpeningDate).
+		Suffix(`RETURNING id, user_email, name, account_type, opening_balance, opening_date, created_at, updated_at`).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var record dbAccount
+	err = r.dbx.GetContext(ctx, &record, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return record.MapAccount(), nil
+}
+
+type UpdateAccountRequest struct {
+	ID             uint64
+	Name           *string
+	Type           *Type
+	OpeningBalance *float32
+	OpeningDate    *time.Time
+}
+
+func (r *Repository) UpdateAccount(ctx context.Context, input UpdateAccountRequest) error {
+	ctx, span := xtrace.StartSpan(ctx, "Update Account")
+	defer span.End()
+
+	var shouldUpdate bool
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	builder := psql.Update("accounts").Where(sq.Eq{"id": input.ID})
+
+	if input.Name != nil {
+		builder = builder.Set("name", *input.Name)
+		shouldUpdate = true
+	}
+
+	if input.Type != nil {
+		if !input.Type.Valid() {
+			return fmt.Errorf("invalid account type %q", *input.Type)
+		}
+
+		builder = builder.Set("account_type", string(*input.Type))
+		shouldUpdate = true
+	}
+
+	if input.OpeningBalance != nil {
+		builder = builder.Set("opening_balance", expense.ConvertToCents(*input.OpeningBalance))
+		shouldUpdate = true
+	}
+
+	if input.OpeningDate != nil {
+		builder = builder.Set("opening_date", *input.OpeningDate)
+		shouldUpdate = true
+	}
+
+	if !shouldUpdate {
+		return nil
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = r.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) FindAccount(ctx context.Context, id uint64) (*Account, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find Account by ID")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("id", "user_email", "name", "account_type", "opening_balance", "opening_date", "created_at", "updated_at").
+		From("accounts").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var record dbAccount
+	err = r.dbx.GetContext(ctx, &record, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return record.MapAccount(), nil
+}
+
+func (r *Repository) ListAccounts(ctx context.Context, email string) ([]Account, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Accounts")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("id", "user_email", "name", "account_type", "opening_balance", "opening_date", "created_at", "updated_at").
+		From("accounts").
+		Where(sq.Eq{"user_email": email}).
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var records []dbAccount
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	var accounts []Account
+	for _, record := range records {
+		accounts = append(accounts, *record.MapAccount())
+	}
+
+	return accounts, nil
+}
+
+// DeleteAccount removes the account along with its transfers. Expenses paid
+// from the account are kept, but no longer assigned to any account.
+func (r *Repository) DeleteAccount(ctx context.Context, id uint64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Delete Account")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.Delete("accounts").Where(sq.Eq{"id": id}).ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = r.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
+
+type CreateTransferRequest struct {
+	UserEmail     string
+	FromAccountID uint64
+	ToAccountID   uint64
+	Amount        float32
+	Date          time.Time
+	Description   string
+}
+
+func (r *Repository) CreateTransfer(ctx context.Context, input CreateTransferRequest) (*Transfer, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Create Transfer")
+	defer span.End()
+
+	if input.FromAccountID == input.ToAccountID {
+		return nil, fmt.Errorf("unable to transfer to the same account")
+	}
+
+	if input.Amount <= 0 {
+		return nil, fmt.Errorf("transfer amount must be positive")
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Insert("transfers").
+		Columns("user_email", "from_account_id", "to_account_id", "amount", "transfer_date", "description").
+		Values(input.UserEmail, input.FromAccountID, input.ToAccountID, expense.ConvertToCents(input.Amount), input.Date, input.Description).
+		Suffix(`RETURNING id, user_email, from_account_id, to_account_id, amount, transfer_date, description`).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var record dbTransfer
+	err = r.dbx.GetContext(ctx, &record, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	transfer := record.MapTransfer()
+	return &transfer, nil
+}
+
+func (r *Repository) DeleteTransfer(ctx context.Context, id uint64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Delete Transfer")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.Delete("transfers").Where(sq.Eq{"id": id}).ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = r.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) FindTransfer(ctx context.Context, id uint64) (*Transfer, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find Transfer by ID")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("id", "user_email", "from_account_id", "to_account_id", "amount", "transfer_date", "description").
+		From("transfers").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var record dbTransfer
+	err = r.dbx.GetContext(ctx, &record, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	transfer := record.MapTransfer()
+	return &transfer, nil
+}
+
+// ListTransfers lists the transfers of a user. When accountID isn't zero,
+// only the transfers in or out of that account are returned.
+func (r *Repository) ListTransfers(ctx context.Context, email string, accountID uint64) ([]Transfer, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Transfers")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"user_email": email}}
+	if accountID != 0 {
+		where = append(where, sq.Or{sq.Eq{"from_account_id": accountID}, sq.Eq{"to_account_id": accountID}})
+	}
+
+	query, args, err := psql.
+		Select("id", "user_email", "from_account_id", "to_account_id", "amount", "transfer_date", "description").
+		From("transfers").
+		Where(where).
+		OrderBy("transfer_date DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var records []dbTransfer
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	var transfers []Transfer
+	for _, record := range records {
+		transfers = append(transfers, record.MapTransfer())
+	}
+
+	return transfers, nil
+}
+
+// ListMovements returns every expense paid from the account and every
+// transfer in or out of it as signed movements.
+func (r *Repository) ListMovements(ctx context.Context, accountID uint64) ([]Movement, error) {
+	byAccount, err := r.ListMovementsForAccounts(ctx, []uint64{accountID})
+	if err != nil {
+		return nil, err
+	}
+
+	return byAccount[accountID], nil
+}
+
+// ListMovementsForAccounts returns the movements of all the accounts in a
+// single query, grouped by account.
+func (r *Repository) ListMovementsForAccounts(ctx context.Context, accountIDs []uint64) (map[uint64][]Movement, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Account Movements")
+	defer span.End()
+
+	byAccount := make(map[uint64][]Movement, len(accountIDs))
+	if len(accountIDs) == 0 {
+		return byAccount, nil
+	}
+
+	selects := []sq.SelectBuilder{
+		sq.Select("account_id", "expense_date AS movement_date", "-amount AS amount").
+			From("expenses").
+			Where(sq.Eq{"account_id": accountIDs}),
+		sq.Select("from_account_id AS account_id", "transfer_date AS movement_date", "-amount AS amount").
+			From("transfers").
+			Where(sq.Eq{"from_account_id": accountIDs}),
+		sq.Select("to_account_id AS account_id", "transfer_date AS movement_date", "amount").
+			From("transfers").
+			Where(sq.Eq{"to_account_id": accountIDs}),
+	}
+
+	var queries []string
+	var args []any
+	for _, s := range selects {
+		query, selectArgs, err := s.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("compile query: %w", err)
+		}
+
+		queries = append(queries, query)
+		args = append(args, selectArgs...)
+	}
+
+	query, err := sq.Dollar.ReplacePlaceholders(strings.Join(queries, " UNION ALL ") + " ORDER BY movement_date")
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var records []struct {
+		AccountID uint64    `db:"account_id"`
+		Date      time.Time `db:"movement_date"`
+		Amount    int64     `db:"amount"`
+	}
+
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	for _, record := range records {
+		byAccount[record.AccountID] = append(byAccount[record.AccountID], Movement{Date: record.Date, Amount: expense.ConvertToDollar(int32(record.Amount))})
+	}
+
+	return byAccount, nil
+}
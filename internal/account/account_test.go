@@ -0,0 +1,71 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manzanit0/mcduck/internal/account"
+)
+
+func TestCalculateBalance(t *testing.T) {
+	a := account.Account{OpeningBalance: 100, OpeningDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}
+	movements := []account.Movement{
+		{Date: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), Amount: -10.5},
+		{Date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Amount: 50},
+		{Date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Amount: -0.25},
+	}
+
+	balance := account.CalculateBalance(a, movements, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	if balance != 139.5 {
+		t.Fatalf("expected 139.5, got %f", balance)
+	}
+
+	balance = account.CalculateBalance(a, movements, time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC))
+	if balance != 139.25 {
+		t.Fatalf("expected 139.25, got %f", balance)
+	}
+}
+
+func TestCalculateBalanceOverflow(t *testing.T) {
+	a := account.Account{OpeningBalance: 200000, OpeningDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}
+	movements := []account.Movement{
+		{Date: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), Amount: 50000},
+	}
+
+	balance := account.CalculateBalance(a, movements, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	if balance != 250000 {
+		t.Fatalf("expected 250000, got %f", balance)
+	}
+
+	points := account.CalculateBalanceHistory(a, movements, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	if len(points) != 1 || points[0].Balance != 250000 {
+		t.Fatalf("expected a closing balance of 250000, got %+v", points)
+	}
+}
+
+func TestCalculateBalanceHistory(t *testing.T) {
+	a := account.Account{OpeningBalance: 100, OpeningDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}
+	movements := []account.Movement{
+		{Date: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), Amount: -20},
+		{Date: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), Amount: -10},
+		{Date: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), Amount: 5},
+	}
+
+	points := account.CalculateBalanceHistory(a, movements, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	expected := []account.BalancePoint{
+		{MonthYear: "2024-01", Date: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), Balance: 95},
+		{MonthYear: "2024-02", Date: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), Balance: 95},
+		{MonthYear: "2024-03", Date: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), Balance: 75},
+	}
+
+	if len(points) != len(expected) {
+		t.Fatalf("expected %d points, got %d", len(expected), len(points))
+	}
+
+	for i := range expected {
+		if points[i] != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], points[i])
+		}
+	}
+}
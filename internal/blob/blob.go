@@ -0,0 +1,81 @@
+// Package blob stores the files uploaded by users, like receipt images, away
+// from the rows which reference them.
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store persists blobs under a key. Keys are expected to be content-addressed,
+// see Key, so putting the same key twice is a no-op.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Key returns the content-addressed key of the data. Identical files share the
+// same key, so they're only stored once.
+func Key(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256/" + hex.EncodeToString(sum[:])
+}
+
+func validKey(key string) error {
+	digest, ok := strings.CutPrefix(key, "sha256/")
+	if !ok || len(digest) != sha256.Size*2 {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+
+	_, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return nil
+}
+
+// NewStoreFromEnv builds the store selected by BLOB_STORE, which can be
+// "postgres", "filesystem" or "s3". It defaults to Postgres.
+func NewStoreFromEnv(ctx context.Context, db *sqlx.DB) (Store, error) {
+	switch kind := os.Getenv("BLOB_STORE"); kind {
+	case "", "postgres":
+		return NewPostgresStore(db), nil
+
+	case "filesystem":
+		dir := os.Getenv("BLOB_DIR")
+		if dir == "" {
+			return nil, fmt.Errorf("BLOB_DIR is empty")
+		}
+
+		return NewFilesystemStore(dir)
+
+	case "s3":
+		bucket := os.Getenv("BLOB_BUCKET")
+		if bucket == "" {
+			return nil, fmt.Errorf("BLOB_BUCKET is empty")
+		}
+
+		cfg, err := config.LoadDefaultConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("load aws config: %w", err)
+		}
+
+		// BLOB_ENDPOINT is only needed for S3-compatible stores like MinIO.
+		return NewS3Store(cfg, bucket, os.Getenv("BLOB_ENDPOINT")), nil
+
+	default:
+		return nil, fmt.Errorf("unknown blob store %q", kind)
+	}
+}
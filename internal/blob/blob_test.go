@@ -0,0 +1,133 @@
+package blob_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/blob"
+)
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "sha256/2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", blob.Key([]byte("foo")))
+	assert.Equal(t, blob.Key([]byte("foo")), blob.Key([]byte("foo")))
+	assert.NotEqual(t, blob.Key([]byte("foo")), blob.Key([]byte("bar")))
+}
+
+func TestFilesystemStore(t *testing.T) {
+	store, err := blob.NewFilesystemStore(t.TempDir())
+	require.NoError(t, err)
+
+	testStore(t, store)
+}
+
+func TestS3Store(t *testing.T) {
+	server := httptest.NewServer(newFakeS3())
+	t.Cleanup(server.Close)
+
+	cfg := aws.Config{
+		Region: "us-east-1",
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "minio", SecretAccessKey: "minio123"}, nil
+		}),
+	}
+
+	testStore(t, blob.NewS3Store(cfg, "receipts", server.URL))
+}
+
+func testStore(t *testing.T, store blob.Store) {
+	ctx := context.Background()
+	data := []byte("some receipt")
+	key := blob.Key(data)
+
+	t.Run("missing blobs are not found", func(t *testing.T) {
+		_, err := store.Get(ctx, key)
+		require.ErrorIs(t, err, blob.ErrNotFound)
+	})
+
+	t.Run("blobs can be read after being put", func(t *testing.T) {
+		err := store.Put(ctx, key, data)
+		require.NoError(t, err)
+
+		// Content-addressed keys make putting the same blob twice harmless.
+		err = store.Put(ctx, key, data)
+		require.NoError(t, err)
+
+		got, err := store.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, data, got)
+	})
+
+	t.Run("deleted blobs are not found", func(t *testing.T) {
+		err := store.Delete(ctx, key)
+		require.NoError(t, err)
+
+		_, err = store.Get(ctx, key)
+		require.ErrorIs(t, err, blob.ErrNotFound)
+
+		err = store.Delete(ctx, key)
+		require.NoError(t, err)
+	})
+
+	t.Run("keys which aren't content-addressed are rejected", func(t *testing.T) {
+		err := store.Put(ctx, "../../etc/passwd", data)
+		require.ErrorContains(t, err, "invalid blob key")
+	})
+}
+
+// fakeS3 is a stand-in for MinIO which supports path-style PUT, GET and DELETE
+// of objects.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		f.objects[path] = data
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet:
+		data, ok := f.objects[path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+
+	case http.MethodDelete:
+		delete(f.objects, path)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
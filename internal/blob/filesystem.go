@@ -0,0 +1,121 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// FilesystemStore keeps blobs as files under a root directory. Files are
+// sharded by the first characters of their digest so no single directory
+// grows too large.
+type FilesystemStore struct {
+	root string
+}
+
+var _ Store = (*FilesystemStore)(nil)
+
+func NewFilesystemStore(root string) (*FilesystemStore, error) {
+	err := os.MkdirAll(root, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("create blob directory: %w", err)
+	}
+
+	return &FilesystemStore{root: root}, nil
+}
+
+func (s *FilesystemStore) Put(ctx context.Context, key string, data []byte) error {
+	_, span := xtrace.StartSpan(ctx, "Blob Put: Filesystem")
+	defer span.End()
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+
+	// Writing to a temporary file first means readers never see half-written
+	// blobs.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write blob: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("close blob: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("rename blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemStore) Get(ctx context.Context, key string) ([]byte, error) {
+	_, span := xtrace.StartSpan(ctx, "Blob Get: Filesystem")
+	defer span.End()
+
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("read blob: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *FilesystemStore) Delete(ctx context.Context, key string) error {
+	_, span := xtrace.StartSpan(ctx, "Blob Delete: Filesystem")
+	defer span.End()
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemStore) path(key string) (string, error) {
+	if err := validKey(key); err != nil {
+		return "", err
+	}
+
+	algorithm, digest, _ := strings.Cut(key, "/")
+	return filepath.Join(s.root, algorithm, digest[:2], digest), nil
+}
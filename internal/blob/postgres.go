@@ -0,0 +1,93 @@
+package blob
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// PostgresStore keeps blobs in the blobs table of the application database.
+type PostgresStore struct {
+	dbx *sqlx.DB
+}
+
+var _ Store = (*PostgresStore)(nil)
+
+func NewPostgresStore(dbx *sqlx.DB) *PostgresStore {
+	return &PostgresStore{dbx: dbx}
+}
+
+func (s *PostgresStore) Put(ctx context.Context, key string, data []byte) error {
+	ctx, span := xtrace.StartSpan(ctx, "Blob Put: Postgres")
+	defer span.End()
+
+	if err := validKey(key); err != nil {
+		return err
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("blobs").
+		Columns("blob_key", "data", "size").
+		Values(key, data, len(data)).
+		Suffix("ON CONFLICT (blob_key) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = s.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresStore) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Blob Get: Postgres")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("data").
+		From("blobs").
+		Where(sq.Eq{"blob_key": key}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var data []byte
+	err = s.dbx.GetContext(ctx, &data, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("select blob: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *PostgresStore) Delete(ctx context.Context, key string) error {
+	ctx, span := xtrace.StartSpan(ctx, "Blob Delete: Postgres")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("blobs").
+		Where(sq.Eq{"blob_key": key}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = s.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
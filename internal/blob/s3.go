@@ -0,0 +1,107 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// S3Store keeps blobs in an S3 bucket. Any S3-compatible store, like MinIO,
+// works by pointing the endpoint to it.
+type S3Store struct {
+	bucket string
+	client *s3.Client
+}
+
+var _ Store = (*S3Store)(nil)
+
+func NewS3Store(cfg aws.Config, bucket, endpoint string) *S3Store {
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+			// S3-compatible stores rarely support virtual-hosted buckets.
+			o.UsePathStyle = true
+		}
+	})
+
+	return &S3Store{bucket: bucket, client: client}
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, data []byte) error {
+	ctx, span := xtrace.StartSpan(ctx, "Blob Put: S3")
+	defer span.End()
+
+	if err := validKey(key); err != nil {
+		return err
+	}
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+		ContentType:   aws.String(http.DetectContentType(data)),
+	})
+	if err != nil {
+		return fmt.Errorf("AWS S3 PUT: %w", err)
+	}
+
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Blob Get: S3")
+	defer span.End()
+
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil && isNotFound(err) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("AWS S3 GET: %w", err)
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read S3 object: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	ctx, span := xtrace.StartSpan(ctx, "Blob Delete: S3")
+	defer span.End()
+
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("AWS S3 DELETE: %w", err)
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return true
+	}
+
+	var res *awshttp.ResponseError
+	return errors.As(err, &res) && res.HTTPStatusCode() == http.StatusNotFound
+}
@@ -0,0 +1,329 @@
+// Package chart renders the spending aggregates of the dashboard as images so
+// they can be sent over channels which can't run Chart.js, like Telegram or
+// email.
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/manzanit0/mcduck/internal/dashboard"
+)
+
+var ErrNoData = errors.New("no data to chart")
+
+type Kind string
+
+const (
+	KindBar        Kind = "bar"
+	KindStackedBar Kind = "stacked"
+	KindDonut      Kind = "donut"
+)
+
+func (k Kind) Valid() bool {
+	return k == KindBar || k == KindStackedBar || k == KindDonut
+}
+
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+func (f Format) ContentType() string {
+	if f == FormatSVG {
+		return "image/svg+xml"
+	}
+
+	return "image/png"
+}
+
+type Series struct {
+	Label  string
+	Values []float64
+}
+
+// Chart is the description of what to draw. For bar and donut charts only the
+// first series is used, with a value per label. Stacked bar charts have a bar
+// per label with a segment per series.
+type Chart struct {
+	Kind   Kind
+	Title  string
+	Labels []string
+	Series []Series
+}
+
+// FromChartData builds a chart out of the data the dashboard computes, which
+// has a dataset per month and a value per category in each of them.
+//
+// Bar and donut charts display the most recent month while stacked bar charts
+// display a bar per month, broken down by category.
+func FromChartData(kind Kind, title string, data dashboard.ChartData) (Chart, error) {
+	if len(data.Datasets) == 0 || len(data.Labels) == 0 {
+		return Chart{}, ErrNoData
+	}
+
+	switch kind {
+	case KindBar, KindDonut:
+		latest := data.Datasets[len(data.Datasets)-1]
+
+		values := make([]float64, len(latest.Data))
+		for i, v := range latest.Data {
+			values[i] = float64(v)
+		}
+
+		return Chart{
+			Kind:   kind,
+			Title:  fmt.Sprintf("%s %s", title, latest.Label),
+			Labels: data.Labels,
+			Series: []Series{{Label: latest.Label, Values: values}},
+		}, nil
+
+	case KindStackedBar:
+		months := make([]string, len(data.Datasets))
+		for i, dataset := range data.Datasets {
+			months[i] = dataset.Label
+		}
+
+		series := make([]Series, len(data.Labels))
+		for i, label := range data.Labels {
+			values := make([]float64, len(data.Datasets))
+			for j, dataset := range data.Datasets {
+				values[j] = float64(dataset.Data[i])
+			}
+
+			series[i] = Series{Label: label, Values: values}
+		}
+
+		return Chart{Kind: kind, Title: title, Labels: months, Series: series}, nil
+
+	default:
+		return Chart{}, fmt.Errorf("unknown chart kind %q", kind)
+	}
+}
+
+// Render writes the chart in the given format.
+func Render(w io.Writer, c Chart, f Format) error {
+	switch f {
+	case FormatSVG:
+		return RenderSVG(w, c)
+	case FormatPNG:
+		return RenderPNG(w, c)
+	default:
+		return fmt.Errorf("unknown chart format %q", f)
+	}
+}
+
+const (
+	width  = 800
+	height = 480
+
+	titleSize = 24
+	smallSize = 10
+)
+
+var (
+	background = color.RGBA{255, 255, 255, 255}
+	foreground = color.RGBA{55, 65, 81, 255}
+	gridColour = color.RGBA{229, 231, 235, 255}
+)
+
+// palette follows the colours of the charts in the web app.
+var palette = []color.RGBA{
+	{255, 99, 132, 255},
+	{255, 159, 64, 255},
+	{255, 205, 86, 255},
+	{75, 192, 192, 255},
+	{54, 162, 235, 255},
+	{153, 102, 255, 255},
+	{201, 203, 207, 255},
+}
+
+type anchor int
+
+const (
+	anchorStart anchor = iota
+	anchorMiddle
+	anchorEnd
+)
+
+// canvas is what both the SVG and PNG renderers implement so the layout of the
+// charts is only written once. Angles are in radians, clockwise from the x
+// axis, and text is positioned by its baseline.
+type canvas interface {
+	rect(x, y, w, h float64, c color.RGBA)
+	wedge(cx, cy, inner, outer, from, to float64, c color.RGBA)
+	text(x, y float64, s string, size float64, a anchor, c color.RGBA)
+}
+
+func layout(c Chart, cv canvas) error {
+	if len(c.Labels) == 0 || len(c.Series) == 0 {
+		return ErrNoData
+	}
+
+	cv.rect(0, 0, width, height, background)
+	cv.text(width/2, 40, c.Title, titleSize, anchorMiddle, foreground)
+
+	switch c.Kind {
+	case KindBar:
+		drawBars(c.Labels, c.Series[:1], cv, width-30)
+	case KindStackedBar:
+		drawBars(c.Labels, c.Series, cv, width-230)
+		drawLegend(seriesLabels(c.Series), cv)
+	case KindDonut:
+		return drawDonut(c.Labels, c.Series[0].Values, cv)
+	default:
+		return fmt.Errorf("unknown chart kind %q", c.Kind)
+	}
+
+	return nil
+}
+
+func drawBars(labels []string, series []Series, cv canvas, right float64) {
+	const left, top, bottom = 80.0, 80.0, height - 60.0
+
+	var max float64
+	for i := range labels {
+		var total float64
+		for _, s := range series {
+			total += value(s.Values, i)
+		}
+
+		max = math.Max(max, total)
+	}
+
+	step := niceStep(max / 5)
+	axisMax := step * math.Max(1, math.Ceil(max/step))
+
+	ticks := int(math.Round(axisMax / step))
+	for t := 0; t <= ticks; t++ {
+		v := step * float64(t)
+		y := bottom - (bottom-top)*v/axisMax
+		cv.rect(left, y, right-left, 1, gridColour)
+		cv.text(left-8, y+4, fmt.Sprintf("%.0f", v), smallSize, anchorEnd, foreground)
+	}
+
+	slot := (right - left) / float64(len(labels))
+	barWidth := slot * 0.7
+	maxChars := int(slot / 6)
+
+	for i, label := range labels {
+		x := left + slot*float64(i) + (slot-barWidth)/2
+		y := bottom
+
+		var total float64
+		for j, s := range series {
+			v := value(s.Values, i)
+			h := (bottom - top) * v / axisMax
+
+			colour := palette[i%len(palette)]
+			if len(series) > 1 {
+				colour = palette[j%len(palette)]
+			}
+
+			y -= h
+			cv.rect(x, y, barWidth, h, colour)
+			total += v
+		}
+
+		cv.text(x+barWidth/2, y-6, fmt.Sprintf("%.2f", total), smallSize, anchorMiddle, foreground)
+		cv.text(x+barWidth/2, bottom+18, truncate(label, maxChars), smallSize, anchorMiddle, foreground)
+	}
+}
+
+func drawDonut(labels []string, values []float64, cv canvas) error {
+	const cx, cy, outer, inner = 280.0, 270.0, 170.0, 95.0
+
+	var total float64
+	for i := range labels {
+		total += math.Max(0, value(values, i))
+	}
+
+	if total == 0 {
+		return ErrNoData
+	}
+
+	from := -math.Pi / 2
+	legend := make([]string, len(labels))
+	for i, label := range labels {
+		v := math.Max(0, value(values, i))
+		to := from + 2*math.Pi*v/total
+		if v > 0 {
+			cv.wedge(cx, cy, inner, outer, from, to, palette[i%len(palette)])
+		}
+
+		legend[i] = fmt.Sprintf("%s %.2f (%.0f%%)", truncate(label, 14), v, 100*v/total)
+		from = to
+	}
+
+	cv.text(cx, cy+8, fmt.Sprintf("%.2f", total), titleSize, anchorMiddle, foreground)
+	drawLegend(legend, cv)
+
+	return nil
+}
+
+func drawLegend(labels []string, cv canvas) {
+	const x, top, row = width - 210.0, 90.0, 24.0
+
+	for i, label := range labels {
+		y := top + row*float64(i)
+		if y > height-20 {
+			cv.text(x, y, fmt.Sprintf("and %d more", len(labels)-i), smallSize, anchorStart, foreground)
+			return
+		}
+
+		cv.rect(x, y-10, 12, 12, palette[i%len(palette)])
+		cv.text(x+20, y, label, smallSize, anchorStart, foreground)
+	}
+}
+
+func seriesLabels(series []Series) []string {
+	labels := make([]string, len(series))
+	for i, s := range series {
+		labels[i] = s.Label
+	}
+
+	return labels
+}
+
+func value(values []float64, i int) float64 {
+	if i < len(values) {
+		return values[i]
+	}
+
+	return 0
+}
+
+// niceStep rounds the step of the axis up to 1, 2 or 5 times a power of ten so
+// the ticks are easy to read.
+func niceStep(raw float64) float64 {
+	if raw <= 0 {
+		return 1
+	}
+
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5} {
+		if raw <= m*magnitude {
+			return m * magnitude
+		}
+	}
+
+	return 10 * magnitude
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if n < 1 || len(r) <= n {
+		return s
+	}
+
+	if n == 1 {
+		return string(r[:1])
+	}
+
+	return string(r[:n-1]) + "."
+}
@@ -0,0 +1,111 @@
+package chart_test
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/manzanit0/mcduck/internal/chart"
+	"github.com/manzanit0/mcduck/internal/dashboard"
+)
+
+var data = dashboard.ChartData{
+	Labels: []string{"food", "home"},
+	Datasets: []dashboard.Dataset{
+		{Label: "2024-01", Data: []float32{10, 500}},
+		{Label: "2024-02", Data: []float32{25.5, 500}},
+	},
+}
+
+func TestFromChartData(t *testing.T) {
+	t.Run("bar charts show the most recent month", func(t *testing.T) {
+		c, err := chart.FromChartData(chart.KindBar, "Spend per category", data)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if c.Title != "Spend per category 2024-02" {
+			t.Errorf("unexpected title %q", c.Title)
+		}
+
+		if len(c.Series) != 1 || c.Series[0].Values[0] != 25.5 || c.Series[0].Values[1] != 500 {
+			t.Errorf("unexpected series %+v", c.Series)
+		}
+	})
+
+	t.Run("stacked charts have a bar per month and a series per category", func(t *testing.T) {
+		c, err := chart.FromChartData(chart.KindStackedBar, "Month over month", data)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(c.Labels) != 2 || c.Labels[0] != "2024-01" || c.Labels[1] != "2024-02" {
+			t.Errorf("unexpected labels %v", c.Labels)
+		}
+
+		if len(c.Series) != 2 || c.Series[0].Label != "food" || c.Series[0].Values[0] != 10 || c.Series[0].Values[1] != 25.5 {
+			t.Errorf("unexpected series %+v", c.Series)
+		}
+	})
+
+	t.Run("no datasets means no chart", func(t *testing.T) {
+		_, err := chart.FromChartData(chart.KindDonut, "Empty", dashboard.ChartData{})
+		if !errors.Is(err, chart.ErrNoData) {
+			t.Errorf("expected ErrNoData, got %v", err)
+		}
+	})
+}
+
+func TestRender(t *testing.T) {
+	for _, kind := range []chart.Kind{chart.KindBar, chart.KindStackedBar, chart.KindDonut} {
+		c, err := chart.FromChartData(kind, "Spend & more", data)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		t.Run(string(kind)+" svg", func(t *testing.T) {
+			var b bytes.Buffer
+			err := chart.RenderSVG(&b, c)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			svg := b.String()
+			if !strings.HasPrefix(svg, "<svg") || !strings.Contains(svg, "food") || !strings.Contains(svg, "Spend &amp; more") {
+				t.Errorf("unexpected svg %s", svg)
+			}
+		})
+
+		t.Run(string(kind)+" png", func(t *testing.T) {
+			var b bytes.Buffer
+			err := chart.RenderPNG(&b, c)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			img, err := png.Decode(&b)
+			if err != nil {
+				t.Fatalf("unable to decode png: %s", err)
+			}
+
+			if img.Bounds().Dx() != 800 || img.Bounds().Dy() != 480 {
+				t.Errorf("unexpected size %v", img.Bounds())
+			}
+		})
+	}
+}
+
+func TestRenderDonutWithoutSpend(t *testing.T) {
+	c := chart.Chart{
+		Kind:   chart.KindDonut,
+		Labels: []string{"food"},
+		Series: []chart.Series{{Values: []float64{0}}},
+	}
+
+	err := chart.RenderSVG(&bytes.Buffer{}, c)
+	if !errors.Is(err, chart.ErrNoData) {
+		t.Errorf("expected ErrNoData, got %v", err)
+	}
+}
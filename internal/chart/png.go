@@ -0,0 +1,98 @@
+package chart
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+)
+
+type pngCanvas struct {
+	img *image.RGBA
+}
+
+func RenderPNG(w io.Writer, c Chart) error {
+	cv := &pngCanvas{img: image.NewRGBA(image.Rect(0, 0, width, height))}
+
+	err := layout(c, cv)
+	if err != nil {
+		return err
+	}
+
+	err = png.Encode(w, cv.img)
+	if err != nil {
+		return fmt.Errorf("encode png: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pngCanvas) rect(x, y, w, h float64, c color.RGBA) {
+	r := image.Rect(int(math.Round(x)), int(math.Round(y)), int(math.Round(x+w)), int(math.Round(y+h)))
+	draw.Draw(p.img, r, &image.Uniform{C: c}, image.Point{}, draw.Src)
+}
+
+func (p *pngCanvas) wedge(cx, cy, inner, outer, from, to float64, c color.RGBA) {
+	for y := int(cy - outer); y <= int(cy+outer); y++ {
+		for x := int(cx - outer); x <= int(cx+outer); x++ {
+			dx, dy := float64(x)+0.5-cx, float64(y)+0.5-cy
+
+			d := math.Hypot(dx, dy)
+			if d < inner || d > outer {
+				continue
+			}
+
+			// Wedges start at the top of the circle, so the angle is moved to
+			// the same range as theirs.
+			a := math.Atan2(dy, dx)
+			if a < -math.Pi/2 {
+				a += 2 * math.Pi
+			}
+
+			if a >= from && a < to {
+				p.img.SetRGBA(x, y, c)
+			}
+		}
+	}
+}
+
+// text draws the string with the bitmap font, scaled to approximate the size
+// of the SVG text. The font only has upper case letters.
+func (p *pngCanvas) text(x, y float64, s string, size float64, a anchor, c color.RGBA) {
+	scale := int(math.Max(1, math.Round(size/10)))
+	runes := []rune(strings.ToUpper(s))
+
+	textWidth := float64(len(runes)*glyphAdvance*scale - scale)
+	switch a {
+	case anchorMiddle:
+		x -= textWidth / 2
+	case anchorEnd:
+		x -= textWidth
+	}
+
+	left := int(math.Round(x))
+	top := int(math.Round(y)) - glyphHeight*scale
+
+	for i, r := range runes {
+		glyph, ok := font[r]
+		if !ok {
+			glyph = font['?']
+		}
+
+		for row, bits := range glyph {
+			for col := 0; col < glyphWidth; col++ {
+				if bits&(1<<(glyphWidth-1-col)) == 0 {
+					continue
+				}
+
+				px := left + (i*glyphAdvance+col)*scale
+				py := top + row*scale
+				draw.Draw(p.img, image.Rect(px, py, px+scale, py+scale), &image.Uniform{C: c}, image.Point{}, draw.Src)
+			}
+		}
+	}
+}
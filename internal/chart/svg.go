@@ -0,0 +1,72 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+)
+
+type svgCanvas struct {
+	b *bytes.Buffer
+}
+
+func RenderSVG(w io.Writer, c Chart) error {
+	cv := &svgCanvas{b: &bytes.Buffer{}}
+	fmt.Fprintf(cv.b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+
+	err := layout(c, cv)
+	if err != nil {
+		return err
+	}
+
+	cv.b.WriteString("</svg>\n")
+
+	_, err = w.Write(cv.b.Bytes())
+	if err != nil {
+		return fmt.Errorf("write svg: %w", err)
+	}
+
+	return nil
+}
+
+func (s *svgCanvas) rect(x, y, w, h float64, c color.RGBA) {
+	fmt.Fprintf(s.b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`, x, y, w, h, hex(c))
+}
+
+func (s *svgCanvas) wedge(cx, cy, inner, outer, from, to float64, c color.RGBA) {
+	// An arc can't start and end at the same point, so whole circles and
+	// anything bigger than half of one are drawn in two halves.
+	if to-from > math.Pi {
+		middle := from + (to-from)/2
+		s.wedge(cx, cy, inner, outer, from, middle, c)
+		s.wedge(cx, cy, inner, outer, middle, to, c)
+		return
+	}
+
+	point := func(r, a float64) (float64, float64) {
+		return cx + r*math.Cos(a), cy + r*math.Sin(a)
+	}
+
+	x0, y0 := point(outer, from)
+	x1, y1 := point(outer, to)
+	x2, y2 := point(inner, to)
+	x3, y3 := point(inner, from)
+
+	fmt.Fprintf(s.b, `<path d="M%.2f %.2f A%.2f %.2f 0 0 1 %.2f %.2f L%.2f %.2f A%.2f %.2f 0 0 0 %.2f %.2f Z" fill="%s"/>`,
+		x0, y0, outer, outer, x1, y1, x2, y2, inner, inner, x3, y3, hex(c))
+}
+
+func (s *svgCanvas) text(x, y float64, text string, size float64, a anchor, c color.RGBA) {
+	anchors := map[anchor]string{anchorStart: "start", anchorMiddle: "middle", anchorEnd: "end"}
+
+	fmt.Fprintf(s.b, `<text x="%.1f" y="%.1f" font-family="sans-serif" font-size="%.0f" text-anchor="%s" fill="%s">`, x, y, size, anchors[a], hex(c))
+	_ = xml.EscapeText(s.b, []byte(text))
+	s.b.WriteString("</text>")
+}
+
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -81,6 +81,67 @@ func (_c *MockParserClient_ParseReceipt_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ParseReceiptWith provides a mock function with given fields: ctx, onBehalfOfEmail, data, opts
+func (_m *MockParserClient) ParseReceiptWith(ctx context.Context, onBehalfOfEmail string, data []byte, opts ParseOptions) (*ParseReceiptResponse, error) {
+	ret := _m.Called(ctx, onBehalfOfEmail, data, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseReceiptWith")
+	}
+
+	var r0 *ParseReceiptResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, ParseOptions) (*ParseReceiptResponse, error)); ok {
+		return rf(ctx, onBehalfOfEmail, data, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, ParseOptions) *ParseReceiptResponse); ok {
+		r0 = rf(ctx, onBehalfOfEmail, data, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ParseReceiptResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, ParseOptions) error); ok {
+		r1 = rf(ctx, onBehalfOfEmail, data, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockParserClient_ParseReceiptWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseReceiptWith'
+type MockParserClient_ParseReceiptWith_Call struct {
+	*mock.Call
+}
+
+// ParseReceiptWith is a helper method to define mock.On call
+//   - ctx context.Context
+//   - onBehalfOfEmail string
+//   - data []byte
+//   - opts ParseOptions
+func (_e *MockParserClient_Expecter) ParseReceiptWith(ctx interface{}, onBehalfOfEmail interface{}, data interface{}, opts interface{}) *MockParserClient_ParseReceiptWith_Call {
+	return &MockParserClient_ParseReceiptWith_Call{Call: _e.mock.On("ParseReceiptWith", ctx, onBehalfOfEmail, data, opts)}
+}
+
+func (_c *MockParserClient_ParseReceiptWith_Call) Run(run func(ctx context.Context, onBehalfOfEmail string, data []byte, opts ParseOptions)) *MockParserClient_ParseReceiptWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].(ParseOptions))
+	})
+	return _c
+}
+
+func (_c *MockParserClient_ParseReceiptWith_Call) Return(_a0 *ParseReceiptResponse, _a1 error) *MockParserClient_ParseReceiptWith_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockParserClient_ParseReceiptWith_Call) RunAndReturn(run func(context.Context, string, []byte, ParseOptions) (*ParseReceiptResponse, error)) *MockParserClient_ParseReceiptWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockParserClient creates a new instance of MockParserClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockParserClient(t interface {
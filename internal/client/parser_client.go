@@ -18,14 +18,69 @@ import (
 
 type ParserClient interface {
 	ParseReceipt(ctx context.Context, onBehalfOfEmail string, data []byte) (*ParseReceiptResponse, error)
+	ParseReceiptWith(ctx context.Context, onBehalfOfEmail string, data []byte, opts ParseOptions) (*ParseReceiptResponse, error)
+}
+
+// ParseOptions change how the parser reads the receipt. The zero value leaves
+// it up to the parser.
+type ParseOptions struct {
+	// Backend is the parser to use: "vision", "textract" or "pdf_text". By
+	// default it's picked from the kind of file.
+	Backend string
+	// Prompt are extra instructions for the model, on top of the usual ones.
+	Prompt string
+	// NoCache reads the receipt again instead of returning what was read from
+	// the same file before, and replaces it.
+	NoCache bool
 }
 
 type ParseReceiptResponse struct {
-	Amount       float64 `json:"amount"`
-	Currency     string  `json:"currency"`
-	Description  string  `json:"description"`
-	Vendor       string  `json:"vendor"`
-	PurchaseDate string  `json:"purchase_date"`
+	Amount       float64    `json:"amount"`
+	Currency     string     `json:"currency"`
+	Description  string     `json:"description"`
+	Vendor       string     `json:"vendor"`
+	PurchaseDate string     `json:"purchase_date"`
+	Items        []LineItem `json:"items"`
+	// Text is the whole text of the receipt, to search receipts by.
+	Text string `json:"text"`
+
+	// Subtotal is the amount before taxes.
+	Subtotal      float64   `json:"subtotal"`
+	Taxes         []TaxLine `json:"taxes"`
+	VendorTaxID   string    `json:"vendor_tax_id"`
+	InvoiceNumber string    `json:"invoice_number"`
+}
+
+type TaxLine struct {
+	// Rate is a percentage, like 21 for 21%.
+	Rate   float64 `json:"rate"`
+	Base   float64 `json:"base"`
+	Amount float64 `json:"amount"`
+}
+
+type LineItem struct {
+	Name      string  `json:"name"`
+	Quantity  float64 `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	Total     float64 `json:"total"`
+	Category  string  `json:"category"`
+}
+
+// ParserError is a response of the parser other than 200.
+type ParserError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ParserError) Error() string {
+	return fmt.Sprintf("request failed: %d %s - %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Temporary reports whether the request may succeed when retried later, like
+// when the provider of the model fails. Receipts the parser couldn't read or
+// doesn't support are rejected with a 4xx and won't be read on a retry either.
+func (e *ParserError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
 }
 
 type parserClient struct {
@@ -46,7 +101,11 @@ func NewParserClient(host string) *parserClient {
 }
 
 func (c *parserClient) ParseReceipt(ctx context.Context, onBehalfOfEmail string, data []byte) (*ParseReceiptResponse, error) {
-	req, err := c.newParseReceiptRequest(ctx, data, onBehalfOfEmail)
+	return c.ParseReceiptWith(ctx, onBehalfOfEmail, data, ParseOptions{})
+}
+
+func (c *parserClient) ParseReceiptWith(ctx context.Context, onBehalfOfEmail string, data []byte, opts ParseOptions) (*ParseReceiptResponse, error) {
+	req, err := c.newParseReceiptRequest(ctx, data, onBehalfOfEmail, opts)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
@@ -63,7 +122,7 @@ func (c *parserClient) ParseReceipt(ctx context.Context, onBehalfOfEmail string,
 	}
 
 	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("request failed: %s - %s", res.Status, string(respBody))
+		return nil, &ParserError{StatusCode: res.StatusCode, Message: string(respBody)}
 	}
 
 	var unmarshalled ParseReceiptResponse
@@ -76,7 +135,7 @@ func (c *parserClient) ParseReceipt(ctx context.Context, onBehalfOfEmail string,
 	return &unmarshalled, nil
 }
 
-func (c *parserClient) newParseReceiptRequest(ctx context.Context, data []byte, onBehalfOf string) (*http.Request, error) {
+func (c *parserClient) newParseReceiptRequest(ctx context.Context, data []byte, onBehalfOf string, opts ParseOptions) (*http.Request, error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
@@ -94,6 +153,27 @@ func (c *parserClient) newParseReceiptRequest(ctx context.Context, data []byte,
 		return nil, fmt.Errorf("copy form file to writer: %w", err)
 	}
 
+	if opts.Backend != "" {
+		err = writer.WriteField("backend", opts.Backend)
+		if err != nil {
+			return nil, fmt.Errorf("write backend field: %w", err)
+		}
+	}
+
+	if opts.Prompt != "" {
+		err = writer.WriteField("prompt", opts.Prompt)
+		if err != nil {
+			return nil, fmt.Errorf("write prompt field: %w", err)
+		}
+	}
+
+	if opts.NoCache {
+		err = writer.WriteField("no_cache", "true")
+		if err != nil {
+			return nil, fmt.Errorf("write no_cache field: %w", err)
+		}
+	}
+
 	err = writer.Close()
 	if err != nil {
 		return nil, fmt.Errorf("close multipart request body writer: %w", err)
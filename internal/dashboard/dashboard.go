@@ -0,0 +1,255 @@
+package dashboard
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/manzanit0/mcduck/internal/expense"
+)
+
+var chartColours = []string{
+	"rgba(255, 99, 132)",
+	"rgba(255, 159, 64)",
+	"rgba(255, 205, 86)",
+	"rgba(75, 192, 192)",
+	"rgba(54, 162, 235)",
+	"rgba(153, 102, 255)",
+	"rgba(201, 203, 207)",
+	// repeated
+	"rgba(255, 99, 132)",
+	"rgba(255, 159, 64)",
+	"rgba(255, 205, 86)",
+	"rgba(75, 192, 192)",
+	"rgba(54, 162, 235)",
+	"rgba(153, 102, 255)",
+	"rgba(201, 203, 207)",
+}
+
+var chartBackgroundColours = []string{
+	"rgba(255, 99, 132, 0.2)",
+	"rgba(255, 159, 64, 0.2)",
+	"rgba(255, 205, 86, 0.2)",
+	"rgba(75, 192, 192, 0.2)",
+	"rgba(54, 162, 235, 0.2)",
+	"rgba(153, 102, 255, 0.2)",
+	"rgba(201, 203, 207, 0.2)",
+	// repeated
+	"rgba(255, 99, 132, 0.2)",
+	"rgba(255, 159, 64, 0.2)",
+	"rgba(255, 205, 86, 0.2)",
+	"rgba(75, 192, 192, 0.2)",
+	"rgba(54, 162, 235, 0.2)",
+	"rgba(153, 102, 255, 0.2)",
+	"rgba(201, 203, 207, 0.2)",
+}
+
+type ChartData struct {
+	Title    string
+	Labels   []string
+	Datasets []Dataset
+}
+
+type Dataset struct {
+	Label            string
+	BorderColour     string
+	BackgroundColour string
+	Hidden           bool
+	Data             []float32
+}
+
+type MonthlySpend struct {
+	Date      time.Time
+	MonthYear string
+	Amount    float32
+}
+
+// Dashboard holds every series the dashboard renders. It's shared by the web
+// app and the dashboard RPC so both report the same numbers.
+type Dashboard struct {
+	MostRecent          time.Time
+	Categories          []string
+	CategoriesChart     ChartData
+	SubcategoriesCharts []ChartData
+	TopCategories       []expense.CategoryAggregate
+	TotalSpends         []MonthlySpend
+}
+
+func (d *Dashboard) PrettyMonthYear() string {
+	return d.MostRecent.Format("January 2006")
+}
+
+// Build computes the dashboard for the given expenses. The expenses are
+// sorted by date in place.
+func Build(expenses []expense.Expense) *Dashboard {
+	expense.SortByDate(expenses)
+
+	mostRecent := expense.FindMostRecentTime(expenses)
+
+	categoryTotals := expense.CalculateTotalsPerCategory(expenses)
+	categoryLabels := getSecondClassifier(categoryTotals)
+
+	var subcategoryCharts []ChartData
+	for cat, subcats := range GroupSubcategoriesByCategory(expenses) {
+		filtered := FilterByCategory(expenses, cat)
+		subcategoryTotals := expense.CalculateTotalsPerSubCategory(filtered)
+		subcategoryChartData := buildChartData(subcats, subcategoryTotals)
+
+		subcategoryChartData.Title = cat
+
+		subcategoryCharts = append(subcategoryCharts, subcategoryChartData)
+	}
+
+	sort.Slice(subcategoryCharts, func(i, j int) bool {
+		return subcategoryCharts[i].Title < subcategoryCharts[j].Title
+	})
+
+	return &Dashboard{
+		MostRecent:          mostRecent,
+		Categories:          categoryLabels,
+		CategoriesChart:     buildChartData(categoryLabels, categoryTotals),
+		SubcategoriesCharts: subcategoryCharts,
+		TopCategories:       expense.GetTop3ExpenseCategories(expenses, expense.NewMonthYear(mostRecent)),
+		TotalSpends:         TotalSpendLastThreeMonths(expenses),
+	}
+}
+
+func TotalSpendLastThreeMonths(expenses []expense.Expense) []MonthlySpend {
+	latest := expense.FindMostRecentTime(expenses)
+	totalSpends := map[string]*MonthlySpend{}
+	for i := range expenses {
+		if isOlderThanLastThreeMonths(expenses[i].Date, latest) {
+			continue
+		}
+
+		key := expenses[i].Date.Format("January 2006")
+		val, ok := totalSpends[key]
+		if !ok {
+			totalSpends[key] = &MonthlySpend{
+				Date:      expenses[i].Date,
+				MonthYear: key,
+				Amount:    expenses[i].Amount,
+			}
+		} else {
+			val.Amount += expenses[i].Amount
+		}
+	}
+
+	sortedTotalSpends := []MonthlySpend{}
+	for _, a := range totalSpends {
+		a.Amount = roundCents(a.Amount)
+		sortedTotalSpends = append(sortedTotalSpends, *a)
+	}
+
+	sort.Slice(sortedTotalSpends, func(i, j int) bool {
+		return sortedTotalSpends[i].Date.Before(sortedTotalSpends[j].Date)
+	})
+
+	return sortedTotalSpends
+}
+
+func isOlderThanLastThreeMonths(t time.Time, latest time.Time) bool {
+	// 15th of March 2022-> 15th of December 2022
+	year, month, _ := latest.AddDate(0, -2, 0).Date()
+
+	// 1st of December 2022
+	beginningOf3MonthsAgo := time.Date(year, month, 1, 0, 0, 0, 0, time.Now().Location())
+
+	return t.Before(beginningOf3MonthsAgo)
+}
+
+func FilterByCategory(list []expense.Expense, cat string) []expense.Expense {
+	var filtered []expense.Expense
+	for i := range list {
+		if list[i].Category == cat {
+			filtered = append(filtered, list[i])
+		}
+	}
+
+	return filtered
+}
+
+func GroupSubcategoriesByCategory(list []expense.Expense) map[string][]string {
+	m := map[string]map[string]bool{}
+	for _, e := range list {
+		if _, ok := m[e.Category]; !ok {
+			m[e.Category] = map[string]bool{}
+		}
+
+		m[e.Category][e.Subcategory] = true
+	}
+
+	mm := map[string][]string{}
+	for k, v := range m {
+		if _, ok := mm[k]; !ok {
+			mm[k] = []string{}
+		}
+
+		for s := range v {
+			mm[k] = append(mm[k], s)
+		}
+
+		sort.Strings(mm[k])
+	}
+
+	return mm
+}
+
+func buildChartData(labels []string, totals map[string]map[string]float32) ChartData {
+	var datasets []Dataset
+	for monthYear, amountsByCategory := range totals { // totalsByMonth[monthYear][expense.Category] += expense.Amount
+		var data []float32
+		for _, label := range labels {
+			data = append(data, roundCents(amountsByCategory[label]))
+		}
+
+		datasets = append(datasets, Dataset{
+			Label:  monthYear,
+			Data:   data,
+			Hidden: true,
+		})
+	}
+
+	// Labels are formatted as 2006-01, so sorting them alphabetically sorts
+	// them chronologically too.
+	sort.Slice(datasets, func(i, j int) bool {
+		return datasets[i].Label < datasets[j].Label
+	})
+
+	// By default we only show the current month.
+	if len(datasets) > 0 {
+		datasets[len(datasets)-1].Hidden = false
+	}
+
+	for i := range datasets {
+		datasets[i].BorderColour = chartColours[i%len(chartColours)]
+		datasets[i].BackgroundColour = chartBackgroundColours[i%len(chartBackgroundColours)]
+	}
+
+	return ChartData{
+		Labels:   labels,
+		Datasets: datasets,
+	}
+}
+
+func getSecondClassifier(calculations map[string]map[string]float32) []string {
+	classifierMap := map[string]bool{}
+	classifierSlice := []string{}
+	for _, amountByClassifier := range calculations {
+		for secondClassifier := range amountByClassifier {
+			if ok := classifierMap[secondClassifier]; !ok {
+				classifierMap[secondClassifier] = true
+				classifierSlice = append(classifierSlice, secondClassifier)
+			}
+		}
+	}
+
+	sort.Strings(classifierSlice)
+	return classifierSlice
+}
+
+// roundCents gets rid of the float32 noise that accumulates when adding up
+// amounts, so the series render with two decimals at most.
+func roundCents(amount float32) float32 {
+	return float32(math.Round(float64(amount)*100) / 100)
+}
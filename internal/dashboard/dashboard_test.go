@@ -0,0 +1,96 @@
+package dashboard_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/manzanit0/mcduck/internal/dashboard"
+	"github.com/manzanit0/mcduck/internal/expense"
+)
+
+func TestGroupSubcategoriesByCategory(t *testing.T) {
+	testCases := []struct {
+		expenses []expense.Expense
+		result   map[string][]string
+	}{
+		{
+			expenses: []expense.Expense{
+				{Category: "a", Subcategory: "1"},
+				{Category: "a", Subcategory: "2"},
+				{Category: "b", Subcategory: "3"},
+				{Category: "c", Subcategory: "4"},
+				{Category: "c", Subcategory: "5"},
+				{Category: "d", Subcategory: "1"},
+			},
+			result: map[string][]string{
+				"a": {"1", "2"},
+				"b": {"3"},
+				"c": {"4", "5"},
+				"d": {"1"},
+			},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("case %d", i), func(t *testing.T) {
+			grouped := dashboard.GroupSubcategoriesByCategory(tc.expenses)
+			if len(grouped) != len(tc.result) {
+				t.Fatalf("expected %d results, got %d", len(tc.result), len(grouped))
+			}
+
+			for category, subcategories := range grouped {
+				for _, sub := range subcategories {
+					if !slices.Contains(tc.result[category], sub) {
+						t.Errorf("expected %s to contain %s", category, sub)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	expenses := []expense.Expense{
+		{Date: date("2024-01-10"), Amount: 10.1, Category: "food", Subcategory: "groceries"},
+		{Date: date("2024-02-10"), Amount: 0.1, Category: "food", Subcategory: "groceries"},
+		{Date: date("2024-02-11"), Amount: 0.2, Category: "food", Subcategory: "restaurants"},
+		{Date: date("2024-02-12"), Amount: 50, Category: "home", Subcategory: "rent"},
+	}
+
+	dash := dashboard.Build(expenses)
+
+	if dash.PrettyMonthYear() != "February 2024" {
+		t.Errorf("expected February 2024, got %s", dash.PrettyMonthYear())
+	}
+
+	if !slices.Equal(dash.Categories, []string{"food", "home"}) {
+		t.Errorf("unexpected categories %v", dash.Categories)
+	}
+
+	datasets := dash.CategoriesChart.Datasets
+	if len(datasets) != 2 || datasets[0].Label != "2024-01" || datasets[1].Label != "2024-02" {
+		t.Fatalf("unexpected datasets %+v", datasets)
+	}
+
+	if !datasets[0].Hidden || datasets[1].Hidden {
+		t.Errorf("expected only the most recent month to be visible")
+	}
+
+	if !slices.Equal(datasets[1].Data, []float32{0.3, 50}) {
+		t.Errorf("unexpected data %v", datasets[1].Data)
+	}
+
+	if len(dash.SubcategoriesCharts) != 2 || dash.SubcategoriesCharts[0].Title != "food" {
+		t.Errorf("unexpected subcategory charts %+v", dash.SubcategoriesCharts)
+	}
+
+	if len(dash.TotalSpends) != 2 || dash.TotalSpends[1].MonthYear != "February 2024" || dash.TotalSpends[1].Amount != 50.3 {
+		t.Errorf("unexpected total spends %+v", dash.TotalSpends)
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/household"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 )
 
@@ -26,6 +27,7 @@ type Expense struct {
 	Subcategory string
 	UserEmail   string
 	ReceiptID   uint64
+	AccountID   uint64
 	Description string
 }
 
@@ -40,6 +42,7 @@ type dbExpense struct {
 	Subcategory *string   `db:"sub_category"`
 	UserEmail   string    `db:"user_email"`
 	ReceiptID   *uint64   `db:"receipt_id"`
+	AccountID   *uint64   `db:"account_id"`
 	Description *string   `db:"description"`
 }
 
@@ -240,6 +243,11 @@ func NewRepository(db *sqlx.DB) *Repository {
 type ExpensesBatch struct {
 	Records   []Expense
 	UserEmail string
+
+	// AccountID is the account the whole batch was paid from, for instance when
+	// importing a bank statement. It takes precedence over the account of each
+	// of the records.
+	AccountID uint64
 }
 
 func (r *Repository) CreateExpenses(ctx context.Context, e ExpensesBatch) error {
@@ -264,9 +272,15 @@ func CreateExpenses(ctx context.Context, tx QueryExecutor, e ExpensesBatch) erro
 		"sub_category",
 		"description",
 		"receipt_id",
+		"account_id",
 	)
 
 	for _, expense := range e.Records {
+		accountID := expense.AccountID
+		if e.AccountID != 0 {
+			accountID = e.AccountID
+		}
+
 		builder = builder.Values(
 			e.UserEmail,
 			expense.Date,
@@ -275,6 +289,7 @@ func CreateExpenses(ctx context.Context, tx QueryExecutor, e ExpensesBatch) erro
 			expense.Subcategory,
 			expense.Description,
 			expense.ReceiptID,
+			nullableID(accountID),
 		)
 	}
 
@@ -298,7 +313,7 @@ func (r *Repository) FindExpense(ctx context.Context, id int64) (*Expense, error
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 	builder := psql.
-		Select("id, expense_Date, amount, category, sub_category, user_email", "receipt_id", "description").
+		Select("id, expense_Date, amount, category, sub_category, user_email", "receipt_id", "account_id", "description").
 		From("expenses").
 		Where(sq.Eq{"id": id})
 
@@ -325,6 +340,7 @@ type UpdateExpenseRequest struct {
 	Subcategory *string
 	Description *string
 	ReceiptID   *uint64
+	AccountID   *uint64
 }
 
 func (r *Repository) UpdateExpense(ctx context.Context, e UpdateExpenseRequest) error {
@@ -367,6 +383,12 @@ func (r *Repository) UpdateExpense(ctx context.Context, e UpdateExpenseRequest)
 		shouldUpdate = true
 	}
 
+	// Setting the account to zero unassigns the expense from any account.
+	if e.AccountID != nil {
+		builder = builder.Set("account_id", nullableID(*e.AccountID))
+		shouldUpdate = true
+	}
+
 	if !shouldUpdate {
 		return nil
 	}
@@ -389,6 +411,7 @@ type CreateExpenseRequest struct {
 	Date      time.Time
 	Amount    float32
 	ReceiptID *uint64
+	AccountID *uint64
 }
 
 func (r *Repository) CreateExpense(ctx context.Context, e CreateExpenseRequest) (int64, error) {
@@ -399,8 +422,8 @@ func (r *Repository) CreateExpense(ctx context.Context, e CreateExpenseRequest)
 
 	builder := psql.
 		Insert("expenses").
-		Columns("user_email", "amount, expense_date", "receipt_id").
-		Values(e.UserEmail, ConvertToCents(e.Amount), e.Date, e.ReceiptID).
+		Columns("user_email", "amount, expense_date", "receipt_id", "account_id").
+		Values(e.UserEmail, ConvertToCents(e.Amount), e.Date, e.ReceiptID, e.AccountID).
 		Suffix("RETURNING \"id\"")
 
 	query, args, err := builder.ToSql()
@@ -443,8 +466,21 @@ func (r *Repository) ListExpenses(ctx context.Context, email string) ([]Expense,
 	ctx, span := xtrace.StartSpan(ctx, "List Expenses for User")
 	defer span.End()
 
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	// Users see the expenses of the whole household ledger, not only their own.
+	query, args, err := psql.
+		Select("id", "amount", "expense_date", "category", "sub_category", "description", "receipt_id", "account_id", "user_email").
+		From("expenses").
+		Where(household.VisibleTo(email)).
+		OrderBy("expense_date desc").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
 	var expenses []dbExpense
-	err := r.db.SelectContext(ctx, &expenses, `SELECT id, amount, expense_date, category, sub_category, description, receipt_id FROM expenses WHERE user_email = $1 ORDER BY expense_date desc`, email)
+	err = r.db.SelectContext(ctx, &expenses, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to execute query: %w", err)
 	}
@@ -464,7 +500,7 @@ func (r *Repository) ListExpensesForReceipt(ctx context.Context, receiptID uint6
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 	query, args, err := psql.
-		Select("id", "expense_date", "amount", "category", "sub_category", "description").
+		Select("id", "expense_date", "amount", "category", "sub_category", "description", "account_id").
 		From("expenses").
 		Where(sq.Eq{"receipt_id": receiptID}).
 		ToSql()
@@ -486,6 +522,43 @@ func (r *Repository) ListExpensesForReceipt(ctx context.Context, receiptID uint6
 	return expensesList, nil
 }
 
+// ListExpensesForReceipts returns the expenses of all the receipts in a single
+// query, grouped by receipt.
+func (r *Repository) ListExpensesForReceipts(ctx context.Context, receiptIDs []uint64) (map[uint64][]Expense, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Expenses for Receipts")
+	defer span.End()
+
+	byReceipt := make(map[uint64][]Expense, len(receiptIDs))
+	if len(receiptIDs) == 0 {
+		return byReceipt, nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("id", "expense_date", "amount", "category", "sub_category", "description", "account_id", "receipt_id").
+		From("expenses").
+		Where(sq.Eq{"receipt_id": receiptIDs}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var expenses []dbExpense
+	err = r.db.SelectContext(ctx, &expenses, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	for _, expense := range expenses {
+		e := toDomainExpense(expense)
+		byReceipt[e.ReceiptID] = append(byReceipt[e.ReceiptID], e)
+	}
+
+	return byReceipt, nil
+}
+
 func ConvertToCents(amount float32) int32 {
 	return int32(math.Round(float64(amount * 100)))
 }
@@ -498,6 +571,15 @@ func ConvertToDollar(cents int32) float32 {
 	return float32(cents) / 100
 }
 
+// nullableID maps the zero ID to NULL so it doesn't violate foreign keys.
+func nullableID(id uint64) *uint64 {
+	if id == 0 {
+		return nil
+	}
+
+	return &id
+}
+
 func toDomainExpense(expense dbExpense) Expense {
 	e := Expense{
 		ID:        expense.ID,
@@ -518,6 +600,10 @@ func toDomainExpense(expense dbExpense) Expense {
 		e.ReceiptID = *expense.ReceiptID
 	}
 
+	if expense.AccountID != nil {
+		e.AccountID = *expense.AccountID
+	}
+
 	if expense.Description != nil {
 		e.Description = *expense.Description
 	}
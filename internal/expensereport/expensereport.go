@@ -0,0 +1,281 @@
+// Package expensereport generates the PDF reports of expenses users hand in
+// for reimbursements: a summary of the expenses followed by their receipts.
+package expensereport
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	// Receipts uploaded before their copies were generated may be in any of
+	// the formats phones upload.
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/pkg/pdfgen"
+)
+
+// Like in the settle package, amounts are handled as cents.
+
+type Report struct {
+	Owner string
+	// From is inclusive and To exclusive. Either can be nil.
+	From *time.Time
+	To   *time.Time
+
+	// Lines are the expenses in the report, in the order they're listed.
+	Lines    []Line
+	Receipts []Receipt
+}
+
+type Line struct {
+	ReceiptID   int64
+	Date        time.Time
+	Vendor      string
+	Category    string
+	Description string
+	Amount      int64
+}
+
+// Receipt is the proof of some lines of the report. Its amount is the total of
+// those lines, which may be only some of the expenses of the receipt.
+type Receipt struct {
+	ID     int64
+	Vendor string
+	Date   time.Time
+	Amount int64
+	// File is the large copy of the receipt when it's an image, or the
+	// original file otherwise.
+	File []byte
+}
+
+// Total adds up the amount of every line.
+func (r *Report) Total() int64 {
+	var total int64
+	for _, l := range r.Lines {
+		total += l.Amount
+	}
+
+	return total
+}
+
+type CategoryTotal struct {
+	Category string
+	Amount   int64
+}
+
+// CategoryTotals adds up the lines of each category, sorted by category.
+func (r *Report) CategoryTotals() []CategoryTotal {
+	totals := map[string]int64{}
+	for _, l := range r.Lines {
+		totals[category(l)] += l.Amount
+	}
+
+	out := make([]CategoryTotal, 0, len(totals))
+	for c, amount := range totals {
+		out = append(out, CategoryTotal{Category: c, Amount: amount})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Category < out[j].Category })
+	return out
+}
+
+func category(l Line) string {
+	if l.Category == "" {
+		return "Uncategorised"
+	}
+
+	return l.Category
+}
+
+const (
+	margin     = 40.0
+	rowHeight  = 16.0
+	fontSize   = 9.0
+	headerSize = 16.0
+)
+
+// The columns of the summary table: their left edge, but for the amount which
+// is aligned to the right.
+const (
+	dateColumn        = margin
+	receiptColumn     = margin + 60
+	vendorColumn      = margin + 110
+	categoryColumn    = margin + 250
+	descriptionColumn = margin + 350
+	amountColumn      = pdfgen.PageWidth - margin
+)
+
+// Render writes the report as a PDF: the summary table of the lines and the
+// totals per category, followed by a page per receipt. Receipts which aren't
+// images are attached to the document instead, or printed when they're text.
+func Render(w io.Writer, r Report) error {
+	doc := pdfgen.New()
+
+	page := doc.AddPage()
+	y := pdfgen.PageHeight - margin - headerSize
+	page.Text(margin, y, headerSize, true, "Expense report")
+
+	y -= rowHeight * 1.5
+	page.Text(margin, y, fontSize, false, fmt.Sprintf("%s, %s", r.Owner, period(r.From, r.To)))
+
+	y -= rowHeight * 2
+	tableHeader(page, y)
+
+	for _, l := range r.Lines {
+		y -= rowHeight
+		if y < margin {
+			page = doc.AddPage()
+			y = pdfgen.PageHeight - margin - rowHeight
+			tableHeader(page, y)
+			y -= rowHeight
+		}
+
+		page.Text(dateColumn, y, fontSize, false, l.Date.Format("2006-01-02"))
+		page.Text(receiptColumn, y, fontSize, false, fmt.Sprintf("#%d", l.ReceiptID))
+		page.Text(vendorColumn, y, fontSize, false, pdfgen.Truncate(l.Vendor, fontSize, false, categoryColumn-vendorColumn-10))
+		page.Text(categoryColumn, y, fontSize, false, pdfgen.Truncate(category(l), fontSize, false, descriptionColumn-categoryColumn-10))
+		page.Text(descriptionColumn, y, fontSize, false, pdfgen.Truncate(l.Description, fontSize, false, amountColumn-descriptionColumn-60))
+		page.TextRight(amountColumn, y, fontSize, false, FormatAmount(l.Amount))
+	}
+
+	totals := r.CategoryTotals()
+
+	// The totals aren't split across pages.
+	y -= rowHeight * 2
+	if y-rowHeight*float64(len(totals)+3) < margin {
+		page = doc.AddPage()
+		y = pdfgen.PageHeight - margin - rowHeight
+	}
+
+	page.Text(margin, y, fontSize+2, true, "Totals per category")
+	y -= rowHeight * 0.5
+	page.Line(margin, y, amountColumn, y)
+
+	for _, t := range totals {
+		y -= rowHeight
+		page.Text(margin, y, fontSize, false, t.Category)
+		page.TextRight(amountColumn, y, fontSize, false, FormatAmount(t.Amount))
+	}
+
+	y -= rowHeight * 0.5
+	page.Line(margin, y, amountColumn, y)
+	y -= rowHeight
+	page.Text(margin, y, fontSize, true, "Total")
+	page.TextRight(amountColumn, y, fontSize, true, FormatAmount(r.Total()))
+
+	for _, rcpt := range r.Receipts {
+		err := receiptPage(doc, rcpt)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := doc.WriteTo(w)
+	if err != nil {
+		return fmt.Errorf("write pdf: %w", err)
+	}
+
+	return nil
+}
+
+func tableHeader(page *pdfgen.Page, y float64) {
+	page.Text(dateColumn, y, fontSize, true, "Date")
+	page.Text(receiptColumn, y, fontSize, true, "Receipt")
+	page.Text(vendorColumn, y, fontSize, true, "Vendor")
+	page.Text(categoryColumn, y, fontSize, true, "Category")
+	page.Text(descriptionColumn, y, fontSize, true, "Description")
+	page.TextRight(amountColumn, y, fontSize, true, "Amount")
+	page.Line(margin, y-rowHeight*0.4, amountColumn, y-rowHeight*0.4)
+}
+
+func receiptPage(doc *pdfgen.Document, r Receipt) error {
+	page := doc.AddPage()
+
+	y := pdfgen.PageHeight - margin - headerSize
+	page.Text(margin, y, headerSize, true, fmt.Sprintf("Receipt #%d", r.ID))
+	page.TextRight(amountColumn, y, headerSize, true, FormatAmount(r.Amount))
+
+	y -= rowHeight * 1.5
+	page.Text(margin, y, fontSize, false, fmt.Sprintf("%s, %s", r.Date.Format("2006-01-02"), r.Vendor))
+
+	// The rest of the page is for the receipt itself.
+	top := y - rowHeight
+
+	mimeType := receipt.DetectMIMEType(r.File)
+	switch {
+	case mimeType == "image/jpeg":
+		err := page.JPEG(r.File, margin, margin, pdfgen.PageWidth-2*margin, top-margin)
+		if err == nil {
+			return nil
+		}
+
+	case strings.HasPrefix(mimeType, "image/"):
+		img, _, err := image.Decode(bytes.NewReader(r.File))
+		if err != nil {
+			break
+		}
+
+		var buf bytes.Buffer
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+		if err != nil {
+			break
+		}
+
+		err = page.JPEG(buf.Bytes(), margin, margin, pdfgen.PageWidth-2*margin, top-margin)
+		if err == nil {
+			return nil
+		}
+
+	case mimeType == "application/pdf":
+		name := fmt.Sprintf("receipt-%d.pdf", r.ID)
+		doc.Attach(name, mimeType, r.File)
+		page.Text(margin, top, fontSize, false, fmt.Sprintf("The receipt is a PDF document, attached to this report as %s.", name))
+		return nil
+
+	case strings.HasPrefix(mimeType, "text/plain"):
+		for _, line := range strings.Split(string(r.File), "\n") {
+			top -= rowHeight * 0.8
+			if top < margin {
+				break
+			}
+
+			page.Text(margin, top, fontSize, false, pdfgen.Truncate(strings.TrimRight(line, "\r"), fontSize, false, pdfgen.PageWidth-2*margin))
+		}
+
+		return nil
+	}
+
+	page.Text(margin, top, fontSize, false, "The file of the receipt can't be shown.")
+	return nil
+}
+
+func period(from, to *time.Time) string {
+	switch {
+	case from != nil && to != nil:
+		// To is exclusive, but people read periods as inclusive.
+		return fmt.Sprintf("from %s to %s", from.Format("2006-01-02"), to.AddDate(0, 0, -1).Format("2006-01-02"))
+	case from != nil:
+		return fmt.Sprintf("since %s", from.Format("2006-01-02"))
+	case to != nil:
+		return fmt.Sprintf("until %s", to.AddDate(0, 0, -1).Format("2006-01-02"))
+	default:
+		return "all time"
+	}
+}
+
+// FormatAmount writes the cents in euros, like "12.50 €".
+func FormatAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d €", sign, cents/100, cents%100)
+}
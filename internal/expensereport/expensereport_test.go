@@ -0,0 +1,127 @@
+package expensereport_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/martoche/pdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/expensereport"
+)
+
+func date(day int) time.Time {
+	return time.Date(2024, time.May, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCategoryTotals(t *testing.T) {
+	report := expensereport.Report{
+		Lines: []expensereport.Line{
+			{Category: "Travel", Amount: 1000},
+			{Category: "Food", Amount: 250},
+			{Category: "Travel", Amount: 500},
+			{Amount: 100},
+		},
+	}
+
+	assert.Equal(t, []expensereport.CategoryTotal{
+		{Category: "Food", Amount: 250},
+		{Category: "Travel", Amount: 1500},
+		{Category: "Uncategorised", Amount: 100},
+	}, report.CategoryTotals())
+
+	assert.EqualValues(t, 1850, report.Total())
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "12.50 €", expensereport.FormatAmount(1250))
+	assert.Equal(t, "0.05 €", expensereport.FormatAmount(5))
+	assert.Equal(t, "-3.00 €", expensereport.FormatAmount(-300))
+}
+
+func TestRender(t *testing.T) {
+	from, to := date(1), date(16)
+
+	report := expensereport.Report{
+		Owner: "foo@email.com",
+		From:  &from,
+		To:    &to,
+		Lines: []expensereport.Line{
+			{ReceiptID: 1, Date: date(2), Vendor: "Renfe", Category: "Travel", Description: "Train to Valencia", Amount: 3540},
+			{ReceiptID: 2, Date: date(3), Vendor: "Bar Manolo", Category: "Food", Description: "Lunch with a client", Amount: 2200},
+			{ReceiptID: 3, Date: date(4), Vendor: "Office Supplies", Category: "Office", Description: "Paper", Amount: 999},
+			{ReceiptID: 4, Date: date(5), Vendor: "Mercado", Category: "Food", Description: "Snacks", Amount: 450},
+		},
+		Receipts: []expensereport.Receipt{
+			{ID: 1, Vendor: "Renfe", Date: date(2), Amount: 3540, File: encodeJPEG(t)},
+			{ID: 2, Vendor: "Bar Manolo", Date: date(3), Amount: 2200, File: []byte("BAR MANOLO\nTOTAL 22.00")},
+			{ID: 3, Vendor: "Office Supplies", Date: date(4), Amount: 999, File: []byte("%PDF-1.4 some invoice")},
+			{ID: 4, Vendor: "Mercado", Date: date(5), Amount: 450, File: encodePNG(t)},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := expensereport.Render(&buf, report)
+	require.NoError(t, err)
+
+	r, err := pdf.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	// The summary and a page per receipt.
+	assert.Equal(t, 5, r.NumPage())
+
+	reader, err := r.GetPlainText()
+	require.NoError(t, err)
+
+	text, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	for _, s := range []string{
+		"Expense report",
+		"from 2024-05-01 to 2024-05-15",
+		"Train to Valencia",
+		"Totals per category",
+		"26.50",
+		"71.89",
+		"Receipt #2",
+		"TOTAL 22.00",
+		"attached to this report as receipt-3.pdf",
+	} {
+		assert.Contains(t, string(text), s)
+	}
+
+	// PDF receipts are attached as they are.
+	files := r.Trailer().Key("Root").Key("Names").Key("EmbeddedFiles").Key("Names")
+	require.Equal(t, 2, files.Len())
+	assert.Equal(t, "receipt-3.pdf", files.Index(0).Text())
+
+	attached, err := io.ReadAll(files.Index(1).Key("EF").Key("F").Reader())
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4 some invoice", string(attached))
+}
+
+func testImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 60, 120))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	return img
+}
+
+func encodeJPEG(t *testing.T) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, testImage(), nil))
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, testImage()))
+	return buf.Bytes()
+}
@@ -0,0 +1,163 @@
+package expensereport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/internal/receipt"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// MaxReceipts is the most receipts a report can have, since all their files
+// are held in memory to generate it.
+const MaxReceipts = 100
+
+var ErrTooManyReceipts = fmt.Errorf("reports can't have more than %d receipts", MaxReceipts)
+
+// Query picks the expenses of the report. Every filter is optional.
+type Query struct {
+	Email string
+
+	// From is inclusive and To exclusive.
+	From *time.Time
+	To   *time.Time
+
+	// Category and Subcategory only keep the expenses which have them.
+	Category    string
+	Subcategory string
+}
+
+type Repository struct {
+	dbx      *sqlx.DB
+	receipts *receipt.Repository
+}
+
+func NewRepository(dbx *sqlx.DB, blobs blob.Store) *Repository {
+	return &Repository{dbx: dbx, receipts: receipt.NewRepository(dbx, blobs)}
+}
+
+// Build loads the report of the expenses of the ledger of the user, their
+// household's included, which match the query.
+// Only expenses with a receipt are reported, since they're what proves them,
+// and archived receipts are left out like in the listings.
+func (r *Repository) Build(ctx context.Context, q Query) (*Report, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Build Expense Report")
+	defer span.End()
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"expenses.receipt_id",
+			"expenses.expense_date",
+			"COALESCE(receipts.vendor, '') AS vendor",
+			"COALESCE(expenses.category, '') AS category",
+			"COALESCE(expenses.description, '') AS description",
+			"expenses.amount",
+			"receipts.receipt_date",
+		).
+		From("expenses").
+		Join("receipts ON receipts.id = expenses.receipt_id").
+		Where(household.ColumnVisibleTo("expenses.user_email", q.Email)).
+		Where(sq.NotEq{"receipts.status": receipt.StatusArchived}).
+		OrderBy("expenses.expense_date", "expenses.receipt_id", "expenses.id")
+
+	if q.From != nil {
+		builder = builder.Where(sq.GtOrEq{"expenses.expense_date": *q.From})
+	}
+
+	if q.To != nil {
+		builder = builder.Where(sq.Lt{"expenses.expense_date": *q.To})
+	}
+
+	if q.Category != "" {
+		builder = builder.Where(sq.Eq{"expenses.category": q.Category})
+	}
+
+	if q.Subcategory != "" {
+		builder = builder.Where(sq.Eq{"expenses.sub_category": q.Subcategory})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var rows []struct {
+		ReceiptID   int64     `db:"receipt_id"`
+		Date        time.Time `db:"expense_date"`
+		Vendor      string    `db:"vendor"`
+		Category    string    `db:"category"`
+		Description string    `db:"description"`
+		Amount      int64     `db:"amount"`
+		ReceiptDate time.Time `db:"receipt_date"`
+	}
+
+	err = r.dbx.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select expenses: %w", err)
+	}
+
+	report := Report{Owner: q.Email, From: q.From, To: q.To, Lines: make([]Line, len(rows))}
+
+	receipts := map[int64]int{}
+	for i, row := range rows {
+		report.Lines[i] = Line{
+			ReceiptID:   row.ReceiptID,
+			Date:        row.Date,
+			Vendor:      row.Vendor,
+			Category:    row.Category,
+			Description: row.Description,
+			Amount:      row.Amount,
+		}
+
+		j, ok := receipts[row.ReceiptID]
+		if !ok {
+			report.Receipts = append(report.Receipts, Receipt{ID: row.ReceiptID, Vendor: row.Vendor, Date: row.ReceiptDate})
+			j = len(report.Receipts) - 1
+			receipts[row.ReceiptID] = j
+		}
+
+		report.Receipts[j].Amount += row.Amount
+	}
+
+	if len(report.Receipts) > MaxReceipts {
+		return nil, ErrTooManyReceipts
+	}
+
+	for i, rcpt := range report.Receipts {
+		file, err := r.file(ctx, rcpt.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get file of receipt %d: %w", rcpt.ID, err)
+		}
+
+		report.Receipts[i].File = file
+	}
+
+	return &report, nil
+}
+
+// file returns the large copy of the receipt, which is smaller than the
+// original and upright, or the original when it isn't an image.
+func (r *Repository) file(ctx context.Context, receiptID int64) ([]byte, error) {
+	key, err := r.receipts.GetReceiptImageKey(ctx, uint64(receiptID), receipt.ImageLarge)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return r.receipts.GetReceiptImage(ctx, uint64(receiptID))
+	}
+
+	file, err := r.receipts.GetImage(ctx, key)
+	if err != nil && errors.Is(err, blob.ErrNotFound) {
+		// The report is still useful without it.
+		return nil, nil
+	}
+
+	return file, err
+}
@@ -0,0 +1,496 @@
+package household
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/pkg/xsql"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleReadWrite Role = "read_write"
+	RoleRead      Role = "read"
+)
+
+// CanWrite reports whether members with the role can modify the records of
+// other members of the household.
+func (r Role) CanWrite() bool {
+	return r == RoleOwner || r == RoleReadWrite
+}
+
+type InviteStatus string
+
+const (
+	InviteStatusPending  InviteStatus = "pending"
+	InviteStatusAccepted InviteStatus = "accepted"
+	InviteStatusDeclined InviteStatus = "declined"
+)
+
+type Access int
+
+const (
+	AccessRead Access = iota
+	AccessWrite
+)
+
+type Household struct {
+	ID         uint64    `db:"id"`
+	Name       string    `db:"name"`
+	OwnerEmail string    `db:"owner_email"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type Member struct {
+	HouseholdID uint64    `db:"household_id"`
+	UserEmail   string    `db:"user_email"`
+	Role        Role      `db:"member_role"`
+	JoinedAt    time.Time `db:"created_at"`
+}
+
+type Invite struct {
+	ID           uint64       `db:"id"`
+	HouseholdID  uint64       `db:"household_id"`
+	InviterEmail string       `db:"inviter_email"`
+	InviteeEmail string       `db:"invitee_email"`
+	Role         Role         `db:"member_role"`
+	Status       InviteStatus `db:"invite_status"`
+	CreatedAt    time.Time    `db:"created_at"`
+}
+
+// VisibleTo is the condition which matches the records of the user along with
+// the records of the rest of members of their household.
+func VisibleTo(email string) sq.Sqlizer {
+	return ColumnVisibleTo("user_email", email)
+}
+
+// ColumnVisibleTo is like VisibleTo for queries which join several tables
+// with a user_email column, where the column has to be qualified, like
+// "expenses.user_email".
+func ColumnVisibleTo(column, email string) sq.Sqlizer {
+	return sq.Or{
+		sq.Eq{column: email},
+		sq.Expr(column+` IN (
+			SELECT them.user_email
+			FROM household_members them
+			JOIN household_members me ON me.household_id = them.household_id
+			WHERE me.user_email = ?)`, email),
+	}
+}
+
+var (
+	memberColumns = []string{"household_id", "user_email", "member_role", "created_at"}
+	inviteColumns = []string{"id", "household_id", "inviter_email", "invitee_email", "member_role", "invite_status", "created_at"}
+)
+
+type Repository struct {
+	dbx *sqlx.DB
+}
+
+func NewRepository(dbx *sqlx.DB) *Repository {
+	return &Repository{dbx: dbx}
+}
+
+// CreateHousehold creates a new household with the given user as its owner.
+func (r *Repository) CreateHousehold(ctx context.Context, name, ownerEmail string) (*Household, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Create Household")
+	defer span.End()
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Insert("households").
+		Columns("name", "owner_email").
+		Values(name, ownerEmail).
+		Suffix("RETURNING id, name, owner_email, created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var h Household
+	err = txn.GetContext(ctx, &h, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("insert household: %w", err)
+	}
+
+	err = insertMember(ctx, txn, h.ID, ownerEmail, RoleOwner)
+	if err != nil {
+		return nil, fmt.Errorf("insert owner: %w", err)
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &h, nil
+}
+
+func (r *Repository) FindHousehold(ctx context.Context, id uint64) (*Household, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find Household")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "name", "owner_email", "created_at").
+		From("households").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var h Household
+	err = r.dbx.GetContext(ctx, &h, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select household: %w", err)
+	}
+
+	return &h, nil
+}
+
+// DeleteHousehold deletes the household along with its memberships and
+// invites. The records of the members aren't affected.
+func (r *Repository) DeleteHousehold(ctx context.Context, id uint64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Delete Household")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("households").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = r.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete household: %w", err)
+	}
+
+	return nil
+}
+
+// FindMembership returns the membership of the user. The error wraps
+// sql.ErrNoRows when the user doesn't belong to any household.
+func (r *Repository) FindMembership(ctx context.Context, email string) (*Member, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find Household Membership")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(memberColumns...).
+		From("household_members").
+		Where(sq.Eq{"user_email": email}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var m Member
+	err = r.dbx.GetContext(ctx, &m, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select membership: %w", err)
+	}
+
+	return &m, nil
+}
+
+func (r *Repository) ListMembers(ctx context.Context, householdID uint64) ([]Member, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Household Members")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(memberColumns...).
+		From("household_members").
+		Where(sq.Eq{"household_id": householdID}).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var members []Member
+	err = r.dbx.SelectContext(ctx, &members, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select members: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *Repository) UpdateMemberRole(ctx context.Context, householdID uint64, email string, role Role) error {
+	ctx, span := xtrace.StartSpan(ctx, "Update Household Member Role")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("household_members").
+		Set("member_role", string(role)).
+		Where(sq.Eq{"household_id": householdID, "user_email": email}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = r.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update member: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveMember is used both for a member leaving the household and for the
+// owner kicking somebody out of it.
+func (r *Repository) RemoveMember(ctx context.Context, householdID uint64, email string) error {
+	ctx, span := xtrace.StartSpan(ctx, "Remove Household Member")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("household_members").
+		Where(sq.Eq{"household_id": householdID, "user_email": email}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = r.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete member: %w", err)
+	}
+
+	return nil
+}
+
+// LedgerMembers returns the emails of everybody sharing the ledger with the
+// user, the user included.
+func (r *Repository) LedgerMembers(ctx context.Context, email string) ([]string, error) {
+	m, err := r.FindMembership(ctx, email)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return []string{email}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	members, err := r.ListMembers(ctx, m.HouseholdID)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, len(members))
+	for i, member := range members {
+		emails[i] = member.UserEmail
+	}
+
+	return emails, nil
+}
+
+type CreateInviteRequest struct {
+	HouseholdID  uint64
+	InviterEmail string
+	InviteeEmail string
+	Role         Role
+}
+
+func (r *Repository) CreateInvite(ctx context.Context, input CreateInviteRequest) (*Invite, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Create Household Invite")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Insert("household_invites").
+		Columns("household_id", "inviter_email", "invitee_email", "member_role").
+		Values(input.HouseholdID, input.InviterEmail, strings.ToLower(input.InviteeEmail), string(input.Role)).
+		Suffix("RETURNING " + strings.Join(inviteColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var invite Invite
+	err = r.dbx.GetContext(ctx, &invite, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return &invite, nil
+}
+
+func (r *Repository) FindInvite(ctx context.Context, id uint64) (*Invite, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find Household Invite")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(inviteColumns...).
+		From("household_invites").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var invite Invite
+	err = r.dbx.GetContext(ctx, &invite, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select invite: %w", err)
+	}
+
+	return &invite, nil
+}
+
+// ListPendingInvites lists the invites the user hasn't accepted nor declined
+// yet.
+func (r *Repository) ListPendingInvites(ctx context.Context, inviteeEmail string) ([]Invite, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Pending Household Invites")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(inviteColumns...).
+		From("household_invites").
+		Where(sq.Eq{"invitee_email": strings.ToLower(inviteeEmail), "invite_status": string(InviteStatusPending)}).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var invites []Invite
+	err = r.dbx.SelectContext(ctx, &invites, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+// AcceptInvite marks the invite as accepted and adds the invitee to the
+// household with the role of the invite.
+func (r *Repository) AcceptInvite(ctx context.Context, invite *Invite, email string) error {
+	ctx, span := xtrace.StartSpan(ctx, "Accept Household Invite")
+	defer span.End()
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("household_invites").
+		Set("invite_status", string(InviteStatusAccepted)).
+		Where(sq.Eq{"id": invite.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update invite: %w", err)
+	}
+
+	err = insertMember(ctx, txn, invite.HouseholdID, email, invite.Role)
+	if err != nil {
+		return fmt.Errorf("insert member: %w", err)
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeclineInvite(ctx context.Context, id uint64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Decline Household Invite")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("household_invites").
+		Set("invite_status", string(InviteStatusDeclined)).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = r.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update invite: %w", err)
+	}
+
+	return nil
+}
+
+// Authorize reports whether the user can access the records of the owner.
+// Users always have full access to their own records. Members of the same
+// household can read each other's records, but only those with write role can
+// modify them.
+func (r *Repository) Authorize(ctx context.Context, user, owner string, access Access) (bool, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Authorize Ledger Access")
+	defer span.End()
+
+	if user == "" {
+		return false, nil
+	}
+
+	if strings.EqualFold(user, owner) {
+		return true, nil
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("me.member_role").
+		From("household_members me").
+		Join("household_members them ON them.household_id = me.household_id").
+		Where(sq.Eq{"me.user_email": user, "them.user_email": owner}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var role Role
+	err = r.dbx.GetContext(ctx, &role, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("select role: %w", err)
+	}
+
+	if access == AccessWrite {
+		return role.CanWrite(), nil
+	}
+
+	return true, nil
+}
+
+func insertMember(ctx context.Context, txn *sqlx.Tx, householdID uint64, email string, role Role) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("household_members").
+		Columns("household_id", "user_email", "member_role").
+		Values(householdID, email, string(role)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,91 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const orientationTag = 0x0112
+
+// orientation reads the EXIF orientation of a JPEG, from 1 to 8. Anything
+// without one, or with an invalid one, is considered upright.
+func orientation(data []byte) int {
+	tiff := exifSegment(data)
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	if order.Uint16(tiff[2:4]) != 42 {
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 1
+	}
+
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+
+		if order.Uint16(tiff[entry:entry+2]) != orientationTag {
+			continue
+		}
+
+		o := int(order.Uint16(tiff[entry+8 : entry+10]))
+		if o < 1 || o > 8 {
+			return 1
+		}
+
+		return o
+	}
+
+	return 1
+}
+
+// exifSegment returns the TIFF structure within the APP1 segment of a JPEG.
+func exifSegment(data []byte) []byte {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return nil
+		}
+
+		marker := data[i+1]
+		// The image data starts at SOS, so there's no metadata past it.
+		if marker == 0xDA {
+			return nil
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return nil
+		}
+
+		segment := data[i+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:]
+		}
+
+		i = end
+	}
+
+	return nil
+}
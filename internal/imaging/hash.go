@@ -0,0 +1,39 @@
+package imaging
+
+import (
+	"image"
+	"math/bits"
+)
+
+// SimilarityThreshold is the highest Distance between the hashes of two images
+// for them to be considered the same picture.
+const SimilarityThreshold = 10
+
+// perceptualHash computes the difference hash of the image: each bit tells
+// whether a pixel is brighter than the one on its right in a 9x8 grayscale
+// copy. Unlike a checksum, it barely changes when the same receipt is
+// photographed again under different light or at a different size.
+func perceptualHash(img *image.RGBA) uint64 {
+	small := scaleTo(img, 9, 8)
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if luminance(small, x, y) > luminance(small, x+1, y) {
+				hash |= 1 << (y*8 + x)
+			}
+		}
+	}
+
+	return hash
+}
+
+func luminance(img *image.RGBA, x, y int) int {
+	i := img.PixOffset(x, y)
+	return 299*int(img.Pix[i]) + 587*int(img.Pix[i+1]) + 114*int(img.Pix[i+2])
+}
+
+// Distance is the amount of bits which differ between two perceptual hashes.
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,101 @@
+// Package imaging normalises the photos of receipts users upload: it fixes
+// their orientation and generates smaller copies for parsing and listing.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+
+	// Decoders for the formats phones usually upload.
+	_ "image/gif"
+	_ "image/png"
+)
+
+// ErrUnsupported is returned for files which can't be decoded as images, like
+// PDFs. They should be used as they are.
+var ErrUnsupported = errors.New("unsupported image format")
+
+// ErrCorrupted is returned for images whose header can be read but not their
+// contents, like those cut short.
+var ErrCorrupted = errors.New("corrupted image")
+
+const (
+	// LargeMaxSide is the longest side of the copy sent for parsing. It's
+	// plenty to read a receipt while keeping uploads to the parser small.
+	LargeMaxSide = 2048
+	// ThumbnailMaxSide is the longest side of the thumbnails in listings.
+	ThumbnailMaxSide = 320
+
+	// maxPixels guards against decompression bombs.
+	maxPixels = 50_000_000
+
+	largeQuality     = 85
+	thumbnailQuality = 75
+)
+
+// Processed holds the copies generated for an image. Both are JPEGs.
+type Processed struct {
+	// Large is the image upright and no bigger than LargeMaxSide.
+	Large []byte
+	// Thumbnail is the image upright and no bigger than ThumbnailMaxSide.
+	Thumbnail []byte
+	// Hash is the perceptual hash of the image, see Distance.
+	Hash uint64
+}
+
+// Process decodes the image, applies its EXIF orientation and generates the
+// copies. Files which aren't images return ErrUnsupported.
+func Process(data []byte) (*Processed, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("image of %dx%d is too big", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorrupted, err)
+	}
+
+	upright := orient(flatten(img), orientation(data))
+
+	large, err := encode(resize(upright, LargeMaxSide), largeQuality)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnail, err := encode(resize(upright, ThumbnailMaxSide), thumbnailQuality)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Processed{Large: large, Thumbnail: thumbnail, Hash: perceptualHash(upright)}, nil
+}
+
+// flatten copies the image into an RGBA over a white background, since JPEG
+// has no transparency and it would otherwise turn black.
+func flatten(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Over)
+	return dst
+}
+
+func encode(img image.Image, quality int) ([]byte, error) {
+	var b bytes.Buffer
+	err := jpeg.Encode(&b, img, &jpeg.Options{Quality: quality})
+	if err != nil {
+		return nil, fmt.Errorf("encode jpeg: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,188 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/imaging"
+)
+
+func TestProcess(t *testing.T) {
+	t.Run("files which aren't images are unsupported", func(t *testing.T) {
+		_, err := imaging.Process([]byte("%PDF-1.4 some receipt"))
+		require.ErrorIs(t, err, imaging.ErrUnsupported)
+	})
+
+	t.Run("large images are downscaled keeping their aspect ratio", func(t *testing.T) {
+		processed, err := imaging.Process(encodePNG(t, halves(4000, 1000, color.RGBA{R: 255, A: 255})))
+		require.NoError(t, err)
+
+		assert.Equal(t, image.Pt(2048, 512), decode(t, processed.Large).Bounds().Size())
+		assert.Equal(t, image.Pt(320, 80), decode(t, processed.Thumbnail).Bounds().Size())
+	})
+
+	t.Run("small images keep their size", func(t *testing.T) {
+		processed, err := imaging.Process(encodePNG(t, halves(200, 100, color.RGBA{R: 255, A: 255})))
+		require.NoError(t, err)
+
+		assert.Equal(t, image.Pt(200, 100), decode(t, processed.Large).Bounds().Size())
+		assert.Equal(t, image.Pt(200, 100), decode(t, processed.Thumbnail).Bounds().Size())
+	})
+
+	t.Run("images are rotated according to their EXIF orientation", func(t *testing.T) {
+		// The left half is red, so once rotated clockwise it should be on top.
+		data := withOrientation(t, encodeJPEG(t, halves(400, 200, color.RGBA{R: 255, A: 255})), 6)
+
+		processed, err := imaging.Process(data)
+		require.NoError(t, err)
+
+		img := decode(t, processed.Large)
+		require.Equal(t, image.Pt(200, 400), img.Bounds().Size())
+		assertRed(t, img.At(100, 50))
+		assertNotRed(t, img.At(100, 350))
+	})
+
+	t.Run("counter-clockwise orientations are rotated the other way", func(t *testing.T) {
+		data := withOrientation(t, encodeJPEG(t, halves(400, 200, color.RGBA{R: 255, A: 255})), 8)
+
+		processed, err := imaging.Process(data)
+		require.NoError(t, err)
+
+		img := decode(t, processed.Large)
+		require.Equal(t, image.Pt(200, 400), img.Bounds().Size())
+		assertNotRed(t, img.At(100, 50))
+		assertRed(t, img.At(100, 350))
+	})
+
+	t.Run("transparency is flattened onto white", func(t *testing.T) {
+		processed, err := imaging.Process(encodePNG(t, image.NewRGBA(image.Rect(0, 0, 10, 10))))
+		require.NoError(t, err)
+
+		r, g, b, _ := decode(t, processed.Thumbnail).At(5, 5).RGBA()
+		assert.Greater(t, r>>8, uint32(240))
+		assert.Greater(t, g>>8, uint32(240))
+		assert.Greater(t, b>>8, uint32(240))
+	})
+}
+
+// halves returns an image with the left half painted in the colour and the
+// right half in white.
+func halves(w, h int, left color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 {
+				img.SetRGBA(x, y, left)
+			} else {
+				img.SetRGBA(x, y, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+			}
+		}
+	}
+
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var b bytes.Buffer
+	require.NoError(t, png.Encode(&b, img))
+	return b.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var b bytes.Buffer
+	require.NoError(t, jpeg.Encode(&b, img, nil))
+	return b.Bytes()
+}
+
+// withOrientation inserts an EXIF segment with the orientation right after the
+// start of the JPEG, like cameras do.
+func withOrientation(t *testing.T, data []byte, orientation uint16) []byte {
+	require.Equal(t, []byte{0xFF, 0xD8}, data[:2])
+
+	var tiff bytes.Buffer
+	tiff.WriteString("II")
+	_ = binary.Write(&tiff, binary.LittleEndian, uint16(42))
+	_ = binary.Write(&tiff, binary.LittleEndian, uint32(8))
+	_ = binary.Write(&tiff, binary.LittleEndian, uint16(1))
+	_ = binary.Write(&tiff, binary.LittleEndian, []uint16{0x0112, 3})
+	_ = binary.Write(&tiff, binary.LittleEndian, uint32(1))
+	_ = binary.Write(&tiff, binary.LittleEndian, []uint16{orientation, 0})
+	_ = binary.Write(&tiff, binary.LittleEndian, uint32(0))
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+
+	var out bytes.Buffer
+	out.Write(data[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	_ = binary.Write(&out, binary.BigEndian, uint16(len(segment)+2))
+	out.Write(segment)
+	out.Write(data[2:])
+
+	return out.Bytes()
+}
+
+func decode(t *testing.T, data []byte) image.Image {
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	return img
+}
+
+func assertRed(t *testing.T, c color.Color) {
+	r, g, _, _ := c.RGBA()
+	assert.Greater(t, r>>8, uint32(200))
+	assert.Less(t, g>>8, uint32(60))
+}
+
+func assertNotRed(t *testing.T, c color.Color) {
+	_, g, _, _ := c.RGBA()
+	assert.Greater(t, g>>8, uint32(200))
+}
+
+func TestPerceptualHash(t *testing.T) {
+	// receipt paints a grid of random grey blocks, so the same seed is the
+	// same picture regardless of its size.
+	receipt := func(w, h int, seed int64) *image.RGBA {
+		rnd := rand.New(rand.NewSource(seed))
+
+		blocks := make([]uint8, 16*16)
+		for i := range blocks {
+			blocks[i] = uint8(rnd.Intn(256))
+		}
+
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				v := blocks[(y*16/h)*16+x*16/w]
+				img.SetRGBA(x, y, color.RGBA{R: v, G: v, B: v, A: 255})
+			}
+		}
+		return img
+	}
+
+	original, err := imaging.Process(encodePNG(t, receipt(600, 1200, 1)))
+	require.NoError(t, err)
+
+	rephotographed, err := imaging.Process(encodeJPEG(t, receipt(450, 900, 1)))
+	require.NoError(t, err)
+
+	different, err := imaging.Process(encodePNG(t, receipt(600, 1200, 2)))
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, imaging.Distance(original.Hash, rephotographed.Hash), imaging.SimilarityThreshold)
+	assert.Greater(t, imaging.Distance(original.Hash, different.Hash), imaging.SimilarityThreshold)
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, imaging.Distance(0b1011, 0b1011))
+	assert.Equal(t, 2, imaging.Distance(0b1011, 0b1110))
+	assert.Equal(t, 64, imaging.Distance(0, ^uint64(0)))
+}
@@ -0,0 +1,103 @@
+package imaging
+
+import (
+	"image"
+	"math"
+)
+
+// orient transforms the image so that it's upright according to its EXIF
+// orientation.
+func orient(src *image.RGBA, o int) *image.RGBA {
+	if o <= 1 || o > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+
+	dw, dh := w, h
+	if o >= 5 {
+		// Orientations from 5 onwards swap the sides.
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for sy := 0; sy < h; sy++ {
+		for sx := 0; sx < w; sx++ {
+			var dx, dy int
+			switch o {
+			case 2: // Mirrored horizontally.
+				dx, dy = w-1-sx, sy
+			case 3: // Rotated 180°.
+				dx, dy = w-1-sx, h-1-sy
+			case 4: // Mirrored vertically.
+				dx, dy = sx, h-1-sy
+			case 5: // Transposed.
+				dx, dy = sy, sx
+			case 6: // Needs rotating 90° clockwise.
+				dx, dy = h-1-sy, sx
+			case 7: // Transversed.
+				dx, dy = h-1-sy, w-1-sx
+			case 8: // Needs rotating 90° counter-clockwise.
+				dx, dy = sy, w-1-sx
+			}
+
+			si := src.PixOffset(sx, sy)
+			di := dst.PixOffset(dx, dy)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+
+	return dst
+}
+
+// resize scales the image down, keeping its aspect ratio, so that its longest
+// side is at most maxSide. Each pixel is the average of the area of the
+// original it covers, which is enough for downscaling.
+func resize(src *image.RGBA, maxSide int) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	if w <= maxSide && h <= maxSide {
+		return src
+	}
+
+	scale := float64(max(w, h)) / float64(maxSide)
+	dw := max(1, int(math.Round(float64(w)/scale)))
+	dh := max(1, int(math.Round(float64(h)/scale)))
+
+	return scaleTo(src, dw, dh)
+}
+
+// scaleTo scales the image down to exactly dw by dh pixels.
+func scaleTo(src *image.RGBA, dw, dh int) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		y0 := dy * h / dh
+		y1 := max(y0+1, (dy+1)*h/dh)
+
+		for dx := 0; dx < dw; dx++ {
+			x0 := dx * w / dw
+			x1 := max(x0+1, (dx+1)*w/dw)
+
+			var r, g, b, a, n int
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					i := src.PixOffset(x, y)
+					r += int(src.Pix[i])
+					g += int(src.Pix[i+1])
+					b += int(src.Pix[i+2])
+					a += int(src.Pix[i+3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(dx, dy)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
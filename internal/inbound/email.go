@@ -0,0 +1,224 @@
+package inbound
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+)
+
+// recipientHeaders are the headers the inbound address can be found in. The
+// last ones are set by mail servers when the message is forwarded, so that
+// the address of the user isn't lost when it's only in the envelope.
+var recipientHeaders = []string{"To", "Cc", "Delivered-To", "X-Original-To", "X-Forwarded-To"}
+
+// maxParts is the most parts of a message which are read, so that deeply
+// nested messages can't hold the parser forever.
+const maxParts = 100
+
+// Email is a message forwarded to an inbound address.
+type Email struct {
+	From       string
+	Recipients []string
+	Subject    string
+
+	// Attachments are the files attached to the message which can be
+	// receipts: PDFs and images. Images embedded in the body, like logos,
+	// aren't.
+	Attachments []Attachment
+
+	// Text is the body of the message, as plain text. HTML bodies are
+	// rendered to text.
+	Text string
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Receipts returns the files to create receipts from: the attachments or,
+// for e-receipts which are the message itself, its text.
+func (e *Email) Receipts() [][]byte {
+	var files [][]byte
+	for _, a := range e.Attachments {
+		files = append(files, a.Data)
+	}
+
+	// The sender and subject usually tell the vendor, which the body of
+	// e-receipts may not.
+	if len(files) == 0 && strings.TrimSpace(e.Text) != "" {
+		text := fmt.Sprintf("From: %s\nSubject: %s\n\n%s", e.From, e.Subject, e.Text)
+		files = append(files, []byte(text))
+	}
+
+	return files
+}
+
+// Parse reads an RFC 822 message, as sent by inbound mail webhooks or piped
+// from an MTA.
+func Parse(r io.Reader) (*Email, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("read message: %w", err)
+	}
+
+	decoder := mime.WordDecoder{}
+	subject, err := decoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+
+	email := Email{
+		From:    msg.Header.Get("From"),
+		Subject: subject,
+	}
+
+	for _, h := range recipientHeaders {
+		addresses, err := msg.Header.AddressList(h)
+		if err != nil {
+			continue
+		}
+
+		for _, a := range addresses {
+			email.Recipients = append(email.Recipients, a.Address)
+		}
+	}
+
+	p := partReader{email: &email}
+	err = p.read(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), "", "", msg.Body, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// HTML bodies are preferred since e-receipts often have a plain text
+	// version which leaves the amounts out.
+	if p.html != "" {
+		email.Text = RenderHTML(p.html)
+	} else {
+		email.Text = p.text
+	}
+
+	return &email, nil
+}
+
+type partReader struct {
+	email *Email
+	parts int
+
+	html string
+	text string
+}
+
+func (p *partReader) read(contentType, encoding, disposition, contentID string, body io.Reader, related bool) error {
+	p.parts++
+	if p.parts > maxParts {
+		return fmt.Errorf("message has more than %d parts", maxParts)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// RFC 2045 defaults to plain text.
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("read part: %w", err)
+			}
+
+			err = p.read(
+				part.Header.Get("Content-Type"),
+				part.Header.Get("Content-Transfer-Encoding"),
+				part.Header.Get("Content-Disposition"),
+				part.Header.Get("Content-ID"),
+				part,
+				related || mediaType == "multipart/related",
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(decode(encoding, body))
+	if err != nil {
+		return fmt.Errorf("decode %s part: %w", mediaType, err)
+	}
+
+	dispositionType, dispositionParams, _ := mime.ParseMediaType(disposition)
+	isAttachment := dispositionType == "attachment"
+
+	switch {
+	case mediaType == "text/html" && !isAttachment:
+		if p.html == "" {
+			p.html = string(data)
+		}
+	case mediaType == "text/plain" && !isAttachment:
+		if p.text == "" {
+			p.text = string(data)
+		}
+	case mediaType == "message/rfc822":
+		// Messages forwarded as attachments are read as part of this one.
+		forwarded, err := Parse(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+
+		p.email.Attachments = append(p.email.Attachments, forwarded.Attachments...)
+		if p.html == "" && p.text == "" {
+			p.text = forwarded.Text
+		}
+	default:
+		// Images embedded in the body are referenced by their ID, and are
+		// logos and the like rather than receipts.
+		if related && contentID != "" && !isAttachment {
+			return nil
+		}
+
+		// Senders often label every file as application/octet-stream, so
+		// the contents tell what they are.
+		detected := receipt.DetectMIMEType(data)
+		if !slices.Contains(receipt.AllowedMIMETypes, detected) || len(data) > receipt.MaxFileSize {
+			return nil
+		}
+
+		filename := dispositionParams["filename"]
+		if filename == "" {
+			filename = params["name"]
+		}
+
+		p.email.Attachments = append(p.email.Attachments, Attachment{
+			Filename:    filename,
+			ContentType: detected,
+			Data:        data,
+		})
+	}
+
+	return nil
+}
+
+func decode(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	default:
+		return r
+	}
+}
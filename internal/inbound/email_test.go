@@ -0,0 +1,95 @@
+package inbound_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/inbound"
+)
+
+func parseFixture(t *testing.T, name string) *inbound.Email {
+	f, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	defer f.Close()
+
+	email, err := inbound.Parse(f)
+	require.NoError(t, err)
+
+	return email
+}
+
+func TestParse(t *testing.T) {
+	t.Run("PDF attachment", func(t *testing.T) {
+		email := parseFixture(t, "pdf_attachment.eml")
+
+		assert.Equal(t, "Fwd: Your invoice from Acme Hosting", email.Subject)
+		assert.Contains(t, email.Recipients, "receipts+0123456789abcdef0123456789abcdef@in.mcduck.test")
+
+		// The calendar invite isn't a receipt.
+		require.Len(t, email.Attachments, 1)
+		assert.Equal(t, "invoice-2024-05.pdf", email.Attachments[0].Filename)
+		assert.Equal(t, "application/pdf", email.Attachments[0].ContentType)
+		assert.Contains(t, string(email.Attachments[0].Data), "%PDF-1.4")
+
+		receipts := email.Receipts()
+		require.Len(t, receipts, 1)
+		assert.Equal(t, email.Attachments[0].Data, receipts[0])
+	})
+
+	t.Run("HTML e-receipt", func(t *testing.T) {
+		email := parseFixture(t, "html_receipt.eml")
+
+		assert.Equal(t, "Your receipt from Corner Café", email.Subject)
+
+		// The logo is embedded in the body.
+		assert.Empty(t, email.Attachments)
+
+		assert.Contains(t, email.Text, "Order #4521 · 15/05/2024")
+		assert.Contains(t, email.Text, "Flat white 2 6.40 EUR")
+		assert.Contains(t, email.Text, "Total 8.50 EUR")
+		assert.NotContains(t, email.Text, "track()")
+		assert.NotContains(t, email.Text, "padding")
+
+		receipts := email.Receipts()
+		require.Len(t, receipts, 1)
+		assert.Contains(t, string(receipts[0]), "From: Corner Coffee <no-reply@cornercoffee.test>")
+		assert.Contains(t, string(receipts[0]), "Total 8.50 EUR")
+	})
+
+	t.Run("message forwarded as an attachment", func(t *testing.T) {
+		email := parseFixture(t, "forwarded_message.eml")
+
+		assert.Contains(t, email.Recipients, "receipts+0123456789abcdef0123456789abcdef@in.mcduck.test")
+
+		require.Len(t, email.Attachments, 1)
+		assert.Equal(t, "ticket.png", email.Attachments[0].Filename)
+		assert.Equal(t, "image/png", email.Attachments[0].ContentType)
+	})
+}
+
+func TestTokenFromAddress(t *testing.T) {
+	domain := "in.mcduck.test"
+
+	token, ok := inbound.TokenFromAddress(inbound.Address("abc123", domain), domain)
+	assert.True(t, ok)
+	assert.Equal(t, "abc123", token)
+
+	token, ok = inbound.TokenFromAddress(`"Receipts" <Receipts+ABC123@IN.mcduck.test>`, domain)
+	assert.True(t, ok)
+	assert.Equal(t, "abc123", token)
+
+	for _, address := range []string{
+		"receipts+abc123@example.com",
+		"jane+abc123@in.mcduck.test",
+		"receipts+@in.mcduck.test",
+		"receipts@in.mcduck.test",
+		"not an address",
+	} {
+		_, ok := inbound.TokenFromAddress(address, domain)
+		assert.False(t, ok, address)
+	}
+}
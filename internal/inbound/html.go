@@ -0,0 +1,70 @@
+package inbound
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// blockElements start on a new line when rendered.
+var blockElements = map[string]bool{
+	"address": true, "article": true, "blockquote": true, "br": true, "div": true,
+	"footer": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true,
+	"h6": true, "header": true, "hr": true, "li": true, "ol": true, "p": true,
+	"section": true, "table": true, "tbody": true, "thead": true, "tfoot": true,
+	"tr": true, "ul": true,
+}
+
+var (
+	spaces     = regexp.MustCompile(`[ \t\x{a0}]+`)
+	blankLines = regexp.MustCompile(`\n\s*\n+`)
+)
+
+// RenderHTML turns an HTML body into plain text: one line per block and
+// table row, so that items and their prices stay together.
+func RenderHTML(body string) string {
+	doc, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return body
+	}
+
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			b.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			switch n.Data {
+			case "script", "style", "head", "title":
+				return
+			case "td", "th":
+				b.WriteString(" ")
+			}
+
+			if blockElements[n.Data] {
+				b.WriteString("\n")
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+
+		if n.Type == html.ElementNode && blockElements[n.Data] {
+			b.WriteString("\n")
+		}
+	}
+	walk(doc)
+
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(spaces.ReplaceAllString(line, " "))
+	}
+
+	text := strings.Join(lines, "\n")
+	text = blankLines.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
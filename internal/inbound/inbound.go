@@ -0,0 +1,43 @@
+// Package inbound reads the receipts out of the emails users forward to their
+// inbound address.
+package inbound
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// addressPrefix is the local part of every inbound address, before the token
+// of the user.
+const addressPrefix = "receipts+"
+
+// Address is the address the user with the token forwards receipts to.
+func Address(token, domain string) string {
+	return fmt.Sprintf("%s%s@%s", addressPrefix, token, domain)
+}
+
+// TokenFromAddress returns the token of the user the inbound address belongs
+// to. Addresses can include the name, as in email headers.
+func TokenFromAddress(address, domain string) (string, bool) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", false
+	}
+
+	local, host, ok := strings.Cut(parsed.Address, "@")
+	if !ok || !strings.EqualFold(host, domain) {
+		return "", false
+	}
+
+	if len(local) < len(addressPrefix) || !strings.EqualFold(local[:len(addressPrefix)], addressPrefix) {
+		return "", false
+	}
+
+	token := strings.ToLower(local[len(addressPrefix):])
+	if token == "" {
+		return "", false
+	}
+
+	return token, true
+}
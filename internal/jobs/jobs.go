@@ -0,0 +1,304 @@
+// Package jobs is a queue of background jobs stored in Postgres. Workers pull
+// jobs with FOR UPDATE SKIP LOCKED, so any number of them can run at once, and
+// retry failed jobs with exponential backoff until they're dead.
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusRunning Status = "running"
+	StatusDone    Status = "done"
+	// StatusDead is a job which failed all of its attempts. It's kept for
+	// inspection and never retried.
+	StatusDead Status = "dead"
+)
+
+const (
+	DefaultMaxAttempts = 5
+
+	// lease is how long a job can run before it's considered abandoned, like
+	// when its worker crashes, and handed to another worker.
+	lease = 10 * time.Minute
+
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+)
+
+type Job struct {
+	ID          int64
+	Kind        string
+	Payload     json.RawMessage
+	Status      Status
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	LastError   string
+}
+
+// LastAttempt tells whether the job will be dead if the current attempt fails.
+func (j *Job) LastAttempt() bool {
+	return j.Attempts >= j.MaxAttempts
+}
+
+// Decode unmarshals the payload of the job into v.
+func (j *Job) Decode(v any) error {
+	err := json.Unmarshal(j.Payload, v)
+	if err != nil {
+		return fmt.Errorf("decode payload of job %d: %w", j.ID, err)
+	}
+
+	return nil
+}
+
+type dbJob struct {
+	ID          int64      `db:"id"`
+	Kind        string     `db:"kind"`
+	Payload     []byte     `db:"payload"`
+	Status      string     `db:"status"`
+	Attempts    int        `db:"attempts"`
+	MaxAttempts int        `db:"max_attempts"`
+	RunAt       time.Time  `db:"run_at"`
+	LockedAt    *time.Time `db:"locked_at"`
+	LastError   *string    `db:"last_error"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (j *dbJob) MapJob() *Job {
+	var lastError string
+	if j.LastError != nil {
+		lastError = *j.LastError
+	}
+
+	return &Job{
+		ID:          j.ID,
+		Kind:        j.Kind,
+		Payload:     j.Payload,
+		Status:      Status(j.Status),
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		RunAt:       j.RunAt,
+		LastError:   lastError,
+	}
+}
+
+var columns = []string{"id", "kind", "payload", "status", "attempts", "max_attempts", "run_at", "locked_at", "last_error", "created_at", "updated_at"}
+
+// Enqueue adds a job of the kind to the queue. It takes the transaction of the
+// caller so that jobs are only enqueued when the data they act upon is
+// committed.
+func Enqueue(ctx context.Context, db sqlx.ExtContext, kind string, payload any) (int64, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Enqueue Job")
+	defer span.End()
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("encode payload: %w", err)
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("jobs").
+		Columns("kind", "payload", "max_attempts").
+		Values(kind, string(data), DefaultMaxAttempts).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("compile query: %w", err)
+	}
+
+	var id int64
+	err = sqlx.GetContext(ctx, db, &id, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("insert job: %w", err)
+	}
+
+	return id, nil
+}
+
+type Queue struct {
+	dbx *sqlx.DB
+}
+
+func NewQueue(dbx *sqlx.DB) *Queue {
+	return &Queue{dbx: dbx}
+}
+
+// Claim marks the next runnable job of any of the kinds as running and returns
+// it. It returns nil when there's none.
+func (q *Queue) Claim(ctx context.Context, kinds []string) (*Job, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Claim Job")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	next := sq.Select("id").
+		From("jobs").
+		Where(sq.And{
+			sq.Eq{"kind": kinds},
+			sq.Or{
+				sq.And{sq.Eq{"status": StatusPending}, sq.Expr("run_at <= NOW()")},
+				sq.And{sq.Eq{"status": StatusRunning}, sq.Lt{"locked_at": time.Now().Add(-lease)}},
+			},
+		}).
+		OrderBy("run_at ASC").
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := psql.
+		Update("jobs").
+		Set("status", StatusRunning).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("locked_at", sq.Expr("NOW()")).
+		Where(sq.Expr("id = (?)", next)).
+		Suffix("RETURNING " + strings.Join(columns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var job dbJob
+	err = q.dbx.GetContext(ctx, &job, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("claim job: %w", err)
+	}
+
+	return job.MapJob(), nil
+}
+
+// Complete marks the job as done.
+func (q *Queue) Complete(ctx context.Context, id int64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Complete Job")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("jobs").
+		Set("status", StatusDone).
+		Set("locked_at", nil).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile query: %w", err)
+	}
+
+	_, err = q.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update job: %w", err)
+	}
+
+	return nil
+}
+
+// Fail records the cause of the failure and schedules the job to be retried,
+// or marks it dead if it was its last attempt.
+func (q *Queue) Fail(ctx context.Context, job *Job, cause error) error {
+	ctx, span := xtrace.StartSpan(ctx, "Fail Job")
+	defer span.End()
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("jobs").
+		Set("locked_at", nil).
+		Set("last_error", cause.Error()).
+		Where(sq.Eq{"id": job.ID})
+
+	if job.LastAttempt() || IsPermanent(cause) {
+		builder = builder.Set("status", StatusDead)
+	} else {
+		builder = builder.
+			Set("status", StatusPending).
+			Set("run_at", time.Now().Add(Backoff(job.Attempts)))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("compile query: %w", err)
+	}
+
+	_, err = q.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update job: %w", err)
+	}
+
+	return nil
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as a failure which retrying won't fix, so that the job
+// is left dead straight away instead of being retried.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent tells whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// GetJob returns the job with the ID.
+func (q *Queue) GetJob(ctx context.Context, id int64) (*Job, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Job")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(columns...).
+		From("jobs").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var job dbJob
+	err = q.dbx.GetContext(ctx, &job, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select job: %w", err)
+	}
+
+	return job.MapJob(), nil
+}
+
+// Backoff is how long to wait before retrying a job which failed the attempt:
+// it doubles with every attempt, up to an hour.
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	backoff := baseBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+
+	return backoff
+}
@@ -0,0 +1,38 @@
+package jobs_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/manzanit0/mcduck/internal/jobs"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, jobs.Backoff(0))
+	assert.Equal(t, 30*time.Second, jobs.Backoff(1))
+	assert.Equal(t, time.Minute, jobs.Backoff(2))
+	assert.Equal(t, 4*time.Minute, jobs.Backoff(4))
+	assert.Equal(t, time.Hour, jobs.Backoff(8))
+	assert.Equal(t, time.Hour, jobs.Backoff(100))
+}
+
+func TestLastAttempt(t *testing.T) {
+	assert.False(t, (&jobs.Job{Attempts: 1, MaxAttempts: 5}).LastAttempt())
+	assert.True(t, (&jobs.Job{Attempts: 5, MaxAttempts: 5}).LastAttempt())
+}
+
+func TestPermanent(t *testing.T) {
+	cause := errors.New("unsupported file")
+
+	err := fmt.Errorf("parse receipt: %w", jobs.Permanent(cause))
+	assert.True(t, jobs.IsPermanent(err))
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "parse receipt: unsupported file", err.Error())
+
+	assert.False(t, jobs.IsPermanent(cause))
+	assert.NoError(t, jobs.Permanent(nil))
+}
@@ -0,0 +1,124 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Handler runs a job. Returning an error schedules it to be retried, unless
+// it was the job's last attempt, see Job.LastAttempt, or the error is
+// Permanent.
+type Handler func(ctx context.Context, job *Job) error
+
+const (
+	defaultPollInterval = 2 * time.Second
+	defaultTimeout      = 5 * time.Minute
+)
+
+type Worker struct {
+	queue    *Queue
+	handlers map[string]Handler
+	kinds    []string
+
+	// PollInterval is how long the worker waits before looking for jobs again
+	// once the queue is empty.
+	PollInterval time.Duration
+	// Timeout is how long a job can run before its context is cancelled.
+	Timeout time.Duration
+}
+
+// NewWorker returns a worker which runs the jobs of the kinds in handlers.
+func NewWorker(queue *Queue, handlers map[string]Handler) *Worker {
+	kinds := make([]string, 0, len(handlers))
+	for kind := range handlers {
+		kinds = append(kinds, kind)
+	}
+
+	return &Worker{
+		queue:        queue,
+		handlers:     handlers,
+		kinds:        kinds,
+		PollInterval: defaultPollInterval,
+		Timeout:      defaultTimeout,
+	}
+}
+
+// Run runs jobs until the context is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	for {
+		ran, err := w.RunOnce(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to run job", "error", err.Error())
+		}
+
+		if ran && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.PollInterval):
+		}
+	}
+}
+
+// RunOnce runs the next runnable job, if any, and tells whether there was one.
+// Jobs which fail are scheduled to be retried, and only errors of the queue
+// itself are returned.
+func (w *Worker) RunOnce(ctx context.Context) (bool, error) {
+	job, err := w.queue.Claim(ctx, w.kinds)
+	if err != nil {
+		return false, err
+	} else if job == nil {
+		return false, nil
+	}
+
+	ctx, span := xtrace.StartSpan(ctx, "Run Job")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int64("job.id", job.ID),
+		attribute.String("job.kind", job.Kind),
+		attribute.Int("job.attempt", job.Attempts),
+	)
+
+	err = w.run(ctx, job)
+	if err != nil {
+		slog.WarnContext(ctx, "job failed", "error", err.Error(), "job_id", job.ID, "kind", job.Kind, "attempt", job.Attempts)
+		span.SetStatus(codes.Error, err.Error())
+
+		err = w.queue.Fail(ctx, job, err)
+		if err != nil {
+			return true, fmt.Errorf("fail job %d: %w", job.ID, err)
+		}
+
+		return true, nil
+	}
+
+	err = w.queue.Complete(ctx, job.ID)
+	if err != nil {
+		return true, fmt.Errorf("complete job %d: %w", job.ID, err)
+	}
+
+	return true, nil
+}
+
+func (w *Worker) run(ctx context.Context, job *Job) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, w.Timeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return w.handlers[job.Kind](ctx, job)
+}
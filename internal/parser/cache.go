@@ -0,0 +1,197 @@
+package parser
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/manzanit0/mcduck/pkg/llm"
+)
+
+// PromptVersion identifies the prompt and the rules the parsers read receipts
+// with. Bump it whenever they change so that the cached receipts are read
+// again.
+const PromptVersion = 2
+
+// Cache keeps the receipts read from documents for a while.
+type Cache interface {
+	// Get returns nil when there's no receipt under the key or it expired.
+	Get(ctx context.Context, key string) (*Receipt, error)
+	Set(ctx context.Context, key string, receipt *Receipt, ttl time.Duration) error
+}
+
+// CacheKey returns the key of what the backend reads from the document with
+// the instructions. It's made of the SHA-256 of the document, the backend,
+// with "auto" for the router, and the version of the prompt, since any of them
+// changes what's read.
+func CacheKey(data []byte, backend, instructions string) string {
+	document := sha256.Sum256(data)
+	key := fmt.Sprintf("sha256/%s/%s/v%d", hex.EncodeToString(document[:]), backend, PromptVersion)
+
+	if instructions != "" {
+		sum := sha256.Sum256([]byte(instructions))
+		key += "/" + hex.EncodeToString(sum[:8])
+	}
+
+	return key
+}
+
+// CachedParser is a ReceiptParser which remembers what it read from each
+// document, so that retries, duplicate uploads and resends don't pay for the
+// model again. Failures aren't cached.
+type CachedParser struct {
+	parser       ReceiptParser
+	cache        Cache
+	ttl          time.Duration
+	backend      string
+	instructions string
+	bypass       bool
+}
+
+var _ ReceiptParser = (*CachedParser)(nil)
+
+func NewCachedParser(parser ReceiptParser, backend string, cache Cache, ttl time.Duration) *CachedParser {
+	return &CachedParser{parser: parser, backend: backend, cache: cache, ttl: ttl}
+}
+
+func (p CachedParser) WithInstructions(instructions string) ReceiptParser {
+	p.parser = p.parser.WithInstructions(instructions)
+	p.instructions = instructions
+	return p
+}
+
+// Bypass returns a copy of the parser which reads documents again, replacing
+// what's cached, like when a receipt is re-parsed because it was read wrong.
+func (p CachedParser) Bypass() CachedParser {
+	p.bypass = true
+	return p
+}
+
+func (p CachedParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *llm.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	key := CacheKey(data, p.backend, p.instructions)
+
+	if !p.bypass {
+		receipt, err := p.cache.Get(ctx, key)
+		if err != nil {
+			// The cache is just an optimisation.
+			slog.WarnContext(ctx, "failed to get cached receipt", "error", err.Error(), "key", key)
+		}
+
+		span.SetAttributes(attribute.Bool("parser.cache_hit", receipt != nil))
+		if receipt != nil {
+			return receipt, nil, nil
+		}
+	}
+
+	receipt, response, err := p.parser.ExtractReceipt(ctx, data)
+	if err != nil {
+		return nil, response, err
+	}
+
+	err = p.cache.Set(ctx, key, receipt, p.ttl)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to cache receipt", "error", err.Error(), "key", key)
+	}
+
+	return receipt, response, nil
+}
+
+// MemoryCache keeps receipts in memory, which is enough for a single instance
+// of the parser. Once it's full, the receipts used least recently are dropped
+// to make room, and expired ones are dropped as they're found.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	// recent has the entries from the most recently used to the least.
+	recent *list.List
+}
+
+type memoryEntry struct {
+	key       string
+	receipt   Receipt
+	expiresAt time.Time
+}
+
+var _ Cache = (*MemoryCache)(nil)
+
+// NewMemoryCache returns a cache which holds up to maxEntries receipts.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{maxEntries: maxEntries, entries: map[string]*list.Element{}, recent: list.New()}
+}
+
+func (c *MemoryCache) Get(_ context.Context, key string) (*Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, nil
+	}
+
+	entry := element.Value.(*memoryEntry)
+	if !time.Now().Before(entry.expiresAt) {
+		c.recent.Remove(element)
+		delete(c.entries, key)
+		return nil, nil
+	}
+
+	c.recent.MoveToFront(element)
+
+	receipt := entry.receipt.clone()
+	return &receipt, nil
+}
+
+func (c *MemoryCache) Set(_ context.Context, key string, receipt *Receipt, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &memoryEntry{key: key, receipt: receipt.clone(), expiresAt: time.Now().Add(ttl)}
+
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.recent.MoveToFront(element)
+		return nil
+	}
+
+	c.entries[key] = c.recent.PushFront(entry)
+
+	for c.recent.Len() > c.maxEntries {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryEntry).key)
+	}
+
+	return nil
+}
+
+// clone copies the receipt along with its items and taxes, so that the copy
+// can be changed without changing the original.
+func (r Receipt) clone() Receipt {
+	r.Items = slices.Clone(r.Items)
+	r.Taxes = slices.Clone(r.Taxes)
+	return r
+}
+
+// NoCache caches nothing, for when caching is disabled.
+type NoCache struct{}
+
+var _ Cache = NoCache{}
+
+func (NoCache) Get(context.Context, string) (*Receipt, error) {
+	return nil, nil
+}
+
+func (NoCache) Set(context.Context, string, *Receipt, time.Duration) error {
+	return nil
+}
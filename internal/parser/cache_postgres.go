@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// PostgresCache keeps receipts in the parser_cache table, so that they're
+// shared by every instance of the parser and survive restarts.
+type PostgresCache struct {
+	dbx *sqlx.DB
+}
+
+var _ Cache = (*PostgresCache)(nil)
+
+func NewPostgresCache(dbx *sqlx.DB) *PostgresCache {
+	return &PostgresCache{dbx: dbx}
+}
+
+func (c *PostgresCache) Get(ctx context.Context, key string) (*Receipt, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Parser Cache Get: Postgres")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("receipt").
+		From("parser_cache").
+		Where(sq.Eq{"cache_key": key}).
+		Where("expires_at > NOW()").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var data []byte
+	err = c.dbx.GetContext(ctx, &data, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("select cached receipt: %w", err)
+	}
+
+	var receipt Receipt
+	err = json.Unmarshal(data, &receipt)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal cached receipt: %w", err)
+	}
+
+	return &receipt, nil
+}
+
+func (c *PostgresCache) Set(ctx context.Context, key string, receipt *Receipt, ttl time.Duration) error {
+	ctx, span := xtrace.StartSpan(ctx, "Parser Cache Set: Postgres")
+	defer span.End()
+
+	data, err := json.Marshal(receipt)
+	if err != nil {
+		return fmt.Errorf("marshal receipt: %w", err)
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("parser_cache").
+		Columns("cache_key", "receipt", "expires_at").
+		Values(key, data, expiresAt).
+		Suffix("ON CONFLICT (cache_key) DO UPDATE SET receipt = EXCLUDED.receipt, expires_at = EXCLUDED.expires_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = c.dbx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the receipts which expired, which Get already
+// ignores, to keep the table small.
+func (c *PostgresCache) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Parser Cache Delete Expired: Postgres")
+	defer span.End()
+
+	res, err := c.dbx.ExecContext(ctx, "DELETE FROM parser_cache WHERE expires_at <= NOW()")
+	if err != nil {
+		return 0, fmt.Errorf("delete expired receipts: %w", err)
+	}
+
+	return res.RowsAffected()
+}
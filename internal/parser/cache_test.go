@@ -0,0 +1,133 @@
+package parser_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/parser"
+)
+
+func TestCacheKey(t *testing.T) {
+	key := parser.CacheKey([]byte("foo"), "auto", "")
+	assert.Equal(t, "sha256/2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae/auto/v2", key)
+
+	assert.NotEqual(t, key, parser.CacheKey([]byte("bar"), "auto", ""))
+	assert.NotEqual(t, key, parser.CacheKey([]byte("foo"), "vision", ""))
+	assert.NotEqual(t, key, parser.CacheKey([]byte("foo"), "auto", "the vendor is in the footer"))
+}
+
+func TestCachedParser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("documents are only read once", func(t *testing.T) {
+		stub := &stubParser{receipt: &parser.Receipt{Vendor: "Mercadona"}}
+		p := parser.NewCachedParser(stub, "auto", parser.NewMemoryCache(10), time.Hour)
+
+		for range 2 {
+			receipt, _, err := p.ExtractReceipt(ctx, []byte("foo"))
+			require.NoError(t, err)
+			assert.Equal(t, "Mercadona", receipt.Vendor)
+		}
+
+		assert.Len(t, stub.read, 1)
+
+		_, _, err := p.ExtractReceipt(ctx, []byte("bar"))
+		require.NoError(t, err)
+		assert.Len(t, stub.read, 2)
+
+		_, _, err = p.WithInstructions("the vendor is in the footer").ExtractReceipt(ctx, []byte("foo"))
+		require.NoError(t, err)
+		assert.Len(t, stub.read, 3)
+	})
+
+	t.Run("bypassing reads the document again and replaces it", func(t *testing.T) {
+		stub := &stubParser{receipt: &parser.Receipt{Vendor: "Mercadona"}}
+		p := parser.NewCachedParser(stub, "auto", parser.NewMemoryCache(10), time.Hour)
+
+		_, _, err := p.ExtractReceipt(ctx, []byte("foo"))
+		require.NoError(t, err)
+
+		stub.receipt = &parser.Receipt{Vendor: "Lidl"}
+		receipt, _, err := p.Bypass().ExtractReceipt(ctx, []byte("foo"))
+		require.NoError(t, err)
+		assert.Equal(t, "Lidl", receipt.Vendor)
+
+		receipt, _, err = p.ExtractReceipt(ctx, []byte("foo"))
+		require.NoError(t, err)
+		assert.Equal(t, "Lidl", receipt.Vendor)
+		assert.Len(t, stub.read, 2)
+	})
+
+	t.Run("failures aren't cached", func(t *testing.T) {
+		stub := &stubParser{err: errors.New("boom")}
+		p := parser.NewCachedParser(stub, "auto", parser.NewMemoryCache(10), time.Hour)
+
+		for range 2 {
+			_, _, err := p.ExtractReceipt(ctx, []byte("foo"))
+			assert.Error(t, err)
+		}
+
+		assert.Len(t, stub.read, 2)
+	})
+
+	t.Run("receipts expire", func(t *testing.T) {
+		stub := &stubParser{receipt: &parser.Receipt{Vendor: "Mercadona"}}
+		p := parser.NewCachedParser(stub, "auto", parser.NewMemoryCache(10), 0)
+
+		for range 2 {
+			_, _, err := p.ExtractReceipt(ctx, []byte("foo"))
+			require.NoError(t, err)
+		}
+
+		assert.Len(t, stub.read, 2)
+	})
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("the receipts used least recently are dropped when it's full", func(t *testing.T) {
+		cache := parser.NewMemoryCache(2)
+
+		require.NoError(t, cache.Set(ctx, "a", &parser.Receipt{Vendor: "a"}, time.Hour))
+		require.NoError(t, cache.Set(ctx, "b", &parser.Receipt{Vendor: "b"}, time.Hour))
+
+		receipt, err := cache.Get(ctx, "a")
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+
+		require.NoError(t, cache.Set(ctx, "c", &parser.Receipt{Vendor: "c"}, time.Hour))
+
+		for key, kept := range map[string]bool{"a": true, "b": false, "c": true} {
+			receipt, err := cache.Get(ctx, key)
+			require.NoError(t, err)
+			assert.Equal(t, kept, receipt != nil, key)
+		}
+	})
+
+	t.Run("receipts are copied in and out", func(t *testing.T) {
+		cache := parser.NewMemoryCache(2)
+
+		stored := &parser.Receipt{
+			Items: []parser.LineItem{{Name: "milk"}},
+			Taxes: []parser.TaxLine{{Rate: 21}},
+		}
+		require.NoError(t, cache.Set(ctx, "a", stored, time.Hour))
+		stored.Items[0].Name = "changed"
+
+		receipt, err := cache.Get(ctx, "a")
+		require.NoError(t, err)
+		receipt.Items[0].Name = "changed"
+		receipt.Taxes[0].Rate = 10
+
+		receipt, err = cache.Get(ctx, "a")
+		require.NoError(t, err)
+		assert.Equal(t, "milk", receipt.Items[0].Name)
+		assert.EqualValues(t, 21, receipt.Taxes[0].Rate)
+	})
+}
@@ -3,21 +3,20 @@ package parser
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/manzanit0/mcduck/pkg/openai"
+	"github.com/manzanit0/mcduck/pkg/llm"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/textract"
 	"github.com/aws/aws-sdk-go-v2/service/textract/types"
-	"github.com/martoche/pdf"
 	"github.com/segmentio/ksuid"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const initialPrompt = `
@@ -40,43 +39,114 @@ The total price paid should not include the currency and it should be formated
 as a number.
 
 The currency will be formatted following the ISO 4217 codes.
+
+You will also provide the line items of the receipt under the "items" property
+as a list of objects with the properties "name", "quantity", "unit_price",
+"total" and "category". The unit price and the total of each item should be
+formatted as numbers, without the currency, and the total should be the final
+price paid for the line after any discount. The category should be a short
+generic name for the kind of item, like "Groceries", "Drinks" or "Cleaning",
+so that similar items share the same category.
+
+If the receipt doesn't list any items, "items" should be an empty list.
+
+For invoices and receipts which break down taxes, like VAT, you will provide
+the amount before taxes under "subtotal", and each tax under "taxes" as a list
+of objects with the properties "rate", "base" and "amount": the rate as a
+percentage number, like 21 for 21%, the amount it's applied to and the tax
+itself, all formatted as numbers. You will also provide the tax identification
+number of the vendor, like the NIF or CIF, under "vendor_tax_id" and the number
+of the invoice under "invoice_number". Leave out the properties which the
+receipt doesn't show.
+`
+
+// transcriptionPrompt is only given to parsers which read the receipt from a
+// picture, since the others already have its text.
+const transcriptionPrompt = `
+You will also provide the whole text of the receipt, transcribed line by line
+as it's printed, under the "text" property.
 `
 
 type Receipt struct {
-	Amount       float64 `json:"amount"`
-	Currency     string  `json:"currency"`
-	Description  string  `json:"description"`
-	Vendor       string  `json:"vendor"`
-	PurchaseDate string  `json:"purchase_date"`
+	Amount       float64    `json:"amount"`
+	Currency     string     `json:"currency"`
+	Description  string     `json:"description"`
+	Vendor       string     `json:"vendor"`
+	PurchaseDate string     `json:"purchase_date"`
+	Items        []LineItem `json:"items"`
+	// Text is the whole text of the receipt, as read by OCR or transcribed by
+	// the model.
+	Text string `json:"text"`
+
+	// Subtotal is the amount before taxes.
+	Subtotal      float64   `json:"subtotal"`
+	Taxes         []TaxLine `json:"taxes"`
+	VendorTaxID   string    `json:"vendor_tax_id"`
+	InvoiceNumber string    `json:"invoice_number"`
+}
+
+type TaxLine struct {
+	// Rate is a percentage, like 21 for 21%.
+	Rate   float64 `json:"rate"`
+	Base   float64 `json:"base"`
+	Amount float64 `json:"amount"`
+}
+
+type LineItem struct {
+	Name      string  `json:"name"`
+	Quantity  float64 `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	Total     float64 `json:"total"`
+	Category  string  `json:"category"`
 }
 
 type ReceiptParser interface {
 	// PDF text extractor: passes the raw text
 	// AWS Textract: passes the bytes of the PDF
 	// OpenAI Vision: passes the bytes of image
-	ExtractReceipt(context.Context, []byte) (*Receipt, *openai.Response, error)
+	ExtractReceipt(context.Context, []byte) (*Receipt, *llm.Response, error)
+
+	// WithInstructions returns a copy of the parser which gives the model the
+	// instructions on top of the usual prompt, like hints on how to read a
+	// receipt which was parsed badly.
+	WithInstructions(instructions string) ReceiptParser
+}
+
+// prompt adds the extra instructions, if any, to the initial prompt.
+func prompt(instructions string) string {
+	if instructions == "" {
+		return initialPrompt
+	}
+
+	return initialPrompt + "\nAdditionally, follow these instructions:\n\n" + instructions
 }
 
 // TextractParser is a general-purpouse receipt parser that can process any
 // kind of document by relying on AWS Textract. It'll then feed Textract's
-// output to ChatGPT.
+// output to the model.
 type TextractParser struct {
-	openaiToken string
-	tx          *textract.Client
-	sthree      *s3.Client
+	llm          llm.Client
+	instructions string
+	tx           *textract.Client
+	sthree       *s3.Client
 }
 
 var _ ReceiptParser = (*TextractParser)(nil)
 
-func NewTextractParser(config aws.Config, openaiToken string) *TextractParser {
+func NewTextractParser(config aws.Config, client llm.Client) *TextractParser {
 	return &TextractParser{
-		openaiToken: openaiToken,
-		sthree:      s3.NewFromConfig(config),
-		tx:          textract.NewFromConfig(config),
+		llm:    client,
+		sthree: s3.NewFromConfig(config),
+		tx:     textract.NewFromConfig(config),
 	}
 }
 
-func (p TextractParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *openai.Response, error) {
+func (p TextractParser) WithInstructions(instructions string) ReceiptParser {
+	p.instructions = instructions
+	return p
+}
+
+func (p TextractParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *llm.Response, error) {
 	ctx, span := xtrace.StartSpan(ctx, "Extract Receipt: Textract")
 	defer span.End()
 
@@ -92,33 +162,17 @@ func (p TextractParser) ExtractReceipt(ctx context.Context, data []byte) (*Recei
 		return nil, nil, err
 	}
 
-	payload := openai.Request{
-		Model:     "gpt-4o",
-		MaxTokens: 300,
-		Messages: []openai.Messages{
-			{
-				Role: "user",
-				Content: []openai.Content{
-					{
-						Type: "text",
-						Text: initialPrompt,
-					},
-					{
-						Type: "text",
-						Text: receiptText,
-					},
-				},
-			},
-		},
-	}
-
-	response, err := openai.Completions(ctx, p.openaiToken, payload)
+	receipt, response, err := chatForReceipt(ctx, p.llm, llm.Request{
+		MaxTokens: 1000,
+		Messages:  []llm.Message{llm.UserMessage(llm.Text(prompt(p.instructions)), llm.Text(receiptText))},
+	})
 	if err != nil {
 		span.RecordError(err)
-		return nil, response, fmt.Errorf("get openai completions: %w", err)
+		return nil, response, err
 	}
 
-	return receiptFromResponse(response)
+	receipt.Text = receiptText
+	return receipt, response, nil
 }
 
 func (p TextractParser) StartDocumentTextDetection(ctx context.Context, data []byte) (string, error) {
@@ -192,142 +246,169 @@ outer:
 	var extracted string
 	for _, block := range out.Blocks {
 		if block.BlockType == types.BlockTypeLine && block.Text != nil {
-			extracted += *block.Text
+			extracted += *block.Text + "\n"
 		}
 	}
 
 	return extracted, nil
 }
 
-// AIVisionParser relies on OpenAI Vision to OCR receipts in image formats that
-// are then fed into chatGPT.
+// AIVisionParser relies on the vision of the model to read receipts in image
+// formats.
 type AIVisionParser struct {
-	openaiToken string
+	llm          llm.Client
+	instructions string
 }
 
-func NewAIVisionParser(openaiToken string) *AIVisionParser {
-	return &AIVisionParser{openaiToken: openaiToken}
+func NewAIVisionParser(client llm.Client) *AIVisionParser {
+	return &AIVisionParser{llm: client}
 }
 
 var _ ReceiptParser = (*AIVisionParser)(nil)
 
-func (p AIVisionParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *openai.Response, error) {
-	base64Image := base64.StdEncoding.EncodeToString(data)
-
-	payload := openai.Request{
-		Model:     "gpt-4o",
-		MaxTokens: 300,
-		Messages: []openai.Messages{
-			{
-				Role: "user",
-				Content: []openai.Content{
-					{
-						Type: "text",
-						Text: initialPrompt,
-					},
-					{
-						Type: "image_url",
-						ImageURL: openai.ImageURL{
-							URL: fmt.Sprintf("data:image/jpeg;base64,%s", base64Image),
-						},
-					},
-				},
-			},
-		},
-	}
-
-	response, err := openai.Completions(ctx, p.openaiToken, payload)
-	if err != nil {
-		return nil, response, fmt.Errorf("get openai completions: %w", err)
-	}
+func (p AIVisionParser) WithInstructions(instructions string) ReceiptParser {
+	p.instructions = instructions
+	return p
+}
 
-	return receiptFromResponse(response)
+func (p AIVisionParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *llm.Response, error) {
+	return chatForReceipt(ctx, p.llm, llm.Request{
+		// The transcription of the receipt takes as much as the rest.
+		MaxTokens: 2000,
+		Messages: []llm.Message{
+			llm.UserMessage(llm.Text(prompt(p.instructions)+transcriptionPrompt), llm.Image(data, Sniff(data))),
+		},
+	})
 }
 
-// NaivePDFParser simply attempts to read the text from the PDF and pass it to
-// openAI.
+// ErrNoText is returned for PDFs without embedded text, like scanned ones,
+// which need OCR.
+var ErrNoText = errors.New("the pdf has no text")
+
+// NaivePDFParser reads the text embedded in the PDF. It reads the receipt
+// from it with rules when it can, and otherwise passes it to the model.
 type NaivePDFParser struct {
-	openaiToken string
+	llm          llm.Client
+	instructions string
 }
 
-func NewNaivePDFParser(openaiToken string) *NaivePDFParser {
-	return &NaivePDFParser{openaiToken: openaiToken}
+func NewNaivePDFParser(client llm.Client) *NaivePDFParser {
+	return &NaivePDFParser{llm: client}
 }
 
 var _ ReceiptParser = (*NaivePDFParser)(nil)
 
-func (p NaivePDFParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *openai.Response, error) {
+func (p NaivePDFParser) WithInstructions(instructions string) ReceiptParser {
+	p.instructions = instructions
+	return p
+}
+
+func (p NaivePDFParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *llm.Response, error) {
 	ctx, span := xtrace.StartSpan(ctx, "Extract Receipt: Naive PDF read")
 	defer span.End()
 
-	_, span = xtrace.StartSpan(ctx, "Extract Text from PDF")
-	r, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	pages, err := ReadPDFPages(data)
 	if err != nil {
 		span.RecordError(err)
-		return nil, nil, fmt.Errorf("new pdf reader: %w", err)
+		return nil, nil, fmt.Errorf("read pdf text: %w", err)
 	}
 
-	reader, err := r.GetPlainText()
-	if err != nil {
-		span.RecordError(err)
-		return nil, nil, fmt.Errorf("get pdf text: %w", err)
-	}
+	span.SetAttributes(attribute.Int("document.pages", len(pages)))
 
-	buf, ok := reader.(*bytes.Buffer)
-	if !ok {
-		span.RecordError(err)
-		return nil, nil, fmt.Errorf("github.com/martoche/pdf no longer uses bytes.Buffer to implement io.Reader")
+	extractedText := strings.TrimSpace(strings.Join(pages, "\n"))
+	if extractedText == "" {
+		return nil, nil, ErrNoText
 	}
 
-	extractedText := buf.String()
-	span.End()
+	// The rules can't follow instructions, which are given when they got it
+	// wrong in the first place.
+	if p.instructions == "" {
+		receipt, confidence := ParseText(extractedText)
+		span.SetAttributes(attribute.Float64("receipt.confidence", confidence))
 
-	payload := openai.Request{
-		Model:     "gpt-4o",
-		MaxTokens: 300,
-		Messages: []openai.Messages{
-			{
-				Role: "user",
-				Content: []openai.Content{
-					{
-						Type: "text",
-						Text: initialPrompt,
-					},
-					{
-						Type: "text",
-						Text: extractedText,
-					},
-				},
-			},
-		},
+		if confidence >= MinConfidence && receipt.Validate() == nil {
+			receipt.Text = extractedText
+			return receipt, nil, nil
+		}
 	}
 
-	response, err := openai.Completions(ctx, p.openaiToken, payload)
+	receipt, response, err := chatForReceipt(ctx, p.llm, llm.Request{
+		MaxTokens: 1000,
+		Messages:  []llm.Message{llm.UserMessage(llm.Text(prompt(p.instructions)), llm.Text(joinPages(pages)))},
+	})
 	if err != nil {
-		return nil, response, fmt.Errorf("get openai completions: %w", err)
+		return nil, response, err
 	}
 
-	return receiptFromResponse(response)
+	receipt.Text = extractedText
+	return receipt, response, nil
 }
 
-func receiptFromResponse(response *openai.Response) (*Receipt, *openai.Response, error) {
-	if len(response.Choices) == 0 {
-		return nil, response, fmt.Errorf("openAI response has no choices")
+// maxPages is how many of the first pages of a document are read. Longer ones
+// are usually invoices with an annex of details, so the last page, which has
+// the totals, is read too and the ones in between are left out.
+//
+// The pages are read together rather than apart because a document is a
+// single receipt, which is what the upload of a file stores, and the pages of
+// an invoice only make sense together: the vendor is on the first and the
+// total on the last.
+const maxPages = 5
+
+// joinPages joins the text of the pages of a document, marking where each
+// starts so that the model doesn't mistake them for a single page, and which
+// were left out.
+func joinPages(pages []string) string {
+	if len(pages) == 1 {
+		return pages[0]
 	}
 
-	j := trimMarkdownWrapper(response.Choices[0].Message.Content)
+	var b strings.Builder
+	for i, page := range pages {
+		if i == maxPages && i < len(pages)-1 {
+			fmt.Fprintf(&b, "--- Pages %d to %d of %d left out ---\n", i+1, len(pages)-1, len(pages))
+		}
 
-	var receipt Receipt
-	err := json.Unmarshal([]byte(j), &receipt)
-	if err != nil {
-		return nil, response, fmt.Errorf("unmarshal receipt: %w", err)
+		if page == "" || (i >= maxPages && i < len(pages)-1) {
+			continue
+		}
+
+		fmt.Fprintf(&b, "--- Page %d of %d ---\n%s\n", i+1, len(pages), page)
 	}
 
-	return &receipt, response, nil
+	return strings.TrimSpace(b.String())
 }
 
-func trimMarkdownWrapper(s string) string {
-	s = strings.TrimPrefix(s, "```json")
-	s = strings.TrimSuffix(s, "```")
-	return s
+// TextParser passes receipts which are already text, like e-receipts, to
+// the model as they are.
+type TextParser struct {
+	llm          llm.Client
+	instructions string
+}
+
+func NewTextParser(client llm.Client) *TextParser {
+	return &TextParser{llm: client}
+}
+
+var _ ReceiptParser = (*TextParser)(nil)
+
+func (p TextParser) WithInstructions(instructions string) ReceiptParser {
+	p.instructions = instructions
+	return p
+}
+
+func (p TextParser) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *llm.Response, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Extract Receipt: Text")
+	defer span.End()
+
+	receipt, response, err := chatForReceipt(ctx, p.llm, llm.Request{
+		MaxTokens: 1000,
+		Messages:  []llm.Message{llm.UserMessage(llm.Text(prompt(p.instructions)), llm.Text(string(data)))},
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, response, err
+	}
+
+	receipt.Text = string(data)
+	return receipt, response, nil
 }
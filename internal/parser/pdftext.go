@@ -0,0 +1,176 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/martoche/pdf"
+)
+
+// ReadPDFText returns the text embedded in the PDF line by line. Unlike
+// pdf.Reader.GetPlainText, which glues together every piece of text of a
+// page, it follows the moves of the text position to break lines and
+// separate words, which is what finding totals and dates relies on.
+//
+// Scanned documents have no text, so they return an empty string.
+func ReadPDFText(data []byte) (string, error) {
+	pages, err := ReadPDFPages(data)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(strings.Join(pages, "\n")), nil
+}
+
+// ReadPDFPages is like ReadPDFText, but returns the text of each page apart.
+func ReadPDFPages(data []byte) (pages []string, err error) {
+	// The PDF library panics on some malformed documents.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("read pdf: %v", r)
+		}
+	}()
+
+	r, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("new pdf reader: %w", err)
+	}
+
+	pages = make([]string, r.NumPage())
+	for i := range pages {
+		var b strings.Builder
+		err = readPageText(r.Page(i+1), &b)
+		if err != nil {
+			return nil, fmt.Errorf("read page %d: %w", i+1, err)
+		}
+
+		pages[i] = strings.TrimSpace(b.String())
+	}
+
+	return pages, nil
+}
+
+func readPageText(page pdf.Page, b *strings.Builder) error {
+	fonts := map[string]*pdf.Font{}
+	for _, name := range page.Fonts() {
+		f := page.Font(name)
+		fonts[name] = &f
+	}
+
+	var streams []pdf.Value
+	switch contents := page.V.Key("Contents"); contents.Kind() {
+	case pdf.Stream:
+		streams = append(streams, contents)
+	case pdf.Array:
+		for i := 0; i < contents.Len(); i++ {
+			streams = append(streams, contents.Index(i))
+		}
+	}
+
+	var (
+		enc pdf.TextEncoding
+		// y is the height of the current line of text, and leading the
+		// distance to the next one.
+		y, leading float64
+		lastY      = math.NaN()
+		// moved is whether the text position changed since the last text
+		// shown, which on the same line means a new word.
+		moved bool
+	)
+
+	show := func(s string) {
+		switch {
+		case math.IsNaN(lastY):
+		case math.Abs(y-lastY) > 1:
+			b.WriteString("\n")
+		case moved:
+			b.WriteString(" ")
+		}
+
+		lastY, moved = y, false
+
+		if enc == nil {
+			b.WriteString(s)
+			return
+		}
+
+		b.WriteString(enc.Decode(s))
+	}
+
+	nextLine := func() {
+		y -= leading
+		moved = true
+	}
+
+	for _, stream := range streams {
+		err := pdf.Interpret(stream, func(stk *pdf.Stack, op string) error {
+			args := make([]pdf.Value, stk.Len())
+			for i := len(args) - 1; i >= 0; i-- {
+				args[i] = stk.Pop()
+			}
+
+			switch op {
+			case "BT":
+				y, moved = 0, true
+			case "Tf":
+				if len(args) == 2 {
+					enc = nil
+					if font, ok := fonts[args[0].Name()]; ok {
+						enc = font.Encoder()
+					}
+				}
+			case "TL":
+				if len(args) == 1 {
+					leading = args[0].Float64()
+				}
+			case "Td", "TD":
+				if len(args) == 2 {
+					y += args[1].Float64()
+					moved = true
+					if op == "TD" {
+						leading = -args[1].Float64()
+					}
+				}
+			case "Tm":
+				if len(args) == 6 {
+					y = args[5].Float64()
+					moved = true
+				}
+			case "T*":
+				nextLine()
+			case "'", "\"":
+				nextLine()
+				if len(args) > 0 {
+					show(args[len(args)-1].RawString())
+				}
+			case "Tj":
+				if len(args) == 1 {
+					show(args[0].RawString())
+				}
+			case "TJ":
+				if len(args) != 1 {
+					return nil
+				}
+
+				for i := 0; i < args[0].Len(); i++ {
+					v := args[0].Index(i)
+					if v.Kind() == pdf.String {
+						show(v.RawString())
+					} else if v.Float64() < -200 {
+						// Big kerning is how some generators space words.
+						moved = true
+					}
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/manzanit0/mcduck/pkg/llm"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// ErrUnsupportedType is returned for documents which no parser can read.
+var ErrUnsupportedType = errors.New("unsupported document type")
+
+// Kind is what a document is as far as reading it goes.
+type Kind string
+
+const (
+	KindText Kind = "text"
+	// KindTextPDF are PDFs with their text embedded, usually generated by a
+	// computer, which don't need OCR.
+	KindTextPDF Kind = "text_pdf"
+	// KindScannedPDF are PDFs made of pictures.
+	KindScannedPDF Kind = "scanned_pdf"
+	KindImage      Kind = "image"
+)
+
+// The names of the parsers in the fallback chains, which are also the backends
+// the parser service can be asked for.
+const (
+	BackendText     = "text"
+	BackendPDFText  = "pdf_text"
+	BackendVision   = "vision"
+	BackendTextract = "textract"
+)
+
+// DefaultChains are the parsers tried for each kind of document, cheapest
+// first: reading text is free, or costs a text prompt, while pictures need a
+// vision model or OCR.
+var DefaultChains = map[Kind][]string{
+	KindText:       {BackendText},
+	KindTextPDF:    {BackendPDFText, BackendTextract},
+	KindScannedPDF: {BackendTextract},
+	KindImage:      {BackendVision, BackendTextract},
+}
+
+// visionFormats are the image formats every provider reads. The rest are
+// converted to JPEG first.
+var visionFormats = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+// convertibleFormats are the ImageMagick coders of the image formats which are
+// converted to JPEG, by MIME type. ImageMagick is never left to guess the
+// format of a file, since some of its coders, like SVG or MVG, are an attack
+// surface.
+var convertibleFormats = map[string]string{
+	"image/heic": "heic",
+	"image/heif": "heic",
+	"image/avif": "avif",
+	"image/tiff": "tiff",
+}
+
+// minTextLayer is the least characters a PDF has to have embedded to be read
+// as text. Scanners sometimes add a few, like a page number.
+const minTextLayer = 20
+
+// Sniff returns the MIME type of the document from its contents, without
+// parameters like the charset.
+func Sniff(data []byte) string {
+	mimeType, _, _ := strings.Cut(mimetype.Detect(data).String(), ";")
+	return mimeType
+}
+
+// Classify returns the kind of the document and its MIME type.
+func Classify(data []byte) (Kind, string, error) {
+	mimeType := Sniff(data)
+
+	switch {
+	case mimeType == "application/pdf":
+		text, err := ReadPDFText(data)
+		if err == nil && len(strings.Join(strings.Fields(text), "")) >= minTextLayer {
+			return KindTextPDF, mimeType, nil
+		}
+
+		return KindScannedPDF, mimeType, nil
+
+	case strings.HasPrefix(mimeType, "image/"):
+		return KindImage, mimeType, nil
+
+	case strings.HasPrefix(mimeType, "text/"):
+		return KindText, mimeType, nil
+
+	default:
+		return "", mimeType, fmt.Errorf("%w: %s", ErrUnsupportedType, mimeType)
+	}
+}
+
+// ParseChains reads fallback chains like "image=vision,textract;text=text",
+// which replace the default ones of their kinds.
+func ParseChains(s string) (map[Kind][]string, error) {
+	chains := map[Kind][]string{}
+	for _, chain := range strings.Split(s, ";") {
+		if strings.TrimSpace(chain) == "" {
+			continue
+		}
+
+		kind, backends, ok := strings.Cut(chain, "=")
+		if !ok {
+			return nil, fmt.Errorf("chain %q must be like kind=backend,backend", chain)
+		}
+
+		kind = strings.TrimSpace(kind)
+		if _, ok := DefaultChains[Kind(kind)]; !ok {
+			return nil, fmt.Errorf("unknown document kind: %s", kind)
+		}
+
+		for _, backend := range strings.Split(backends, ",") {
+			if backend = strings.TrimSpace(backend); backend != "" {
+				chains[Kind(kind)] = append(chains[Kind(kind)], backend)
+			}
+		}
+	}
+
+	return chains, nil
+}
+
+// Router is a ReceiptParser which picks the parser for each document by what
+// it is. Should a parser fail, the next of the chain of the kind is tried.
+type Router struct {
+	parsers map[string]ReceiptParser
+	chains  map[Kind][]string
+}
+
+var _ ReceiptParser = (*Router)(nil)
+
+// NewRouter routes to the parsers by their backend name, like "vision". The
+// chains replace the default ones of their kinds.
+func NewRouter(parsers map[string]ReceiptParser, chains map[Kind][]string) (*Router, error) {
+	r := &Router{parsers: parsers, chains: map[Kind][]string{}}
+	for kind, chain := range DefaultChains {
+		r.chains[kind] = chain
+	}
+
+	for kind, chain := range chains {
+		r.chains[kind] = chain
+	}
+
+	for kind, chain := range r.chains {
+		for _, backend := range chain {
+			if _, ok := parsers[backend]; !ok {
+				return nil, fmt.Errorf("unknown backend %s in the chain of %s", backend, kind)
+			}
+		}
+	}
+
+	return r, nil
+}
+
+func (r Router) WithInstructions(instructions string) ReceiptParser {
+	parsers := make(map[string]ReceiptParser, len(r.parsers))
+	for backend, p := range r.parsers {
+		parsers[backend] = p.WithInstructions(instructions)
+	}
+
+	r.parsers = parsers
+	return r
+}
+
+func (r Router) ExtractReceipt(ctx context.Context, data []byte) (*Receipt, *llm.Response, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Extract Receipt: Route")
+	defer span.End()
+
+	kind, mimeType, err := Classify(data)
+	span.SetAttributes(attribute.String("document.kind", string(kind)), attribute.String("document.content_type", mimeType))
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	if kind == KindImage && !slices.Contains(visionFormats, mimeType) {
+		data, err = ConvertImage(ctx, data, mimeType)
+		if err != nil {
+			span.RecordError(err)
+			return nil, nil, fmt.Errorf("convert %s: %w", mimeType, err)
+		}
+	}
+
+	var response *llm.Response
+	var errs []error
+	for _, backend := range r.chains[kind] {
+		var receipt *Receipt
+		receipt, response, err = r.parsers[backend].ExtractReceipt(ctx, data)
+		if err == nil {
+			span.SetAttributes(attribute.String("document.backend", backend))
+			return receipt, response, nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", backend, err))
+		if ctx.Err() != nil {
+			break
+		}
+
+		span.AddEvent("parser failed", trace.WithAttributes(attribute.String("backend", backend), attribute.String("error", err.Error())))
+	}
+
+	return nil, response, errors.Join(errs...)
+}
+
+// ConvertImage turns images the models can't read, HEIC photos of iPhones,
+// TIFF scans and AVIF, into JPEG. Go can't decode them, so it needs
+// ImageMagick installed. Any other type returns ErrUnsupportedType.
+func ConvertImage(ctx context.Context, data []byte, mimeType string) ([]byte, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Convert Image")
+	defer span.End()
+
+	coder, ok := convertibleFormats[mimeType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, mimeType)
+	}
+
+	var command string
+	for _, name := range []string{"magick", "convert"} {
+		if path, err := exec.LookPath(name); err == nil {
+			command = path
+			break
+		}
+	}
+
+	if command == "" {
+		return nil, fmt.Errorf("%w: converting it needs ImageMagick", ErrUnsupportedType)
+	}
+
+	var stdout, stderr bytes.Buffer
+	// Only the first frame of animations or page of multi-page TIFFs.
+	cmd := exec.CommandContext(ctx, command, coder+":-[0]", "-auto-orient", "-quality", "90", "jpeg:-")
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("run %s: %w: %s", command, err, stderr.String())
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,221 @@
+package parser_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/parser"
+	"github.com/manzanit0/mcduck/pkg/llm"
+	"github.com/manzanit0/mcduck/pkg/pdfgen"
+)
+
+var heic = []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic")
+
+// stubParser returns the receipt, or the error, and records what it read.
+type stubParser struct {
+	receipt *parser.Receipt
+	err     error
+	read    [][]byte
+}
+
+func (p *stubParser) ExtractReceipt(_ context.Context, data []byte) (*parser.Receipt, *llm.Response, error) {
+	p.read = append(p.read, data)
+	return p.receipt, nil, p.err
+}
+
+func (p *stubParser) WithInstructions(string) parser.ReceiptParser {
+	return p
+}
+
+func TestClassify(t *testing.T) {
+	textPDF := pdfWithPages(t, "Renfe Viajeros\nTOTAL 35,40 €")
+
+	doc := pdfgen.New()
+	require.NoError(t, doc.AddPage().JPEG(encodeJPEG(t), 0, 0, 100, 100))
+	var scannedPDF bytes.Buffer
+	_, err := doc.WriteTo(&scannedPDF)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		data     []byte
+		kind     parser.Kind
+		mimeType string
+	}{
+		{desc: "text pdf", data: textPDF, kind: parser.KindTextPDF, mimeType: "application/pdf"},
+		{desc: "scanned pdf", data: scannedPDF.Bytes(), kind: parser.KindScannedPDF, mimeType: "application/pdf"},
+		{desc: "jpeg", data: encodeJPEG(t), kind: parser.KindImage, mimeType: "image/jpeg"},
+		{desc: "png", data: encodePNG(t), kind: parser.KindImage, mimeType: "image/png"},
+		{desc: "webp", data: []byte("RIFF\x24\x00\x00\x00WEBPVP8 \x18\x00\x00\x00"), kind: parser.KindImage, mimeType: "image/webp"},
+		{desc: "heic", data: heic, kind: parser.KindImage, mimeType: "image/heic"},
+		{desc: "text", data: []byte("MERCADONA\nTOTAL 3,00 €"), kind: parser.KindText, mimeType: "text/plain"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			kind, mimeType, err := parser.Classify(tC.data)
+			require.NoError(t, err)
+			assert.Equal(t, tC.kind, kind)
+			assert.Equal(t, tC.mimeType, mimeType)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, _, err := parser.Classify([]byte("PK\x03\x04\x14\x00\x00\x00\x08\x00"))
+		assert.ErrorIs(t, err, parser.ErrUnsupportedType)
+	})
+}
+
+func TestParseChains(t *testing.T) {
+	chains, err := parser.ParseChains("image=textract, vision; text_pdf=pdf_text")
+	require.NoError(t, err)
+	assert.Equal(t, map[parser.Kind][]string{
+		parser.KindImage:   {"textract", "vision"},
+		parser.KindTextPDF: {"pdf_text"},
+	}, chains)
+
+	_, err = parser.ParseChains("video=vision")
+	assert.Error(t, err)
+}
+
+func TestRouter(t *testing.T) {
+	t.Run("the next parser of the chain is tried when one fails", func(t *testing.T) {
+		vision := &stubParser{err: errors.New("boom")}
+		textract := &stubParser{receipt: &parser.Receipt{Vendor: "Mercadona"}}
+		text := &stubParser{}
+
+		router, err := parser.NewRouter(map[string]parser.ReceiptParser{
+			parser.BackendVision:   vision,
+			parser.BackendTextract: textract,
+			parser.BackendText:     text,
+			parser.BackendPDFText:  &stubParser{},
+		}, nil)
+		require.NoError(t, err)
+
+		receipt, _, err := router.ExtractReceipt(context.Background(), encodePNG(t))
+		require.NoError(t, err)
+		assert.Equal(t, "Mercadona", receipt.Vendor)
+		assert.Len(t, vision.read, 1)
+		assert.Len(t, textract.read, 1)
+		assert.Empty(t, text.read)
+	})
+
+	t.Run("every error is returned when all fail", func(t *testing.T) {
+		invalid := &parser.ValidationError{Problems: []string{"vendor must not be empty"}}
+		router, err := parser.NewRouter(map[string]parser.ReceiptParser{
+			parser.BackendVision:   &stubParser{err: invalid},
+			parser.BackendTextract: &stubParser{err: errors.New("boom")},
+			parser.BackendText:     &stubParser{},
+			parser.BackendPDFText:  &stubParser{},
+		}, nil)
+		require.NoError(t, err)
+
+		_, _, err = router.ExtractReceipt(context.Background(), encodeJPEG(t))
+		assert.ErrorIs(t, err, invalid)
+		assert.ErrorContains(t, err, "textract: boom")
+	})
+
+	t.Run("chains can only have known parsers", func(t *testing.T) {
+		_, err := parser.NewRouter(map[string]parser.ReceiptParser{}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported images need ImageMagick", func(t *testing.T) {
+		if _, err := exec.LookPath("magick"); err == nil {
+			t.Skip("ImageMagick is installed")
+		}
+		if _, err := exec.LookPath("convert"); err == nil {
+			t.Skip("ImageMagick is installed")
+		}
+
+		vision := &stubParser{}
+		router, err := parser.NewRouter(map[string]parser.ReceiptParser{
+			parser.BackendVision:   vision,
+			parser.BackendTextract: vision,
+			parser.BackendText:     vision,
+			parser.BackendPDFText:  vision,
+		}, nil)
+		require.NoError(t, err)
+
+		_, _, err = router.ExtractReceipt(context.Background(), heic)
+		assert.ErrorIs(t, err, parser.ErrUnsupportedType)
+		assert.Empty(t, vision.read)
+	})
+
+	t.Run("only known image formats are converted", func(t *testing.T) {
+		vision := &stubParser{}
+		router, err := parser.NewRouter(map[string]parser.ReceiptParser{
+			parser.BackendVision:   vision,
+			parser.BackendTextract: vision,
+			parser.BackendText:     vision,
+			parser.BackendPDFText:  vision,
+		}, nil)
+		require.NoError(t, err)
+
+		svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="10" height="10"><rect width="10" height="10"/></svg>`)
+		_, _, err = router.ExtractReceipt(context.Background(), svg)
+		assert.ErrorIs(t, err, parser.ErrUnsupportedType)
+		assert.Empty(t, vision.read)
+	})
+}
+
+func TestNaivePDFParserPages(t *testing.T) {
+	pages := make([]string, 7)
+	for i := range pages {
+		pages[i] = fmt.Sprintf("Annex line %d", i+1)
+	}
+
+	client := &scriptedClient{answers: []string{`{"amount": 10, "currency": "EUR", "vendor": "Acme"}`}}
+	_, _, err := parser.NewNaivePDFParser(client).ExtractReceipt(context.Background(), pdfWithPages(t, pages...))
+	require.NoError(t, err)
+
+	require.Len(t, client.requests, 1)
+	text := client.requests[0].Messages[0].Content[1].Text
+	assert.Contains(t, text, "--- Page 1 of 7 ---\nAnnex line 1")
+	for i := 1; i <= 5; i++ {
+		assert.Contains(t, text, fmt.Sprintf("--- Page %d of 7 ---\nAnnex line %d", i, i))
+	}
+	assert.Contains(t, text, "--- Pages 6 to 6 of 7 left out ---")
+	assert.NotContains(t, text, "Annex line 6")
+	assert.Contains(t, text, "--- Page 7 of 7 ---\nAnnex line 7")
+}
+
+// pdfWithPages returns a PDF with a page for each text.
+func pdfWithPages(t *testing.T, pages ...string) []byte {
+	doc := pdfgen.New()
+	for _, text := range pages {
+		page := doc.AddPage()
+		for i, line := range strings.Split(text, "\n") {
+			page.Text(40, 800-float64(i)*20, 10, false, line)
+		}
+	}
+
+	var buf bytes.Buffer
+	_, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 10, 10)), nil))
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 10, 10))))
+	return buf.Bytes()
+}
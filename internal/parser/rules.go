@@ -0,0 +1,355 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MinConfidence is how confident the rules have to be in what they read from
+// a receipt for it to be trusted without asking the model.
+const MinConfidence = 0.8
+
+// The weights of what the rules find, which add up to the confidence.
+const (
+	totalWeight    = 0.45
+	amountWeight   = 0.15
+	dateWeight     = 0.2
+	vendorWeight   = 0.2
+	currencyWeight = 0.15
+)
+
+var (
+	// moneyRe matches amounts like 12, 12.50, 12,50, 1.234,56 or 1,234.56.
+	moneyRe = regexp.MustCompile(`-?\d{1,3}(?:[.,]\d{3})+(?:[.,]\d{1,2})?|-?\d+(?:[.,]\d{1,2})?`)
+
+	numericDateRe = regexp.MustCompile(`\b(\d{1,4})[/.-](\d{1,2})[/.-](\d{2,4})\b`)
+	writtenDateRe = regexp.MustCompile(`(?i)\b(?:(\d{1,2})(?:\s+de)?\s+([a-záéíóúñ]{3,})\.?(?:\s+de)?,?\s+(\d{4})|([a-z]{3,})\.?\s+(\d{1,2}),?\s+(\d{4}))\b`)
+
+	currencyCodeRe = regexp.MustCompile(`\b(EUR|USD|GBP|CHF|MXN|CAD|AUD|JPY|SEK|NOK|DKK|PLN)\b`)
+)
+
+// totalKeywords are what precede the total of a receipt. Those of the lines
+// which also have an excludedKeyword, like subtotals, are left out.
+var (
+	totalKeywords    = []string{"total", "importe", "amount due", "balance due", "a pagar"}
+	excludedKeywords = []string{"subtotal", "sub-total", "sub total", "total sin", "base imponible", "total iva", "total tax", "total vat", "artículos", "articulos", "items", "unidades"}
+)
+
+// Vendors are usually in the first lines, but so are the kind of document and
+// contact details.
+var nonVendorRe = regexp.MustCompile(`(?i)\b(?:factura|invoice|receipt|recibo|ticket|tel|tlf|fax|cif|nif|vat|www|fecha|date)\b|@|http`)
+
+// currencies are the currencies the rules recognise with their symbols, in
+// the order they're picked when they're mentioned as much.
+var currencies = []struct {
+	code    string
+	symbols []string
+}{
+	{code: "EUR", symbols: []string{"€"}},
+	{code: "USD", symbols: []string{"US$", "$"}},
+	{code: "GBP", symbols: []string{"£"}},
+	{code: "MXN", symbols: []string{"MX$"}},
+	{code: "CHF"},
+	{code: "CAD", symbols: []string{"C$"}},
+	{code: "AUD", symbols: []string{"A$"}},
+	{code: "JPY", symbols: []string{"¥"}},
+	{code: "SEK"},
+	{code: "NOK"},
+	{code: "DKK"},
+	{code: "PLN", symbols: []string{"zł"}},
+}
+
+var monthNames = map[string]time.Month{
+	"jan": time.January, "ene": time.January,
+	"feb": time.February,
+	"mar": time.March,
+	"apr": time.April, "abr": time.April,
+	"may": time.May,
+	"jun": time.June,
+	"jul": time.July,
+	"aug": time.August, "ago": time.August,
+	"sep": time.September, "set": time.September,
+	"oct": time.October,
+	"nov": time.November,
+	"dec": time.December, "dic": time.December,
+}
+
+// ParseText reads a receipt from its text with rules instead of a model: the
+// total from the lines with keywords like TOTAL, IMPORTE or "Amount due",
+// the date, the currency from its symbol or code and the vendor from the
+// header. The confidence, between 0 and 1, tells how much of that it found.
+func ParseText(text string) (*Receipt, float64) {
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+
+	var receipt Receipt
+	var confidence float64
+
+	currency, ok := findCurrency(text)
+	if ok {
+		confidence += currencyWeight
+	}
+	receipt.Currency = currency
+
+	if total, ok := findTotal(lines); ok {
+		receipt.Amount = total
+		confidence += totalWeight
+	} else if amount, ok := largestAmount(lines); ok {
+		receipt.Amount = amount
+		confidence += amountWeight
+	}
+
+	// Ambiguous dates are read as in the US only when paid in dollars.
+	if date, ok := findDate(lines, currency == "USD"); ok {
+		receipt.PurchaseDate = date.Format("02/01/2006")
+		confidence += dateWeight
+	}
+
+	if vendor, ok := findVendor(lines); ok {
+		receipt.Vendor = vendor
+		confidence += vendorWeight
+	}
+
+	// Guessing the currency would be making it up, so the receipts without
+	// one are never trusted, however much else was found.
+	if currency == "" {
+		confidence = min(confidence, MinConfidence-currencyWeight)
+	}
+
+	receipt.Items = []LineItem{}
+	return &receipt, min(confidence, 1)
+}
+
+// findTotal returns the largest amount of the lines with a total keyword,
+// since the smaller ones are usually taxes or discounts. When the amount
+// isn't on the same line as the keyword, it's looked for in the next.
+func findTotal(lines []string) (float64, bool) {
+	var total float64
+	var found bool
+
+	for i, line := range lines {
+		lower := strings.ToLower(line)
+		if !containsAny(lower, totalKeywords) || containsAny(lower, excludedKeywords) {
+			continue
+		}
+
+		amounts := findAmounts(line)
+		if len(amounts) == 0 && i+1 < len(lines) {
+			amounts = findAmounts(lines[i+1])
+		}
+
+		for _, amount := range amounts {
+			if amount > total {
+				total, found = amount, true
+			}
+		}
+	}
+
+	return total, found
+}
+
+func largestAmount(lines []string) (float64, bool) {
+	var largest float64
+	for _, line := range lines {
+		for _, amount := range findAmounts(line) {
+			largest = max(largest, amount)
+		}
+	}
+
+	return largest, largest > 0
+}
+
+// findAmounts returns the amounts with decimals of the line. Whole numbers
+// are left out, since they're usually quantities, references or phone
+// numbers.
+func findAmounts(line string) []float64 {
+	// Dates have numbers which look like amounts.
+	line = numericDateRe.ReplaceAllString(line, "")
+
+	var amounts []float64
+	for _, match := range moneyRe.FindAllString(line, -1) {
+		amount, ok := parseAmount(match)
+		if ok && amount > 0 {
+			amounts = append(amounts, amount)
+		}
+	}
+
+	return amounts
+}
+
+// parseAmount reads both 1.234,56 and 1,234.56: the last separator followed
+// by one or two digits is the decimal one, and the rest separate thousands.
+func parseAmount(s string) (float64, bool) {
+	decimal := strings.LastIndexAny(s, ".,")
+	if decimal == -1 || len(s)-decimal-1 > 2 {
+		return 0, false
+	}
+
+	whole := strings.NewReplacer(".", "", ",", "").Replace(s[:decimal])
+	amount, err := strconv.ParseFloat(whole+"."+s[decimal+1:], 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return amount, true
+}
+
+// findCurrency returns the most mentioned currency of the text, or an empty
+// string when there's none.
+func findCurrency(text string) (string, bool) {
+	codes := map[string]int{}
+	for _, code := range currencyCodeRe.FindAllString(text, -1) {
+		codes[code]++
+	}
+
+	// Symbols like MX$ contain others, so they're removed once counted.
+	counts := map[string]int{}
+	for i := len(currencies) - 1; i >= 0; i-- {
+		c := currencies[i]
+		counts[c.code] = codes[c.code]
+		for _, symbol := range c.symbols {
+			counts[c.code] += strings.Count(text, symbol)
+			text = strings.ReplaceAll(text, symbol, "")
+		}
+	}
+
+	currency, mentions := "", 0
+	for _, c := range currencies {
+		if counts[c.code] > mentions {
+			currency, mentions = c.code, counts[c.code]
+		}
+	}
+
+	return currency, currency != ""
+}
+
+// findDate returns the first valid date of the text. Numeric dates are read
+// day first, like in Europe, unless that makes no sense or usFirst is set and
+// both readings do.
+func findDate(lines []string, usFirst bool) (time.Time, bool) {
+	for _, line := range lines {
+		for _, m := range numericDateRe.FindAllStringSubmatch(line, -1) {
+			if date, ok := numericDate(m[1], m[2], m[3], usFirst); ok {
+				return date, true
+			}
+		}
+
+		for _, m := range writtenDateRe.FindAllStringSubmatch(line, -1) {
+			day, month, year := m[1], m[2], m[3]
+			if day == "" {
+				day, month, year = m[5], m[4], m[6]
+			}
+
+			if date, ok := writtenDate(day, month, year); ok {
+				return date, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func numericDate(a, b, c string, usFirst bool) (time.Time, bool) {
+	x, _ := strconv.Atoi(a)
+	y, _ := strconv.Atoi(b)
+	z, _ := strconv.Atoi(c)
+
+	// 2024-05-31
+	if len(a) == 4 {
+		return newDate(x, y, z)
+	}
+
+	if len(c) == 2 {
+		z += 2000
+	} else if len(c) != 4 {
+		return time.Time{}, false
+	}
+
+	if usFirst {
+		if date, ok := newDate(z, x, y); ok {
+			return date, true
+		}
+	}
+
+	if date, ok := newDate(z, y, x); ok {
+		return date, true
+	}
+
+	return newDate(z, x, y)
+}
+
+func writtenDate(day, month, year string) (time.Time, bool) {
+	m, ok := monthNames[strings.ToLower(month)[:3]]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	d, _ := strconv.Atoi(day)
+	y, _ := strconv.Atoi(year)
+	return newDate(y, int(m), d)
+}
+
+// newDate rejects the dates which don't exist, instead of normalising them
+// like time.Date, and those too far away to be of a receipt.
+func newDate(year, month, day int) (time.Time, bool) {
+	if year < 1990 || year > 2100 || month < 1 || month > 12 || day < 1 {
+		return time.Time{}, false
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+// findVendor returns the first of the header lines which reads like a name.
+func findVendor(lines []string) (string, bool) {
+	seen := 0
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		seen++
+		if seen > 5 {
+			break
+		}
+
+		if nonVendorRe.MatchString(line) || numericDateRe.MatchString(line) {
+			continue
+		}
+
+		var letters, digits int
+		for _, r := range line {
+			switch {
+			case r >= '0' && r <= '9':
+				digits++
+			case strings.ContainsRune(" .,-&'/()", r):
+			default:
+				letters++
+			}
+		}
+
+		if letters >= 3 && digits <= letters/2 {
+			return line, true
+		}
+	}
+
+	return "", false
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+
+	return false
+}
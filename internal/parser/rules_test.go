@@ -0,0 +1,135 @@
+package parser_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/parser"
+	"github.com/manzanit0/mcduck/pkg/pdfgen"
+)
+
+func TestParseText(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		text     string
+		amount   float64
+		currency string
+		date     string
+		vendor   string
+	}{
+		{
+			desc: "spanish ticket",
+			text: `MERCADONA, S.A.
+C/ Valencia 5, Madrid
+NIF A-46103834
+Fecha: 03/05/2024 18:22
+LECHE ENTERA 2 1,80
+PAN 1,20
+BASE IMPONIBLE 2,73
+TOTAL IVA 0,27
+TOTAL (€) 3,00`,
+			amount:   3,
+			currency: "EUR",
+			date:     "03/05/2024",
+			vendor:   "MERCADONA, S.A.",
+		},
+		{
+			desc: "invoice with the total on the next line",
+			text: `Factura F-2024/118
+Hotel Miramar
+Paseo Marítimo 1
+15 de marzo de 2024
+Importe total
+1.234,56 EUR`,
+			amount:   1234.56,
+			currency: "EUR",
+			date:     "15/03/2024",
+			vendor:   "Hotel Miramar",
+		},
+		{
+			desc: "american invoice",
+			text: `Acme Corp
+Invoice #4411
+Date: 04/11/2024
+Subtotal $1,180.00
+Tax $94.40
+Amount due $1,274.40`,
+			amount:   1274.4,
+			currency: "USD",
+			date:     "11/04/2024",
+			vendor:   "Acme Corp",
+		},
+		{
+			desc: "written english date",
+			text: `The Coffee House
+May 31, 2024
+Flat white 3.50
+Total GBP 3.50`,
+			amount:   3.5,
+			currency: "GBP",
+			date:     "31/05/2024",
+			vendor:   "The Coffee House",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			receipt, confidence := parser.ParseText(tC.text)
+			assert.InDelta(t, tC.amount, receipt.Amount, 0.001)
+			assert.Equal(t, tC.currency, receipt.Currency)
+			assert.Equal(t, tC.date, receipt.PurchaseDate)
+			assert.Equal(t, tC.vendor, receipt.Vendor)
+			assert.GreaterOrEqual(t, confidence, parser.MinConfidence)
+		})
+	}
+
+	t.Run("text without a currency isn't trusted", func(t *testing.T) {
+		receipt, confidence := parser.ParseText(`Walgreens
+Date: 04/11/2024
+Shampoo 8.99
+Total 12.99`)
+		assert.InDelta(t, 12.99, receipt.Amount, 0.001)
+		assert.Equal(t, "Walgreens", receipt.Vendor)
+		assert.Empty(t, receipt.Currency)
+		assert.Less(t, confidence, parser.MinConfidence)
+	})
+
+	t.Run("text without a total isn't trusted", func(t *testing.T) {
+		receipt, confidence := parser.ParseText("Thank you for your visit\n2 x 4.50\n")
+		assert.InDelta(t, 4.5, receipt.Amount, 0.001)
+		assert.Less(t, confidence, parser.MinConfidence)
+	})
+}
+
+func TestReadPDFText(t *testing.T) {
+	doc := pdfgen.New()
+	page := doc.AddPage()
+	page.Text(40, 800, 14, true, "Renfe Viajeros")
+	page.Text(40, 780, 10, false, "Madrid - Valencia 02/05/2024")
+	page.Text(40, 760, 10, false, "TOTAL")
+	page.TextRight(300, 760, 10, true, "35,40 €")
+
+	var buf bytes.Buffer
+	_, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+
+	text, err := parser.ReadPDFText(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "Renfe Viajeros\nMadrid - Valencia 02/05/2024\nTOTAL 35,40 €", text)
+
+	t.Run("scanned documents have no text", func(t *testing.T) {
+		doc := pdfgen.New()
+		doc.AddPage()
+
+		var buf bytes.Buffer
+		_, err := doc.WriteTo(&buf)
+		require.NoError(t, err)
+
+		text, err := parser.ReadPDFText(buf.Bytes())
+		require.NoError(t, err)
+		assert.Empty(t, text)
+	})
+}
@@ -0,0 +1,183 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/text/currency"
+
+	"github.com/manzanit0/mcduck/pkg/llm"
+)
+
+// maxRepairs is how many times the model is asked to fix an invalid answer
+// before giving up on it.
+const maxRepairs = 1
+
+// receiptSchema is the JSON schema of the answer the prompt asks for.
+var receiptSchema = llm.Schema{
+	Name:        "receipt",
+	Description: "The contents of a receipt.",
+	Definition: json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "amount": {"type": "number", "description": "The total price paid."},
+    "currency": {"type": "string", "description": "The ISO 4217 code of the currency."},
+    "description": {"type": "string"},
+    "vendor": {"type": "string"},
+    "purchase_date": {"type": "string", "description": "The purchase date in the dd/MM/yyyy format."},
+    "items": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "quantity": {"type": "number"},
+          "unit_price": {"type": "number"},
+          "total": {"type": "number"},
+          "category": {"type": "string"}
+        },
+        "required": ["name", "quantity", "unit_price", "total", "category"]
+      }
+    },
+    "text": {"type": "string"},
+    "subtotal": {"type": "number"},
+    "taxes": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "rate": {"type": "number"},
+          "base": {"type": "number"},
+          "amount": {"type": "number"}
+        },
+        "required": ["rate", "base", "amount"]
+      }
+    },
+    "vendor_tax_id": {"type": "string"},
+    "invoice_number": {"type": "string"}
+  },
+  "required": ["amount", "currency", "description", "vendor", "items"]
+}`),
+}
+
+// ValidationError tells what's wrong with a receipt. The parsers return it
+// when the model still gets the receipt wrong after being asked to fix it.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid receipt: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks that the receipt has what's needed to record an expense
+// from it: a positive amount, an ISO 4217 currency and a vendor. The purchase
+// date is optional, since not all receipts show it, but it has to be a date.
+func (r Receipt) Validate() error {
+	var problems []string
+
+	if r.Amount <= 0 {
+		problems = append(problems, fmt.Sprintf("amount must be positive, but it's %v", r.Amount))
+	}
+
+	if _, err := currency.ParseISO(r.Currency); err != nil || len(r.Currency) != 3 || strings.ToUpper(r.Currency) != r.Currency {
+		problems = append(problems, fmt.Sprintf("currency must be an ISO 4217 code, like EUR, but it's %q", r.Currency))
+	}
+
+	if r.PurchaseDate != "" {
+		if _, err := time.Parse("02/01/2006", r.PurchaseDate); err != nil {
+			problems = append(problems, fmt.Sprintf("purchase_date must be in the dd/MM/yyyy format, but it's %q", r.PurchaseDate))
+		}
+	}
+
+	if strings.TrimSpace(r.Vendor) == "" {
+		problems = append(problems, "vendor must not be empty")
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+// chatForReceipt asks the model for the receipt, enforcing its schema. When
+// the answer isn't a valid receipt, the model is told what's wrong with it
+// and asked again.
+func chatForReceipt(ctx context.Context, client llm.Client, req llm.Request) (*Receipt, *llm.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	req.Schema = &receiptSchema
+
+	var usage llm.Usage
+	for attempt := 0; ; attempt++ {
+		response, err := client.Chat(ctx, req)
+		if err != nil {
+			return nil, response, fmt.Errorf("chat with llm: %w", err)
+		}
+
+		// The cost of the receipt is that of every attempt.
+		usage.InputTokens += response.Usage.InputTokens
+		usage.OutputTokens += response.Usage.OutputTokens
+		response.Usage = usage
+
+		receipt, err := receiptFromResponse(response)
+		if err == nil {
+			return receipt, response, nil
+		}
+
+		var invalid *ValidationError
+		if !errors.As(err, &invalid) || attempt == maxRepairs {
+			return nil, response, err
+		}
+
+		span.AddEvent("repairing receipt", trace.WithAttributes(attribute.String("error", err.Error())))
+
+		// The caller's messages are left as they are.
+		req.Messages = append(req.Messages[:len(req.Messages):len(req.Messages)], llm.AssistantMessage(response.Text), llm.UserMessage(llm.Text(repairPrompt(invalid))))
+	}
+}
+
+func repairPrompt(invalid *ValidationError) string {
+	return "Your answer isn't valid:\n\n- " + strings.Join(invalid.Problems, "\n- ") +
+		"\n\nAnswer again with the corrected JSON and nothing else. If the receipt doesn't show something, read it again carefully."
+}
+
+// receiptFromResponse reads the receipt from the answer of the model, which
+// may still wrap the JSON in markdown or text despite being asked not to.
+func receiptFromResponse(response *llm.Response) (*Receipt, error) {
+	j := extractJSON(response.Text)
+
+	var receipt Receipt
+	err := json.Unmarshal([]byte(j), &receipt)
+	if err != nil {
+		return nil, &ValidationError{Problems: []string{fmt.Sprintf("the answer must be a JSON object: %s", err.Error())}}
+	}
+
+	receipt.Currency = strings.ToUpper(strings.TrimSpace(receipt.Currency))
+	receipt.Vendor = strings.TrimSpace(receipt.Vendor)
+
+	err = receipt.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &receipt, nil
+}
+
+// extractJSON returns the JSON object within the text, from its first to its
+// last brace.
+func extractJSON(s string) string {
+	start := strings.Index(s, "{")
+	end := strings.LastIndex(s, "}")
+	if start == -1 || end < start {
+		return s
+	}
+
+	return s[start : end+1]
+}
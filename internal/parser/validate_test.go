@@ -0,0 +1,83 @@
+package parser_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/parser"
+	"github.com/manzanit0/mcduck/pkg/llm"
+)
+
+// scriptedClient answers with its answers in order, and records the requests.
+type scriptedClient struct {
+	answers  []string
+	requests []llm.Request
+}
+
+func (c *scriptedClient) Chat(_ context.Context, req llm.Request) (*llm.Response, error) {
+	c.requests = append(c.requests, req)
+	if len(c.requests) > len(c.answers) {
+		return nil, errors.New("no more answers")
+	}
+
+	return &llm.Response{Text: c.answers[len(c.requests)-1], Usage: llm.Usage{InputTokens: 10, OutputTokens: 5}}, nil
+}
+
+func TestValidate(t *testing.T) {
+	valid := parser.Receipt{Amount: 12.5, Currency: "EUR", Vendor: "Mercadona", PurchaseDate: "03/05/2024"}
+	assert.NoError(t, valid.Validate())
+
+	noDate := valid
+	noDate.PurchaseDate = ""
+	assert.NoError(t, noDate.Validate())
+
+	invalid := parser.Receipt{Amount: -3, Currency: "€", Vendor: " ", PurchaseDate: "2024-05-03"}
+	err := invalid.Validate()
+
+	var validationErr *parser.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Len(t, validationErr.Problems, 4)
+}
+
+func TestExtractReceiptRepairs(t *testing.T) {
+	t.Run("invalid answers are fixed by the model", func(t *testing.T) {
+		client := &scriptedClient{answers: []string{
+			`Sure! Here it is: {"amount": 12,50, "currency": "EUR", "vendor": "Mercadona"}`,
+			"```json\n{\"amount\": 12.50, \"currency\": \"eur\", \"vendor\": \"Mercadona\", \"items\": []}\n```",
+		}}
+
+		receipt, response, err := parser.NewTextParser(client).ExtractReceipt(context.Background(), []byte("MERCADONA TOTAL 12,50"))
+		require.NoError(t, err)
+
+		assert.InDelta(t, 12.5, receipt.Amount, 0.001)
+		assert.Equal(t, "EUR", receipt.Currency)
+		assert.Equal(t, 20, response.Usage.InputTokens)
+
+		require.Len(t, client.requests, 2)
+		assert.NotNil(t, client.requests[0].Schema)
+		assert.Len(t, client.requests[0].Messages, 1)
+
+		repair := client.requests[1].Messages
+		require.Len(t, repair, 3)
+		assert.Equal(t, llm.RoleAssistant, repair[1].Role)
+		assert.Contains(t, repair[2].Content[0].Text, "the answer must be a JSON object")
+	})
+
+	t.Run("answers which are still invalid fail", func(t *testing.T) {
+		client := &scriptedClient{answers: []string{
+			`{"amount": 0, "currency": "EUR", "vendor": "Mercadona"}`,
+			`{"amount": 0, "currency": "EUR", "vendor": "Mercadona"}`,
+		}}
+
+		_, _, err := parser.NewTextParser(client).ExtractReceipt(context.Background(), []byte("MERCADONA"))
+
+		var validationErr *parser.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"amount must be positive, but it's 0"}, validationErr.Problems)
+		assert.Len(t, client.requests, 2)
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,7 +31,7 @@ func NewDBContainer(ctx context.Context) (*postgres.PostgresContainer, error) {
 
 	container, err := postgres.Run(ctx,
 		"docker.io/postgres:15.8-alpine3.20",
-		postgres.WithInitScripts(migrations...),
+		withMigrations(migrations),
 		postgres.WithDatabase(dbName),
 		postgres.WithUsername(dbUser),
 		postgres.WithPassword(dbPassword),
@@ -44,6 +47,25 @@ func NewDBContainer(ctx context.Context) (*postgres.PostgresContainer, error) {
 	return container, nil
 }
 
+// withMigrations copies the migrations as init scripts. Postgres runs them in
+// alphabetical order, so they're prefixed with their zero-padded position to
+// keep V10 from running before V2.
+func withMigrations(migrations []string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) error {
+		for i, migration := range migrations {
+			req.Files = append(req.Files, testcontainers.ContainerFile{
+				HostFilePath:      migration,
+				ContainerFilePath: fmt.Sprintf("/docker-entrypoint-initdb.d/%04d_%s", i, filepath.Base(migration)),
+				FileMode:          0o755,
+			})
+		}
+
+		return nil
+	}
+}
+
+// GetMigrationsFiles returns the paths of the migrations sorted by their
+// version, the same way Flyway applies them.
 func GetMigrationsFiles() ([]string, error) {
 	var migrationsFiles []string
 	files, err := os.ReadDir(migrationsDirRelativePath)
@@ -51,11 +73,39 @@ func GetMigrationsFiles() ([]string, error) {
 		return nil, fmt.Errorf("read migrations dir: %w", err)
 	}
 
+	versions := map[string]int{}
 	for _, file := range files {
-		if strings.HasSuffix(file.Name(), ".sql") {
-			migrationsFiles = append(migrationsFiles, migrationsDirRelativePath+file.Name())
+		if !strings.HasSuffix(file.Name(), ".sql") {
+			continue
+		}
+
+		version, err := migrationVersion(file.Name())
+		if err != nil {
+			return nil, err
 		}
+
+		path := migrationsDirRelativePath + file.Name()
+		versions[path] = version
+		migrationsFiles = append(migrationsFiles, path)
 	}
 
+	sort.Slice(migrationsFiles, func(i, j int) bool {
+		return versions[migrationsFiles[i]] < versions[migrationsFiles[j]]
+	})
+
 	return migrationsFiles, nil
 }
+
+func migrationVersion(name string) (int, error) {
+	version, _, ok := strings.Cut(strings.TrimPrefix(name, "V"), "__")
+	if !ok {
+		return 0, fmt.Errorf("migration %s doesn't follow the V<version>__<description>.sql format", name)
+	}
+
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return 0, fmt.Errorf("parse version of migration %s: %w", name, err)
+	}
+
+	return v, nil
+}
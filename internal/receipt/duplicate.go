@@ -0,0 +1,160 @@
+package receipt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/internal/imaging"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// DuplicateReason tells why a receipt is considered a duplicate of another.
+type DuplicateReason string
+
+const (
+	// DuplicateSameFile is the exact same file uploaded twice.
+	DuplicateSameFile DuplicateReason = "same_file"
+	// DuplicateSimilarImage is a picture which looks like the other one, like
+	// the same receipt photographed twice.
+	DuplicateSimilarImage DuplicateReason = "similar_image"
+	// DuplicateSameDetails is a receipt from the same vendor, on the same day
+	// and for the same amount.
+	DuplicateSameDetails DuplicateReason = "same_details"
+)
+
+// Duplicate is the existing receipt which a new one is a likely duplicate of.
+type Duplicate struct {
+	ReceiptID int64
+	Reason    DuplicateReason
+}
+
+// FindDuplicateImage looks among the receipts visible to the user for one with
+// the same file or, when the perceptual hash is known, one which looks like it.
+// It returns nil when there's none.
+func (r *Repository) FindDuplicateImage(ctx context.Context, email string, image []byte, hash *uint64) (*Duplicate, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find Duplicate Receipt Image")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("id").
+		From("receipts").
+		Where(sq.And{household.VisibleTo(email), sq.Eq{"image_key": blob.Key(image)}}).
+		OrderBy("id ASC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var id int64
+	err = r.dbx.GetContext(ctx, &id, query, args...)
+	if err == nil {
+		return &Duplicate{ReceiptID: id, Reason: DuplicateSameFile}, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("select receipts with same file: %w", err)
+	}
+
+	if hash == nil {
+		return nil, nil
+	}
+
+	// Hashes are compared in Go since Postgres has no cheap way to count the
+	// differing bits. The amount of receipts of a household keeps this small.
+	query, args, err = psql.
+		Select("id", "image_hash").
+		From("receipts").
+		Where(sq.And{household.VisibleTo(email), sq.NotEq{"image_hash": nil}}).
+		OrderBy("id ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var candidates []struct {
+		ID   int64 `db:"id"`
+		Hash int64 `db:"image_hash"`
+	}
+	err = r.dbx.SelectContext(ctx, &candidates, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select receipt hashes: %w", err)
+	}
+
+	var closest *Duplicate
+	closestDistance := imaging.SimilarityThreshold + 1
+	for _, c := range candidates {
+		distance := imaging.Distance(*hash, uint64(c.Hash))
+		if distance < closestDistance {
+			closest = &Duplicate{ReceiptID: c.ID, Reason: DuplicateSimilarImage}
+			closestDistance = distance
+		}
+	}
+
+	return closest, nil
+}
+
+// FindDuplicateDetails looks among the receipts visible to the user for one
+// from the same vendor, on the same day and whose expenses add up to the same
+// amount. It returns nil when there's none.
+func (r *Repository) FindDuplicateDetails(ctx context.Context, email, vendor string, date time.Time, amount float64) (*Duplicate, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find Duplicate Receipt Details")
+	defer span.End()
+
+	vendor = strings.TrimSpace(vendor)
+	if vendor == "" || amount <= 0 {
+		return nil, nil
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id").
+		From("receipts").
+		Where(sq.And{
+			household.VisibleTo(email),
+			sq.Expr("LOWER(TRIM(vendor)) = LOWER(?)", vendor),
+			sq.Expr("receipt_date::date = ?::date", date),
+			sq.Expr("(SELECT COALESCE(SUM(e.amount), 0) FROM expenses e WHERE e.receipt_id = receipts.id) = ?", expense.ConvertToCents(float32(amount))),
+		}).
+		OrderBy("id ASC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var id int64
+	err = r.dbx.GetContext(ctx, &id, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("select receipts with same details: %w", err)
+	}
+
+	return &Duplicate{ReceiptID: id, Reason: DuplicateSameDetails}, nil
+}
+
+func (r *dbReceipt) mapDuplicate() *Duplicate {
+	if r.DuplicateOf == nil || r.DuplicateReason == nil {
+		return nil
+	}
+
+	return &Duplicate{ReceiptID: *r.DuplicateOf, Reason: DuplicateReason(*r.DuplicateReason)}
+}
+
+// columns returns the values of the duplicate_of and duplicate_reason columns.
+func (d *Duplicate) columns() (*int64, *string) {
+	if d == nil {
+		return nil, nil
+	}
+
+	reason := string(d.Reason)
+	return &d.ReceiptID, &reason
+}
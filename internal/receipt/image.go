@@ -0,0 +1,146 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/blob"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// ImageSize selects which copy of the receipt image to serve.
+type ImageSize string
+
+const (
+	ImageOriginal  ImageSize = "original"
+	ImageLarge     ImageSize = "large"
+	ImageThumbnail ImageSize = "thumbnail"
+)
+
+func (s ImageSize) Valid() bool {
+	return s == ImageOriginal || s == ImageLarge || s == ImageThumbnail
+}
+
+// GetReceiptImageKey returns the blob key of the image in the given size. Sizes
+// which don't exist for the receipt, like thumbnails of PDFs, fall back to the
+// original. The key is empty for receipts whose image hasn't been moved to the
+// blob store yet.
+func (r *Repository) GetReceiptImageKey(ctx context.Context, receiptID uint64, size ImageSize) (string, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Receipt Image Key")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("image_key", "large_key", "thumbnail_key").
+		From("receipts").
+		Where(sq.Eq{"id": receiptID}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("compile query: %w", err)
+	}
+
+	var receipt dbReceipt
+	err = r.dbx.GetContext(ctx, &receipt, query, args...)
+	if err != nil {
+		return "", fmt.Errorf("select receipts: %w", err)
+	}
+
+	switch {
+	case size == ImageLarge && receipt.LargeKey != nil:
+		return *receipt.LargeKey, nil
+	case size == ImageThumbnail && receipt.ThumbnailKey != nil:
+		return *receipt.ThumbnailKey, nil
+	case receipt.ImageKey != nil:
+		return *receipt.ImageKey, nil
+	default:
+		return "", nil
+	}
+}
+
+// GetImage returns the image stored under the key.
+func (r *Repository) GetImage(ctx context.Context, key string) ([]byte, error) {
+	image, err := r.blobs.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get image: %w", err)
+	}
+
+	return image, nil
+}
+
+// putImage stores the image and returns its key, or nil when there's no image.
+func (r *Repository) putImage(ctx context.Context, image []byte) (*string, error) {
+	if len(image) == 0 {
+		return nil, nil
+	}
+
+	key := blob.Key(image)
+	err := r.blobs.Put(ctx, key, image)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// putImages stores the original image and its copies and returns their keys.
+// They're locked until the transaction, which inserts the receipt, ends, so
+// that deleting another receipt of the same file in between doesn't find them
+// unreferenced and delete them. Since keys are content-addressed, a blob left
+// behind by a failed insert is reused by the next upload of the same file.
+func (r *Repository) putImages(ctx context.Context, txn *sqlx.Tx, image, large, thumbnail []byte) (imageKey, largeKey, thumbnailKey *string, err error) {
+	var keys []string
+	for _, data := range [][]byte{image, large, thumbnail} {
+		if len(data) > 0 {
+			keys = append(keys, blob.Key(data))
+		}
+	}
+
+	err = lockImages(ctx, txn, keys...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	imageKey, err = r.putImage(ctx, image)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("store receipt image: %w", err)
+	}
+
+	largeKey, err = r.putImage(ctx, large)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("store large receipt image: %w", err)
+	}
+
+	thumbnailKey, err = r.putImage(ctx, thumbnail)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("store receipt thumbnail: %w", err)
+	}
+
+	return imageKey, largeKey, thumbnailKey, nil
+}
+
+// lockImages takes the advisory locks of the images until the transaction
+// ends. They're taken in order so that two transactions locking the same
+// images don't deadlock.
+func lockImages(ctx context.Context, txn *sqlx.Tx, keys ...string) error {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+
+	for _, key := range slices.Compact(keys) {
+		query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Select().
+			Column(sq.Expr("pg_advisory_xact_lock(hashtext(?))", key)).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("unable to build query: %w", err)
+		}
+
+		_, err = txn.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("lock image %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// Split decides how the amount of a receipt is turned into expenses.
+type Split int
+
+const (
+	// SplitNone creates a single expense with the total of the receipt.
+	SplitNone Split = iota
+	// SplitPerItem creates one expense per line item.
+	SplitPerItem
+	// SplitPerCategory creates one expense per group of items which share the
+	// same category.
+	SplitPerCategory
+)
+
+// reconciliationTolerance is how far apart, in cents, the sum of the items and
+// the total of the receipt can be, since per-item prices are often rounded.
+const reconciliationTolerance = 1
+
+const defaultItemCategory = "Other"
+
+type Item struct {
+	ID        int64
+	ReceiptID int64
+	Name      string
+	Quantity  float64
+	UnitPrice float32
+	Total     float32
+	Category  string
+}
+
+type dbItem struct {
+	ID        int64   `db:"id"`
+	ReceiptID int64   `db:"receipt_id"`
+	Position  int     `db:"position"`
+	Name      string  `db:"item_name"`
+	Quantity  float64 `db:"quantity"`
+	UnitPrice int32   `db:"unit_price"`
+	Total     int32   `db:"total"`
+	Category  *string `db:"category"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (i *dbItem) MapItem() Item {
+	var category string
+	if i.Category != nil {
+		category = *i.Category
+	}
+
+	return Item{
+		ID:        i.ID,
+		ReceiptID: i.ReceiptID,
+		Name:      i.Name,
+		Quantity:  i.Quantity,
+		UnitPrice: expense.ConvertToDollar(i.UnitPrice),
+		Total:     expense.ConvertToDollar(i.Total),
+		Category:  category,
+	}
+}
+
+// ItemsReconcile reports whether the line items add up to the total amount of
+// the receipt. Receipts without items never reconcile.
+func ItemsReconcile(amount float32, items []Item) bool {
+	if len(items) == 0 {
+		return false
+	}
+
+	var sum int32
+	for _, item := range items {
+		sum += expense.ConvertToCents(item.Total)
+	}
+
+	diff := sum - expense.ConvertToCents(amount)
+	return diff <= reconciliationTolerance && diff >= -reconciliationTolerance
+}
+
+// splitExpenses builds the expenses for a receipt according to the split mode.
+// Since the items may be off by a cent from the total, the difference is
+// absorbed by the last expense so that the expenses always add up to the amount
+// of the receipt.
+func splitExpenses(input CreateReceiptRequest, receiptID int64) []expense.Expense {
+	single := expense.Expense{
+		ReceiptID:   uint64(receiptID),
+		Date:        input.Date,
+		Amount:      float32(input.Amount),
+		UserEmail:   input.Email,
+		Description: input.Description,
+		Category:    "Receipt Upload",
+	}
+
+	if input.Split == SplitNone || !ItemsReconcile(float32(input.Amount), input.Items) {
+		return []expense.Expense{single}
+	}
+
+	var expenses []expense.Expense
+	switch input.Split {
+	case SplitPerItem:
+		for _, item := range input.Items {
+			e := single
+			e.Amount = item.Total
+			e.Subcategory = itemCategory(item)
+			e.Description = item.Name
+			expenses = append(expenses, e)
+		}
+
+	case SplitPerCategory:
+		groups := map[string][]Item{}
+		for _, item := range input.Items {
+			groups[itemCategory(item)] = append(groups[itemCategory(item)], item)
+		}
+
+		categories := make([]string, 0, len(groups))
+		for category := range groups {
+			categories = append(categories, category)
+		}
+		sort.Strings(categories)
+
+		for _, category := range categories {
+			var cents int32
+			names := make([]string, len(groups[category]))
+			for i, item := range groups[category] {
+				cents += expense.ConvertToCents(item.Total)
+				names[i] = item.Name
+			}
+
+			e := single
+			e.Amount = expense.ConvertToDollar(cents)
+			e.Subcategory = category
+			e.Description = truncate(strings.Join(names, ", "), 255)
+			expenses = append(expenses, e)
+		}
+
+	default:
+		return []expense.Expense{single}
+	}
+
+	var sum int32
+	for _, e := range expenses {
+		sum += expense.ConvertToCents(e.Amount)
+	}
+
+	last := &expenses[len(expenses)-1]
+	last.Amount = expense.ConvertToDollar(expense.ConvertToCents(last.Amount) + expense.ConvertToCents(float32(input.Amount)) - sum)
+
+	return expenses
+}
+
+func insertItems(ctx context.Context, txn *sqlx.Tx, receiptID int64, items []Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	builder := psql.
+		Insert("receipt_items").
+		Columns("receipt_id", "position", "item_name", "quantity", "unit_price", "total", "category")
+
+	for i, item := range items {
+		quantity := item.Quantity
+		if quantity <= 0 {
+			quantity = 1
+		}
+
+		builder = builder.Values(
+			receiptID,
+			i,
+			truncate(item.Name, 255),
+			quantity,
+			expense.ConvertToCents(item.UnitPrice),
+			expense.ConvertToCents(item.Total),
+			itemCategory(item),
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListReceiptItems(ctx context.Context, receiptID uint64) ([]Item, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Receipt Items")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("id", "receipt_id", "position", "item_name", "quantity", "unit_price", "total", "category").
+		From("receipt_items").
+		Where(sq.Eq{"receipt_id": receiptID}).
+		OrderBy("position ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var items []dbItem
+	err = r.dbx.SelectContext(ctx, &items, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select receipt items: %w", err)
+	}
+
+	domainItems := make([]Item, len(items))
+	for i, item := range items {
+		domainItems[i] = item.MapItem()
+	}
+
+	return domainItems, nil
+}
+
+func itemCategory(item Item) string {
+	category := strings.TrimSpace(item.Category)
+	if category == "" {
+		return defaultItemCategory
+	}
+
+	return truncate(category, 255)
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n])
+}
@@ -0,0 +1,33 @@
+package receipt_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+)
+
+func TestItemsReconcile(t *testing.T) {
+	testCases := []struct {
+		amount float32
+		items  []receipt.Item
+		want   bool
+	}{
+		{amount: 5.5, items: nil, want: false},
+		{amount: 5.5, items: []receipt.Item{{Total: 2.25}, {Total: 3.25}}, want: true},
+		{amount: 5.5, items: []receipt.Item{{Total: 2.25}, {Total: 3.24}}, want: true},
+		{amount: 5.5, items: []receipt.Item{{Total: 2.25}, {Total: 3.26}}, want: true},
+		{amount: 5.5, items: []receipt.Item{{Total: 2.25}, {Total: 3.2}}, want: false},
+		{amount: 5.5, items: []receipt.Item{{Total: 5.5}, {Total: 1}}, want: false},
+		{amount: 0.3, items: []receipt.Item{{Total: 0.1}, {Total: 0.1}, {Total: 0.1}}, want: true},
+	}
+
+	for x, tC := range testCases {
+		t.Run(fmt.Sprintf("case %d", x), func(t *testing.T) {
+			got := receipt.ItemsReconcile(tC.amount, tC.items)
+			if got != tC.want {
+				t.Errorf("expected %t, got %t", tC.want, got)
+			}
+		})
+	}
+}
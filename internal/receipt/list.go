@@ -0,0 +1,216 @@
+package receipt
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// SortOrder is the order receipts are listed in. Ties are broken by ID, so
+// that pages are stable.
+type SortOrder string
+
+const (
+	// SortDateDesc lists the most recent receipts first. It's the default.
+	SortDateDesc   SortOrder = "date_desc"
+	SortDateAsc    SortOrder = "date_asc"
+	SortAmountDesc SortOrder = "amount_desc"
+	SortAmountAsc  SortOrder = "amount_asc"
+)
+
+// ErrInvalidCursor is returned when the cursor wasn't returned by a listing
+// with the same order.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ListReceiptsQuery filters the receipts visible to the user. Every filter is
+// optional.
+type ListReceiptsQuery struct {
+	Email string
+
+	// From is inclusive and To exclusive.
+	From *time.Time
+	To   *time.Time
+
+	// Statuses lists the receipts in any of them. When empty, every receipt
+	// but the archived ones is listed.
+	Statuses []Status
+
+	// Vendor lists the receipts whose vendor contains it, regardless of case.
+	Vendor string
+
+	// MinAmount and MaxAmount are inclusive bounds of the total of the
+	// expenses of the receipt.
+	MinAmount *float32
+	MaxAmount *float32
+
+	Order SortOrder
+
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+	// Limit is the size of the page. Zero lists all receipts.
+	Limit uint64
+}
+
+type ReceiptsPage struct {
+	Receipts []Receipt
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// cursor is the position of the last receipt of a page, in the order of the
+// listing.
+type cursor struct {
+	Order  SortOrder `json:"o"`
+	Date   string    `json:"d,omitempty"`
+	Amount int64     `json:"a,omitempty"`
+	ID     int64     `json:"id"`
+}
+
+func (c cursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string, order SortOrder) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c cursor
+	err = json.Unmarshal(data, &c)
+	if err != nil || c.Order != order {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+type dbListedReceipt struct {
+	dbReceipt
+	Total int64 `db:"total"`
+}
+
+// ListReceipts returns a page of the receipts which match the query.
+func (r *Repository) ListReceipts(ctx context.Context, q ListReceiptsQuery) (*ReceiptsPage, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Receipts")
+	defer span.End()
+
+	if q.Order == "" {
+		q.Order = SortDateDesc
+	}
+
+	const total = "COALESCE(totals.amount, 0)"
+
+	var sortColumn, direction, comparison string
+	switch q.Order {
+	case SortDateDesc:
+		sortColumn, direction, comparison = "receipt_date", "DESC", "<"
+	case SortDateAsc:
+		sortColumn, direction, comparison = "receipt_date", "ASC", ">"
+	case SortAmountDesc:
+		sortColumn, direction, comparison = total, "DESC", "<"
+	case SortAmountAsc:
+		sortColumn, direction, comparison = total, "ASC", ">"
+	default:
+		return nil, fmt.Errorf("unsupported sort order: %s", q.Order)
+	}
+
+	conditions := sq.And{household.VisibleTo(q.Email)}
+
+	if q.From != nil {
+		conditions = append(conditions, sq.GtOrEq{"receipt_date": *q.From})
+	}
+
+	if q.To != nil {
+		conditions = append(conditions, sq.Lt{"receipt_date": *q.To})
+	}
+
+	if len(q.Statuses) > 0 {
+		conditions = append(conditions, sq.Eq{"status": q.Statuses})
+	} else {
+		conditions = append(conditions, sq.NotEq{"status": StatusArchived})
+	}
+
+	if q.Vendor != "" {
+		conditions = append(conditions, sq.Expr("strpos(lower(vendor), lower(?)) > 0", q.Vendor))
+	}
+
+	if q.MinAmount != nil {
+		conditions = append(conditions, sq.Expr(total+" >= ?", expense.ConvertToCents(*q.MinAmount)))
+	}
+
+	if q.MaxAmount != nil {
+		conditions = append(conditions, sq.Expr(total+" <= ?", expense.ConvertToCents(*q.MaxAmount)))
+	}
+
+	if q.Cursor != "" {
+		c, err := decodeCursor(q.Cursor, q.Order)
+		if err != nil {
+			return nil, err
+		}
+
+		var value any = c.Date
+		if sortColumn == total {
+			value = c.Amount
+		}
+
+		conditions = append(conditions, sq.Expr(fmt.Sprintf("(%s, receipts.id) %s (?, ?)", sortColumn, comparison), value, c.ID))
+	}
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("receipts.id", "vendor", "status", "status_reason", "status_changed_at", "receipt_date", "duplicate_of", "duplicate_reason").
+		Column(total+" AS total").
+		From("receipts").
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT SUM(amount)::BIGINT AS amount
+			FROM expenses
+			WHERE receipt_id = receipts.id
+		) AS totals ON TRUE`).
+		Where(conditions).
+		OrderBy(fmt.Sprintf("%s %s", sortColumn, direction), fmt.Sprintf("receipts.id %s", direction))
+
+	// One more than asked for tells whether there's a next page.
+	if q.Limit > 0 {
+		builder = builder.Limit(q.Limit + 1)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var records []dbListedReceipt
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select receipts: %w", err)
+	}
+
+	page := ReceiptsPage{}
+	if q.Limit > 0 && uint64(len(records)) > q.Limit {
+		records = records[:q.Limit]
+
+		last := records[len(records)-1]
+		page.NextCursor = cursor{
+			Order:  q.Order,
+			Date:   last.Date.Format(time.DateOnly),
+			Amount: last.Total,
+			ID:     last.ID,
+		}.encode()
+	}
+
+	page.Receipts = make([]Receipt, len(records))
+	for i, record := range records {
+		page.Receipts[i] = *record.MapReceipt()
+	}
+
+	return &page, nil
+}
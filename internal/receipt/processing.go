@@ -0,0 +1,185 @@
+package receipt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/manzanit0/mcduck/internal/jobs"
+	"github.com/manzanit0/mcduck/pkg/xsql"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// ParseJob is the kind of the jobs which parse uploaded receipts.
+const ParseJob = "parse_receipt"
+
+// ParseJobPayload is the payload of ParseJob jobs.
+type ParseJobPayload struct {
+	ReceiptID int64 `json:"receipt_id"`
+	Split     Split `json:"split"`
+	// RejectDuplicates deletes the receipt when, once parsed, it turns out to
+	// be a duplicate of an existing one.
+	RejectDuplicates bool `json:"reject_duplicates"`
+}
+
+type UploadReceiptRequest struct {
+	Image     []byte
+	Large     []byte
+	Thumbnail []byte
+	ImageHash *uint64
+	Email     string
+
+	// DuplicateOf flags the receipt as a likely duplicate of an existing one.
+	DuplicateOf *Duplicate
+
+	Split            Split
+	RejectDuplicates bool
+}
+
+// UploadReceipt stores the receipt without any details, as uploaded, and
+// enqueues a ParseJob to fill them in.
+func (r *Repository) UploadReceipt(ctx context.Context, input UploadReceiptRequest) (*Receipt, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Upload Receipt")
+	defer span.End()
+
+	if len(input.Image) == 0 {
+		return nil, fmt.Errorf("empty receipt")
+	}
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	imageKey, largeKey, thumbnailKey, err := r.putImages(ctx, txn, input.Image, input.Large, input.Thumbnail)
+	if err != nil {
+		return nil, err
+	}
+
+	var imageHash *int64
+	if input.ImageHash != nil {
+		h := int64(*input.ImageHash)
+		imageHash = &h
+	}
+
+	duplicateOf, duplicateReason := input.DuplicateOf.columns()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("receipts").
+		Columns("image_key", "large_key", "thumbnail_key", "image_hash", "duplicate_of", "duplicate_reason", "status", "user_email", "receipt_date").
+		Values(imageKey, largeKey, thumbnailKey, imageHash, duplicateOf, duplicateReason, StatusUploaded, input.Email, time.Now()).
+		Suffix(`RETURNING id, status, status_changed_at, receipt_date, vendor, user_email, image_key, duplicate_of, duplicate_reason`).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var record dbReceipt
+	err = txn.GetContext(ctx, &record, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	err = recordTransition(ctx, txn, record.ID, nil, StatusUploaded, "")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = jobs.Enqueue(ctx, txn, ParseJob, ParseJobPayload{
+		ReceiptID:        record.ID,
+		Split:            input.Split,
+		RejectDuplicates: input.RejectDuplicates,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("enqueue parse job: %w", err)
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return record.MapReceipt(), nil
+}
+
+// CompleteProcessing fills in the details of a receipt which is being parsed
+// and leaves it pending review. The image fields of the input are ignored,
+// since the receipt already has them. Receipts which were already parsed are
+// left as they are, so jobs can be safely retried.
+func (r *Repository) CompleteProcessing(ctx context.Context, receiptID int64, input CreateReceiptRequest) error {
+	ctx, span := xtrace.StartSpan(ctx, "Complete Receipt Processing")
+	defer span.End()
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	duplicateOf, duplicateReason := input.DuplicateOf.columns()
+	subtotal, vendorTaxID, invoiceNumber := input.Tax.columns()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("receipts").
+		Set("vendor", input.Vendor).
+		Set("receipt_date", input.Date).
+		Set("duplicate_of", duplicateOf).
+		Set("duplicate_reason", duplicateReason).
+		Set("ocr_text", nullIfEmpty(input.Text)).
+		Set("subtotal", subtotal).
+		Set("vendor_tax_id", vendorTaxID).
+		Set("invoice_number", invoiceNumber).
+		Where(sq.Eq{"id": receiptID, "status": []Status{StatusUploaded, StatusParsing}}).
+		Suffix("RETURNING user_email").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	var email string
+	err = txn.GetContext(ctx, &email, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	err = transition(ctx, txn, receiptID, StatusParsing, "")
+	if err != nil {
+		return err
+	}
+
+	err = transition(ctx, txn, receiptID, StatusPendingReview, "")
+	if err != nil {
+		return err
+	}
+
+	input.Email = email
+
+	err = insertDetails(ctx, txn, receiptID, input)
+	if err != nil {
+		return err
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// FailProcessing marks the receipt as failed to be parsed because of the
+// reason.
+func (r *Repository) FailProcessing(ctx context.Context, receiptID int64, reason string) error {
+	ctx, span := xtrace.StartSpan(ctx, "Fail Receipt Processing")
+	defer span.End()
+
+	return r.TransitionReceipt(ctx, receiptID, StatusFailed, reason)
+}
@@ -7,28 +7,49 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/blob"
 	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/imaging"
 	"github.com/manzanit0/mcduck/pkg/xsql"
 	"github.com/manzanit0/mcduck/pkg/xtrace"
 )
 
 type Receipt struct {
-	ID            int64
-	PendingReview bool
-	Image         []byte
-	Vendor        string
-	UserEmail     string
-	Date          time.Time
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID        int64
+	ImageKey  string
+	Vendor    string
+	UserEmail string
+	Date      time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Status Status
+	// StatusReason explains the last change of status, like why the receipt
+	// couldn't be parsed.
+	StatusReason    string
+	StatusChangedAt time.Time
+
+	// DuplicateOf is set when the receipt was flagged as a likely duplicate
+	// of an existing one on upload.
+	DuplicateOf *Duplicate
 }
 
 type dbReceipt struct {
-	ID            int64   `db:"id"`
-	PendingReview bool    `db:"pending_review"`
-	Image         []byte  `db:"receipt_image"`
-	UserEmail     string  `db:"user_email"`
-	Vendor        *string `db:"vendor"`
+	ID           int64   `db:"id"`
+	Image        []byte  `db:"receipt_image"`
+	ImageKey     *string `db:"image_key"`
+	LargeKey     *string `db:"large_key"`
+	ThumbnailKey *string `db:"thumbnail_key"`
+	UserEmail    string  `db:"user_email"`
+	Vendor       *string `db:"vendor"`
+
+	ImageHash       *int64  `db:"image_hash"`
+	DuplicateOf     *int64  `db:"duplicate_of"`
+	DuplicateReason *string `db:"duplicate_reason"`
+
+	Status          string    `db:"status"`
+	StatusReason    *string   `db:"status_reason"`
+	StatusChangedAt time.Time `db:"status_changed_at"`
 
 	Date      time.Time `db:"receipt_date"`
 	CreatedAt time.Time `db:"created_at"`
@@ -40,24 +61,39 @@ func (r *dbReceipt) MapReceipt() *Receipt {
 	if r.Vendor != nil {
 		vendor = *r.Vendor
 	}
+
+	var imageKey string
+	if r.ImageKey != nil {
+		imageKey = *r.ImageKey
+	}
+
+	var statusReason string
+	if r.StatusReason != nil {
+		statusReason = *r.StatusReason
+	}
+
 	return &Receipt{
-		ID:            r.ID,
-		PendingReview: r.PendingReview,
-		Image:         r.Image,
-		Date:          r.Date,
-		CreatedAt:     r.CreatedAt,
-		UpdatedAt:     r.UpdatedAt,
-		Vendor:        vendor,
-		UserEmail:     r.UserEmail,
+		ID:              r.ID,
+		ImageKey:        imageKey,
+		Date:            r.Date,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+		Vendor:          vendor,
+		UserEmail:       r.UserEmail,
+		DuplicateOf:     r.mapDuplicate(),
+		Status:          Status(r.Status),
+		StatusReason:    statusReason,
+		StatusChangedAt: r.StatusChangedAt,
 	}
 }
 
 type Repository struct {
-	dbx *sqlx.DB
+	dbx   *sqlx.DB
+	blobs blob.Store
 }
 
-func NewRepository(dbx *sqlx.DB) *Repository {
-	return &Repository{dbx: dbx}
+func NewRepository(dbx *sqlx.DB, blobs blob.Store) *Repository {
+	return &Repository{dbx: dbx, blobs: blobs}
 }
 
 type CreateReceiptRequest struct {
@@ -67,6 +103,27 @@ type CreateReceiptRequest struct {
 	Image       []byte
 	Date        time.Time
 	Email       string
+
+	// Large and Thumbnail are the smaller copies of the image, when it is
+	// one. See imaging.Process.
+	Large     []byte
+	Thumbnail []byte
+	// ImageHash is the perceptual hash of the image, when it is one.
+	ImageHash *uint64
+
+	// DuplicateOf flags the receipt as a likely duplicate of an existing one.
+	DuplicateOf *Duplicate
+
+	// Text is the whole text read from the receipt, to search receipts by.
+	Text string
+
+	// Items are the line items read from the receipt. They're stored
+	// regardless of the split, which only applies when they reconcile with
+	// the amount.
+	Items []Item
+	Split Split
+
+	Tax Tax
 }
 
 func (r *Repository) CreateReceipt(ctx context.Context, input CreateReceiptRequest) (*Receipt, error) {
@@ -84,13 +141,27 @@ func (r *Repository) CreateReceipt(ctx context.Context, input CreateReceiptReque
 
 	defer xsql.TxClose(txn)
 
+	imageKey, largeKey, thumbnailKey, err := r.putImages(ctx, txn, input.Image, input.Large, input.Thumbnail)
+	if err != nil {
+		return nil, err
+	}
+
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
+	var imageHash *int64
+	if input.ImageHash != nil {
+		h := int64(*input.ImageHash)
+		imageHash = &h
+	}
+
+	duplicateOf, duplicateReason := input.DuplicateOf.columns()
+	subtotal, vendorTaxID, invoiceNumber := input.Tax.columns()
+
 	builder := psql.
 		Insert("receipts").
-		Columns("receipt_image", "pending_review", "user_email", "receipt_date", "vendor").
-		Values(input.Image, true, input.Email, input.Date, input.Vendor).
-		Suffix(`RETURNING id, pending_review, receipt_date, vendor, user_email`)
+		Columns("image_key", "large_key", "thumbnail_key", "image_hash", "duplicate_of", "duplicate_reason", "status", "user_email", "receipt_date", "vendor", "ocr_text", "subtotal", "vendor_tax_id", "invoice_number").
+		Values(imageKey, largeKey, thumbnailKey, imageHash, duplicateOf, duplicateReason, StatusPendingReview, input.Email, input.Date, input.Vendor, nullIfEmpty(input.Text), subtotal, vendorTaxID, invoiceNumber).
+		Suffix(`RETURNING id, status, status_changed_at, receipt_date, vendor, user_email, image_key, duplicate_of, duplicate_reason`)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -103,38 +174,59 @@ func (r *Repository) CreateReceipt(ctx context.Context, input CreateReceiptReque
 		return nil, fmt.Errorf("unable to execute query: %w", err)
 	}
 
+	err = recordTransition(ctx, txn, record.ID, nil, StatusPendingReview, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertDetails(ctx, txn, record.ID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return record.MapReceipt(), nil
+}
+
+// insertDetails inserts the items, taxes and expenses read from the receipt.
+func insertDetails(ctx context.Context, txn *sqlx.Tx, receiptID int64, input CreateReceiptRequest) error {
+	err := insertItems(ctx, txn, receiptID, input.Items)
+	if err != nil {
+		return fmt.Errorf("unable to insert items: %w", err)
+	}
+
+	err = insertTaxLines(ctx, txn, receiptID, input.Tax.Lines)
+	if err != nil {
+		return fmt.Errorf("unable to insert tax lines: %w", err)
+	}
+
 	if input.Amount > 0 {
 		e := expense.ExpensesBatch{
 			UserEmail: input.Email,
-			Records: []expense.Expense{{
-				ReceiptID:   uint64(record.ID),
-				Date:        input.Date,
-				Amount:      float32(input.Amount),
-				UserEmail:   input.Email,
-				Description: input.Description,
-				Category:    "Receipt Upload",
-			}},
+			Records:   splitExpenses(input, receiptID),
 		}
 
 		err = expense.CreateExpenses(ctx, txn, e)
 		if err != nil {
-			return nil, fmt.Errorf("unable to insert expenses: %w", err)
+			return fmt.Errorf("unable to insert expenses: %w", err)
 		}
 	}
 
-	err = txn.Commit()
-	if err != nil {
-		return nil, fmt.Errorf("commit transaction: %w", err)
-	}
-
-	return record.MapReceipt(), nil
+	return nil
 }
 
 type UpdateReceiptRequest struct {
-	ID            int64
-	Vendor        *string
-	PendingReview *bool
-	Date          *time.Time
+	ID     int64
+	Vendor *string
+	// Status moves the receipt through its lifecycle. It fails with
+	// ErrInvalidTransition when the receipt can't get there from its current
+	// status.
+	Status *Status
+	Date   *time.Time
 }
 
 func (r *Repository) UpdateReceipt(ctx context.Context, e UpdateReceiptRequest) error {
@@ -160,33 +252,37 @@ func (r *Repository) UpdateReceipt(ctx context.Context, e UpdateReceiptRequest)
 		shouldUpdate = true
 	}
 
-	if e.PendingReview != nil {
-		builder = builder.Set("pending_review", *e.PendingReview)
-		shouldUpdate = true
-	}
-
 	if e.Date != nil {
 		builder = builder.Set("receipt_date", *e.Date)
 		shouldUpdate = true
 		shouldUpdateExpenseDates = true
 	}
 
-	if !shouldUpdate {
+	if !shouldUpdate && e.Status == nil {
 		return nil
 	}
 
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return fmt.Errorf("compile receipts query: %w", err)
+	if e.Status != nil {
+		err = transition(ctx, txn, e.ID, *e.Status, "")
+		if err != nil {
+			return err
+		}
 	}
 
-	_, err = txn.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("execute query: %w", err)
+	if shouldUpdate {
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("compile receipts query: %w", err)
+		}
+
+		_, err = txn.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
 	}
 
 	if shouldUpdateExpenseDates {
-		query, args, err = psql.Update("expenses").Where(sq.Eq{"receipt_id": e.ID}).Set("expense_date", *e.Date).ToSql()
+		query, args, err := psql.Update("expenses").Where(sq.Eq{"receipt_id": e.ID}).Set("expense_date", *e.Date).ToSql()
 		if err != nil {
 			return fmt.Errorf("compile expenses query: %w", err)
 		}
@@ -205,218 +301,238 @@ func (r *Repository) UpdateReceipt(ctx context.Context, e UpdateReceiptRequest)
 	return nil
 }
 
-func (r *Repository) ListReceipts(ctx context.Context, email string) ([]Receipt, error) {
-	ctx, span := xtrace.StartSpan(ctx, "List Receipts")
+func (r *Repository) GetReceipt(ctx context.Context, receiptID uint64) (*Receipt, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Single Receipt")
 	defer span.End()
 
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 	query, args, err := psql.
-		Select("id", "vendor", "pending_review", "receipt_date").
+		Select("id", "vendor", "status", "status_reason", "status_changed_at", "created_at", "image_key", "user_email", "receipt_date", "duplicate_of", "duplicate_reason").
 		From("receipts").
-		Where(sq.Eq{"user_email": email}).
+		Where(sq.Eq{"id": receiptID}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("compile query: %w", err)
 	}
 
-	var receipts []dbReceipt
-	err = r.dbx.SelectContext(ctx, &receipts, query, args...)
+	var receipt dbReceipt
+	err = r.dbx.GetContext(ctx, &receipt, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("select receipts: %w", err)
 	}
 
-	var domainReceipts []Receipt
-	for _, receipt := range receipts {
-		domainReceipts = append(domainReceipts, *receipt.MapReceipt())
-	}
-
-	return domainReceipts, nil
+	return receipt.MapReceipt(), nil
 }
 
-func (r *Repository) ListReceiptsCurrentMonth(ctx context.Context, email string) ([]Receipt, error) {
-	ctx, span := xtrace.StartSpan(ctx, "List Receipts for Current Month")
+func (r *Repository) GetReceiptImage(ctx context.Context, receiptID uint64) ([]byte, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Receipt Image")
 	defer span.End()
 
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 	query, args, err := psql.
-		Select("id", "vendor", "pending_review", "receipt_date").
+		Select("image_key", "receipt_image").
 		From("receipts").
-		Where(sq.And{
-			sq.Eq{"user_email": email},
-			sq.Expr("receipt_date >= date_trunc('month',current_date)"),
-			sq.Expr("receipt_date < date_trunc('month',current_date) + INTERVAL '1' MONTH"),
-		}).
+		Where(sq.Eq{"id": receiptID}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("compile query: %w", err)
 	}
 
-	var receipts []dbReceipt
-	err = r.dbx.SelectContext(ctx, &receipts, query, args...)
+	var receipt dbReceipt
+	err = r.dbx.GetContext(ctx, &receipt, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("select receipts: %w", err)
 	}
 
-	var domainReceipts []Receipt
-	for _, receipt := range receipts {
-		domainReceipts = append(domainReceipts, *receipt.MapReceipt())
+	// Receipts which haven't been migrated yet still have the image inline.
+	if receipt.ImageKey == nil {
+		return receipt.Image, nil
 	}
 
-	return domainReceipts, nil
+	image, err := r.blobs.Get(ctx, *receipt.ImageKey)
+	if err != nil {
+		return nil, fmt.Errorf("get receipt image: %w", err)
+	}
+
+	return image, nil
 }
 
-func (r *Repository) ListReceiptsPreviousMonth(ctx context.Context, email string) ([]Receipt, error) {
-	ctx, span := xtrace.StartSpan(ctx, "List Receipts for Previous Month")
+func (r *Repository) DeleteReceipt(ctx context.Context, id int64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Delete Receipt")
 	defer span.End()
 
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
-	query, args, err := psql.
-		Select("id", "vendor", "pending_review", "receipt_date").
-		From("receipts").
-		Where(sq.And{
-			sq.Eq{"user_email": email},
-			sq.Expr("receipt_date >= date_trunc('month',current_date) - INTERVAL '1' MONTH"),
-			sq.Expr("receipt_date < date_trunc('month',current_date)"),
-		}).
-		ToSql()
+	txn, err := r.dbx.BeginTxx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("compile query: %w", err)
+		return fmt.Errorf("begin transaction: %w", err)
 	}
 
-	var receipts []dbReceipt
-	err = r.dbx.SelectContext(ctx, &receipts, query, args...)
+	defer xsql.TxClose(txn)
+
+	query, args, err := psql.Delete("receipts").Where(sq.Eq{"id": id}).Suffix("RETURNING image_key, large_key, thumbnail_key").ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("select receipts: %w", err)
+		return fmt.Errorf("unable to build query: %w", err)
 	}
 
-	var domainReceipts []Receipt
-	for _, receipt := range receipts {
-		domainReceipts = append(domainReceipts, *receipt.MapReceipt())
+	var deleted []dbReceipt
+	err = txn.SelectContext(ctx, &deleted, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query to delete receipt: %w", err)
 	}
 
-	return domainReceipts, nil
-}
-
-func (r *Repository) ListReceiptsPendingReview(ctx context.Context, email string) ([]Receipt, error) {
-	ctx, span := xtrace.StartSpan(ctx, "List Receipts Pending Review")
-	defer span.End()
-
-	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	query, args, err = psql.Delete("expenses").Where(sq.Eq{"receipt_id": id}).ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
 
-	query, args, err := psql.
-		Select("id", "vendor", "pending_review", "receipt_date").
-		From("receipts").
-		Where(sq.And{
-			sq.Eq{"user_email": email},
-			sq.Eq{"pending_review": true},
-		}).
-		ToSql()
+	_, err = txn.ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("compile query: %w", err)
+		return fmt.Errorf("unable to execute query to delete expenses: %w", err)
 	}
 
-	var receipts []dbReceipt
-	err = r.dbx.SelectContext(ctx, &receipts, query, args...)
+	err = txn.Commit()
 	if err != nil {
-		return nil, fmt.Errorf("select receipts: %w", err)
+		return fmt.Errorf("commit transaction: %w", err)
 	}
 
-	var domainReceipts []Receipt
-	for _, receipt := range receipts {
-		domainReceipts = append(domainReceipts, *receipt.MapReceipt())
+	for _, receipt := range deleted {
+		for _, key := range []*string{receipt.ImageKey, receipt.LargeKey, receipt.ThumbnailKey} {
+			if key == nil {
+				continue
+			}
+
+			err = r.deleteUnreferencedImage(ctx, *key)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	return domainReceipts, nil
+	return nil
 }
 
-func (r *Repository) GetReceipt(ctx context.Context, receiptID uint64) (*Receipt, error) {
-	ctx, span := xtrace.StartSpan(ctx, "Get Single Receipt")
-	defer span.End()
+// deleteUnreferencedImage deletes the image from the blob store unless another
+// receipt was uploaded with the same file. The image is locked meanwhile, so
+// that a receipt of the same file can't be created in between.
+func (r *Repository) deleteUnreferencedImage(ctx context.Context, key string) error {
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
 
-	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	defer xsql.TxClose(txn)
 
-	query, args, err := psql.
-		Select("id", "vendor", "pending_review", "created_at", "receipt_image", "user_email", "receipt_date").
+	err = lockImages(ctx, txn, key)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("COUNT(*)").
 		From("receipts").
-		Where(sq.Eq{"id": receiptID}).
+		Where(sq.Or{sq.Eq{"image_key": key}, sq.Eq{"large_key": key}, sq.Eq{"thumbnail_key": key}}).
 		ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("compile query: %w", err)
+		return fmt.Errorf("unable to build query: %w", err)
 	}
 
-	var receipt dbReceipt
-	err = r.dbx.GetContext(ctx, &receipt, query, args...)
+	var references int
+	err = txn.GetContext(ctx, &references, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("select receipts: %w", err)
+		return fmt.Errorf("count image references: %w", err)
 	}
 
-	return receipt.MapReceipt(), nil
-}
-
-func (r *Repository) GetReceiptImage(ctx context.Context, receiptID uint64) ([]byte, error) {
-	ctx, span := xtrace.StartSpan(ctx, "Get Receipt Image")
-	defer span.End()
-
-	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	if references > 0 {
+		return nil
+	}
 
-	query, args, err := psql.
-		Select("receipt_image").
-		From("receipts").
-		Where(sq.Eq{"id": receiptID}).
-		ToSql()
+	err = r.blobs.Delete(ctx, key)
 	if err != nil {
-		return nil, fmt.Errorf("compile query: %w", err)
+		return fmt.Errorf("delete receipt image: %w", err)
 	}
 
-	var receipt dbReceipt
-	err = r.dbx.GetContext(ctx, &receipt, query, args...)
+	err = txn.Commit()
 	if err != nil {
-		return nil, fmt.Errorf("select receipts: %w", err)
+		return fmt.Errorf("commit transaction: %w", err)
 	}
 
-	return receipt.Image, nil
+	return nil
 }
 
-func (r *Repository) DeleteReceipt(ctx context.Context, id int64) error {
-	ctx, span := xtrace.StartSpan(ctx, "Delete Receipt")
+// MigrateImages moves up to limit images still stored in the receipt_image
+// column to the blob store and returns how many were moved. Their smaller
+// copies are generated on the way.
+func (r *Repository) MigrateImages(ctx context.Context, limit uint64) (int, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Migrate Receipt Images")
 	defer span.End()
 
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
-	txn, err := r.dbx.BeginTxx(ctx, nil)
+	query, args, err := psql.
+		Select("id", "receipt_image").
+		From("receipts").
+		Where(sq.And{sq.Eq{"image_key": nil}, sq.NotEq{"receipt_image": nil}}).
+		OrderBy("id ASC").
+		Limit(limit).
+		ToSql()
 	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
+		return 0, fmt.Errorf("compile query: %w", err)
 	}
 
-	defer xsql.TxClose(txn)
-
-	query, args, err := psql.Delete("receipts").Where(sq.Eq{"id": id}).ToSql()
+	var receipts []dbReceipt
+	err = r.dbx.SelectContext(ctx, &receipts, query, args...)
 	if err != nil {
-		return fmt.Errorf("unable to build query: %w", err)
+		return 0, fmt.Errorf("select receipts: %w", err)
 	}
 
-	_, err = r.dbx.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("unable to execute query to delete receipt: %w", err)
-	}
+	for i, receipt := range receipts {
+		var imageHash *int64
+		processed, err := imaging.Process(receipt.Image)
+		if err != nil {
+			// Not generating the copies only means that the original is
+			// served in their place.
+			processed = &imaging.Processed{}
+		} else {
+			h := int64(processed.Hash)
+			imageHash = &h
+		}
 
-	query, args, err = psql.Delete("expenses").Where(sq.Eq{"receipt_id": id}).ToSql()
-	if err != nil {
-		return fmt.Errorf("unable to build query: %w", err)
-	}
+		key, err := r.putImage(ctx, receipt.Image)
+		if err != nil {
+			return i, fmt.Errorf("store image of receipt %d: %w", receipt.ID, err)
+		}
 
-	_, err = r.dbx.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("unable to execute query to delete expenses: %w", err)
-	}
+		largeKey, err := r.putImage(ctx, processed.Large)
+		if err != nil {
+			return i, fmt.Errorf("store large image of receipt %d: %w", receipt.ID, err)
+		}
 
-	err = txn.Commit()
-	if err != nil {
-		return fmt.Errorf("commit transaction: %w", err)
+		thumbnailKey, err := r.putImage(ctx, processed.Thumbnail)
+		if err != nil {
+			return i, fmt.Errorf("store thumbnail of receipt %d: %w", receipt.ID, err)
+		}
+
+		query, args, err = psql.
+			Update("receipts").
+			Set("image_key", key).
+			Set("large_key", largeKey).
+			Set("thumbnail_key", thumbnailKey).
+			Set("image_hash", imageHash).
+			Set("receipt_image", nil).
+			Where(sq.Eq{"id": receipt.ID}).
+			ToSql()
+		if err != nil {
+			return i, fmt.Errorf("compile update query: %w", err)
+		}
+
+		_, err = r.dbx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return i, fmt.Errorf("update receipt %d: %w", receipt.ID, err)
+		}
 	}
 
-	return nil
+	return len(receipts), nil
 }
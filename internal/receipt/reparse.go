@@ -0,0 +1,245 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/pkg/xsql"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// Field is a detail of the receipt read by the parser.
+type Field string
+
+const (
+	FieldVendor      Field = "vendor"
+	FieldDate        Field = "date"
+	FieldAmount      Field = "amount"
+	FieldDescription Field = "description"
+)
+
+// ErrSeveralExpenses is returned when the amount or description of a receipt
+// which was split into several expenses is changed, since there's no telling
+// which of them should change.
+var ErrSeveralExpenses = errors.New("receipt has several expenses")
+
+// Details are the fields of a receipt which the parser reads. The amount and
+// description are those of its expenses.
+type Details struct {
+	Vendor      string
+	Date        time.Time
+	Amount      float32
+	Description string
+}
+
+// Diff returns the fields which differ between both details. Dates are
+// compared by day, since that's all parsers read.
+func Diff(current, proposed Details) []Field {
+	var changed []Field
+
+	if current.Vendor != proposed.Vendor {
+		changed = append(changed, FieldVendor)
+	}
+
+	if current.Date.Format(time.DateOnly) != proposed.Date.Format(time.DateOnly) {
+		changed = append(changed, FieldDate)
+	}
+
+	if expense.ConvertToCents(current.Amount) != expense.ConvertToCents(proposed.Amount) {
+		changed = append(changed, FieldAmount)
+	}
+
+	if current.Description != proposed.Description {
+		changed = append(changed, FieldDescription)
+	}
+
+	return changed
+}
+
+// GetDetails returns the current details of the receipt. The amount is the
+// total of its expenses, and the description is only set when it has a single
+// one.
+func (r *Repository) GetDetails(ctx context.Context, receiptID uint64) (*Details, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Receipt Details")
+	defer span.End()
+
+	rcpt, err := r.GetReceipt(ctx, receiptID)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("amount", "COALESCE(description, '') AS description").
+		From("expenses").
+		Where(sq.Eq{"receipt_id": receiptID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var expenses []struct {
+		Amount      int32  `db:"amount"`
+		Description string `db:"description"`
+	}
+	err = r.dbx.SelectContext(ctx, &expenses, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select expenses: %w", err)
+	}
+
+	details := Details{Vendor: rcpt.Vendor, Date: rcpt.Date}
+
+	var total int32
+	for _, e := range expenses {
+		total += e.Amount
+	}
+	details.Amount = expense.ConvertToDollar(total)
+
+	if len(expenses) == 1 {
+		details.Description = expenses[0].Description
+	}
+
+	return &details, nil
+}
+
+// ApplyDetails updates the fields of the receipt with the values in the
+// details, leaving the rest as they are. The amount and description can only
+// be changed for receipts with a single expense, or none, in which case it's
+// created.
+func (r *Repository) ApplyDetails(ctx context.Context, receiptID int64, details Details, fields []Field) error {
+	ctx, span := xtrace.StartSpan(ctx, "Apply Receipt Details")
+	defer span.End()
+
+	apply := map[Field]bool{}
+	for _, f := range fields {
+		apply[f] = true
+	}
+
+	if len(apply) == 0 {
+		return nil
+	}
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	receiptUpdate := psql.Update("receipts").Where(sq.Eq{"id": receiptID})
+	if apply[FieldVendor] {
+		receiptUpdate = receiptUpdate.Set("vendor", details.Vendor)
+	}
+
+	if apply[FieldDate] {
+		receiptUpdate = receiptUpdate.Set("receipt_date", details.Date)
+	}
+
+	if apply[FieldVendor] || apply[FieldDate] {
+		query, args, err := receiptUpdate.ToSql()
+		if err != nil {
+			return fmt.Errorf("compile receipts query: %w", err)
+		}
+
+		_, err = txn.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("update receipt: %w", err)
+		}
+	}
+
+	if apply[FieldDate] {
+		query, args, err := psql.Update("expenses").Where(sq.Eq{"receipt_id": receiptID}).Set("expense_date", details.Date).ToSql()
+		if err != nil {
+			return fmt.Errorf("compile expenses query: %w", err)
+		}
+
+		_, err = txn.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("update expense dates: %w", err)
+		}
+	}
+
+	if apply[FieldAmount] || apply[FieldDescription] {
+		query, args, err := psql.
+			Select("id").
+			From("expenses").
+			Where(sq.Eq{"receipt_id": receiptID}).
+			Suffix("FOR UPDATE").
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("compile expenses query: %w", err)
+		}
+
+		var expenseIDs []int64
+		err = txn.SelectContext(ctx, &expenseIDs, query, args...)
+		if err != nil {
+			return fmt.Errorf("select expenses: %w", err)
+		}
+
+		switch len(expenseIDs) {
+		case 0:
+			query, args, err = psql.Select("user_email", "receipt_date").From("receipts").Where(sq.Eq{"id": receiptID}).ToSql()
+			if err != nil {
+				return fmt.Errorf("compile receipts query: %w", err)
+			}
+
+			var owner struct {
+				UserEmail string    `db:"user_email"`
+				Date      time.Time `db:"receipt_date"`
+			}
+			err = txn.GetContext(ctx, &owner, query, args...)
+			if err != nil {
+				return fmt.Errorf("select receipt: %w", err)
+			}
+
+			var amount int32
+			if apply[FieldAmount] {
+				amount = expense.ConvertToCents(details.Amount)
+			}
+
+			var description string
+			if apply[FieldDescription] {
+				description = details.Description
+			}
+
+			query, args, err = psql.
+				Insert("expenses").
+				Columns("user_email", "expense_date", "amount", "category", "description", "receipt_id").
+				Values(owner.UserEmail, owner.Date, amount, "Receipt Upload", description, receiptID).
+				ToSql()
+		case 1:
+			expenseUpdate := psql.Update("expenses").Where(sq.Eq{"id": expenseIDs[0]})
+			if apply[FieldAmount] {
+				expenseUpdate = expenseUpdate.Set("amount", expense.ConvertToCents(details.Amount))
+			}
+
+			if apply[FieldDescription] {
+				expenseUpdate = expenseUpdate.Set("description", details.Description)
+			}
+
+			query, args, err = expenseUpdate.ToSql()
+		default:
+			return ErrSeveralExpenses
+		}
+		if err != nil {
+			return fmt.Errorf("compile expenses query: %w", err)
+		}
+
+		_, err = txn.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("update expense: %w", err)
+		}
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
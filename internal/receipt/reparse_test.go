@@ -0,0 +1,42 @@
+package receipt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+)
+
+func TestDiff(t *testing.T) {
+	current := receipt.Details{
+		Vendor:      "vendor",
+		Date:        time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC),
+		Amount:      5.5,
+		Description: "description",
+	}
+
+	t.Run("same details on a different time of the day", func(t *testing.T) {
+		proposed := current
+		proposed.Date = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		assert.Empty(t, receipt.Diff(current, proposed))
+	})
+
+	t.Run("every field changed", func(t *testing.T) {
+		proposed := receipt.Details{
+			Vendor:      "other vendor",
+			Date:        time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			Amount:      5.51,
+			Description: "other description",
+		}
+
+		assert.Equal(t, []receipt.Field{
+			receipt.FieldVendor,
+			receipt.FieldDate,
+			receipt.FieldAmount,
+			receipt.FieldDescription,
+		}, receipt.Diff(current, proposed))
+	})
+}
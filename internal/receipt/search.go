@@ -0,0 +1,135 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+// The delimiters of the matches in the snippets built by Postgres. They're
+// control characters so that they can't be mistaken for the text of the
+// receipt.
+const (
+	matchStart = "\x02"
+	matchStop  = "\x03"
+)
+
+// SearchResult is a receipt which matched a search, along with the parts of
+// its text which matched.
+type SearchResult struct {
+	Receipt Receipt
+	Rank    float32
+	Snippet []SnippetPart
+}
+
+// SnippetPart is a piece of the text of a matching receipt. Match is set for
+// the pieces which matched the search.
+type SnippetPart struct {
+	Text  string
+	Match bool
+}
+
+type dbSearchResult struct {
+	dbReceipt
+	Rank    float32 `db:"rank"`
+	Snippet string  `db:"snippet"`
+}
+
+// SearchReceipts returns the receipts visible to the user whose vendor, text
+// or expense descriptions match the terms, best matches first. The terms
+// follow the syntax of web search engines: quoted phrases, "or" and "-" to
+// exclude words.
+func (r *Repository) SearchReceipts(ctx context.Context, email, terms string, limit uint64) ([]SearchResult, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Search Receipts")
+	defer span.End()
+
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	} else if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=20, MinWords=5", matchStart, matchStop)
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "vendor", "status", "status_reason", "status_changed_at", "receipt_date", "duplicate_of", "duplicate_reason").
+		Column("ts_rank(search_vector || setweight(to_tsvector('simple', COALESCE(details.descriptions, '')), 'B'), query) AS rank").
+		Column("ts_headline('simple', concat_ws(E'\\n', vendor, details.descriptions, ocr_text), query, ?) AS snippet", headlineOptions).
+		From("receipts").
+		JoinClause("CROSS JOIN websearch_to_tsquery('simple', ?) AS query", terms).
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT string_agg(description, E'\n') AS descriptions
+			FROM expenses
+			WHERE receipt_id = receipts.id
+		) AS details ON TRUE`).
+		Where(sq.And{
+			household.VisibleTo(email),
+			sq.Or{
+				sq.Expr("search_vector @@ query"),
+				sq.Expr("EXISTS (SELECT 1 FROM expenses e WHERE e.receipt_id = receipts.id AND e.search_vector @@ query)"),
+			},
+		}).
+		OrderBy("rank DESC", "receipt_date DESC").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var records []dbSearchResult
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search receipts: %w", err)
+	}
+
+	results := make([]SearchResult, len(records))
+	for i, record := range records {
+		results[i] = SearchResult{
+			Receipt: *record.MapReceipt(),
+			Rank:    record.Rank,
+			Snippet: ParseSnippet(record.Snippet),
+		}
+	}
+
+	return results, nil
+}
+
+// ParseSnippet splits a snippet built by Postgres into the parts which matched
+// the search and those which didn't.
+func ParseSnippet(snippet string) []SnippetPart {
+	var parts []SnippetPart
+	for {
+		start := strings.Index(snippet, matchStart)
+		if start < 0 {
+			break
+		}
+
+		stop := strings.Index(snippet[start:], matchStop)
+		if stop < 0 {
+			break
+		}
+		stop += start
+
+		if start > 0 {
+			parts = append(parts, SnippetPart{Text: snippet[:start]})
+		}
+
+		parts = append(parts, SnippetPart{Text: snippet[start+len(matchStart) : stop], Match: true})
+		snippet = snippet[stop+len(matchStop):]
+	}
+
+	if snippet != "" {
+		parts = append(parts, SnippetPart{Text: snippet})
+	}
+
+	return parts
+}
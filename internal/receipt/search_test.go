@@ -0,0 +1,35 @@
+package receipt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+)
+
+func TestParseSnippet(t *testing.T) {
+	t.Run("matches are split from the rest of the text", func(t *testing.T) {
+		got := receipt.ParseSnippet("1x \x02HDMI\x03 \x02cable\x03 2m 9.99")
+
+		assert.Equal(t, []receipt.SnippetPart{
+			{Text: "1x "},
+			{Text: "HDMI", Match: true},
+			{Text: " "},
+			{Text: "cable", Match: true},
+			{Text: " 2m 9.99"},
+		}, got)
+	})
+
+	t.Run("snippets without matches are kept whole", func(t *testing.T) {
+		got := receipt.ParseSnippet("Tech Store")
+
+		assert.Equal(t, []receipt.SnippetPart{{Text: "Tech Store"}}, got)
+	})
+
+	t.Run("unterminated matches are kept as text", func(t *testing.T) {
+		got := receipt.ParseSnippet("\x02HDMI")
+
+		assert.Equal(t, []receipt.SnippetPart{{Text: "\x02HDMI"}}, got)
+	})
+}
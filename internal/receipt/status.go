@@ -0,0 +1,229 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/pkg/xsql"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// Status is where the receipt is in its lifecycle:
+//
+//	uploaded -> parsing -> pending_review -> reviewed
+//
+// Receipts which can't be parsed end up failed, and any receipt can be
+// archived to hide it from the listings.
+type Status string
+
+const (
+	// StatusUploaded receipts are waiting to be parsed.
+	StatusUploaded Status = "uploaded"
+	StatusParsing  Status = "parsing"
+	// StatusPendingReview receipts have been parsed and their expenses have to
+	// be checked by the user.
+	StatusPendingReview Status = "pending_review"
+	StatusReviewed      Status = "reviewed"
+	// StatusFailed receipts couldn't be parsed, so their details have to be
+	// filled in by hand.
+	StatusFailed   Status = "failed"
+	StatusArchived Status = "archived"
+)
+
+// ErrInvalidTransition is returned when a receipt can't go from its status to
+// the requested one.
+var ErrInvalidTransition = errors.New("invalid receipt status transition")
+
+var transitions = map[Status][]Status{
+	StatusUploaded:      {StatusParsing, StatusFailed, StatusArchived},
+	StatusParsing:       {StatusPendingReview, StatusFailed, StatusArchived},
+	StatusPendingReview: {StatusReviewed, StatusArchived},
+	StatusReviewed:      {StatusPendingReview, StatusArchived},
+	StatusFailed:        {StatusParsing, StatusPendingReview, StatusReviewed, StatusArchived},
+	StatusArchived:      {StatusPendingReview, StatusReviewed},
+}
+
+func (s Status) Valid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// CanTransitionTo tells whether a receipt in the status can be moved to the
+// other one.
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, t := range transitions[s] {
+		if t == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transition is a change in the status of a receipt.
+type Transition struct {
+	// From is empty for the status the receipt was created in.
+	From      Status
+	To        Status
+	Reason    string
+	CreatedAt time.Time
+}
+
+type dbTransition struct {
+	ID        int64     `db:"id"`
+	ReceiptID int64     `db:"receipt_id"`
+	From      *string   `db:"from_status"`
+	To        string    `db:"to_status"`
+	Reason    *string   `db:"reason"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (t *dbTransition) MapTransition() Transition {
+	var from Status
+	if t.From != nil {
+		from = Status(*t.From)
+	}
+
+	var reason string
+	if t.Reason != nil {
+		reason = *t.Reason
+	}
+
+	return Transition{From: from, To: Status(t.To), Reason: reason, CreatedAt: t.CreatedAt}
+}
+
+// TransitionReceipt moves the receipt to the status. The reason is optional.
+// Moving a receipt to the status it's already in does nothing.
+func (r *Repository) TransitionReceipt(ctx context.Context, receiptID int64, to Status, reason string) error {
+	ctx, span := xtrace.StartSpan(ctx, "Transition Receipt")
+	defer span.End()
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	err = transition(ctx, txn, receiptID, to, reason)
+	if err != nil {
+		return err
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// transition moves the receipt to the status within the transaction, locking
+// it so that concurrent transitions are checked against the latest status.
+func transition(ctx context.Context, txn *sqlx.Tx, receiptID int64, to Status, reason string) error {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("status").
+		From("receipts").
+		Where(sq.Eq{"id": receiptID}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile query: %w", err)
+	}
+
+	var from Status
+	err = txn.GetContext(ctx, &from, query, args...)
+	if err != nil {
+		return fmt.Errorf("select receipt status: %w", err)
+	}
+
+	if from == to {
+		return nil
+	}
+
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, from, to)
+	}
+
+	query, args, err = psql.
+		Update("receipts").
+		Set("status", to).
+		Set("status_reason", nullIfEmpty(reason)).
+		Set("status_changed_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": receiptID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update receipt status: %w", err)
+	}
+
+	return recordTransition(ctx, txn, receiptID, &from, to, reason)
+}
+
+// recordTransition adds the transition to the history of the receipt. From is
+// nil for receipts which have just been created.
+func recordTransition(ctx context.Context, txn *sqlx.Tx, receiptID int64, from *Status, to Status, reason string) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("receipt_status_transitions").
+		Columns("receipt_id", "from_status", "to_status", "reason").
+		Values(receiptID, from, to, nullIfEmpty(reason)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("insert status transition: %w", err)
+	}
+
+	return nil
+}
+
+// ListTransitions returns the history of the status of the receipt, oldest
+// first.
+func (r *Repository) ListTransitions(ctx context.Context, receiptID uint64) ([]Transition, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Receipt Status Transitions")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "receipt_id", "from_status", "to_status", "reason", "created_at").
+		From("receipt_status_transitions").
+		Where(sq.Eq{"receipt_id": receiptID}).
+		OrderBy("created_at ASC", "id ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var records []dbTransition
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select status transitions: %w", err)
+	}
+
+	history := make([]Transition, len(records))
+	for i, t := range records {
+		history[i] = t.MapTransition()
+	}
+
+	return history, nil
+}
+
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,37 @@
+package receipt_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+)
+
+func TestStatusCanTransitionTo(t *testing.T) {
+	testCases := []struct {
+		from receipt.Status
+		to   receipt.Status
+		want bool
+	}{
+		{from: receipt.StatusUploaded, to: receipt.StatusParsing, want: true},
+		{from: receipt.StatusUploaded, to: receipt.StatusReviewed, want: false},
+		{from: receipt.StatusParsing, to: receipt.StatusPendingReview, want: true},
+		{from: receipt.StatusParsing, to: receipt.StatusFailed, want: true},
+		{from: receipt.StatusPendingReview, to: receipt.StatusReviewed, want: true},
+		{from: receipt.StatusPendingReview, to: receipt.StatusUploaded, want: false},
+		{from: receipt.StatusReviewed, to: receipt.StatusPendingReview, want: true},
+		{from: receipt.StatusFailed, to: receipt.StatusReviewed, want: true},
+		{from: receipt.StatusArchived, to: receipt.StatusReviewed, want: true},
+		{from: receipt.StatusArchived, to: receipt.StatusParsing, want: false},
+		{from: receipt.Status("unknown"), to: receipt.StatusReviewed, want: false},
+	}
+
+	for _, tC := range testCases {
+		t.Run(fmt.Sprintf("%s to %s", tC.from, tC.to), func(t *testing.T) {
+			got := tC.from.CanTransitionTo(tC.to)
+			if got != tC.want {
+				t.Errorf("expected %t, got %t", tC.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/expense"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// Tax is what accountants need to deduct the taxes of a receipt paid as a
+// business expense.
+type Tax struct {
+	// Subtotal is the amount before taxes.
+	Subtotal      float32
+	VendorTaxID   string
+	InvoiceNumber string
+	Lines         []TaxLine
+}
+
+type TaxLine struct {
+	// Rate is a percentage, like 21 for 21%.
+	Rate   float64
+	Base   float32
+	Amount float32
+}
+
+type dbTaxLine struct {
+	Rate   float64 `db:"rate"`
+	Base   int32   `db:"base"`
+	Amount int32   `db:"amount"`
+}
+
+// columns returns the values of the tax columns of the receipts table.
+func (t Tax) columns() (subtotal *int32, vendorTaxID, invoiceNumber *string) {
+	if t.Subtotal > 0 {
+		cents := expense.ConvertToCents(t.Subtotal)
+		subtotal = &cents
+	}
+
+	return subtotal, nullIfEmpty(truncate(t.VendorTaxID, 32)), nullIfEmpty(truncate(t.InvoiceNumber, 64))
+}
+
+func insertTaxLines(ctx context.Context, txn *sqlx.Tx, receiptID int64, lines []TaxLine) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("receipt_taxes").
+		Columns("receipt_id", "position", "rate", "base", "amount")
+
+	var position int
+	for _, line := range lines {
+		// Receipts often print the exempt rates too.
+		if line.Amount <= 0 || line.Rate <= 0 || line.Rate >= 100 {
+			continue
+		}
+
+		builder = builder.Values(receiptID, position, line.Rate, expense.ConvertToCents(line.Base), expense.ConvertToCents(line.Amount))
+		position++
+	}
+
+	if position == 0 {
+		return nil
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetTax returns the tax details of the receipt, which are empty when the
+// receipt doesn't break them down.
+func (r *Repository) GetTax(ctx context.Context, receiptID uint64) (*Tax, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Receipt Tax")
+	defer span.End()
+
+	var record struct {
+		Subtotal      *int32  `db:"subtotal"`
+		VendorTaxID   *string `db:"vendor_tax_id"`
+		InvoiceNumber *string `db:"invoice_number"`
+	}
+
+	err := r.dbx.GetContext(ctx, &record, `SELECT subtotal, vendor_tax_id, invoice_number FROM receipts WHERE id = $1`, receiptID)
+	if err != nil {
+		return nil, fmt.Errorf("select receipt: %w", err)
+	}
+
+	var lines []dbTaxLine
+	err = r.dbx.SelectContext(ctx, &lines, `SELECT rate, base, amount FROM receipt_taxes WHERE receipt_id = $1 ORDER BY position`, receiptID)
+	if err != nil {
+		return nil, fmt.Errorf("select tax lines: %w", err)
+	}
+
+	tax := Tax{Lines: make([]TaxLine, len(lines))}
+	for i, l := range lines {
+		tax.Lines[i] = TaxLine{Rate: l.Rate, Base: expense.ConvertToDollar(l.Base), Amount: expense.ConvertToDollar(l.Amount)}
+	}
+
+	if record.Subtotal != nil {
+		tax.Subtotal = expense.ConvertToDollar(*record.Subtotal)
+	}
+
+	if record.VendorTaxID != nil {
+		tax.VendorTaxID = *record.VendorTaxID
+	}
+
+	if record.InvoiceNumber != nil {
+		tax.InvoiceNumber = *record.InvoiceNumber
+	}
+
+	return &tax, nil
+}
+
+// VATRate is the deductible VAT paid at a rate.
+type VATRate struct {
+	Rate     float64
+	Base     float32
+	Amount   float32
+	Receipts int
+}
+
+// Quarter returns when the quarter of the year starts and ends, the end being
+// the start of the next one. Quarters go from 1 to 4.
+func Quarter(year, quarter int) (time.Time, time.Time, error) {
+	if quarter < 1 || quarter > 4 {
+		return time.Time{}, time.Time{}, fmt.Errorf("quarter must be between 1 and 4, got %d", quarter)
+	}
+
+	from := time.Date(year, time.Month(3*(quarter-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	return from, from.AddDate(0, 3, 0), nil
+}
+
+// VATReport sums by rate the deductible VAT of the receipts of the ledger of
+// the user, their household's included, dated between from and to, the latter
+// excluded. Only reviewed receipts with the
+// tax ID of the vendor count, since VAT can't be deducted from simplified
+// tickets.
+func (r *Repository) VATReport(ctx context.Context, email string, from, to time.Time) ([]VATRate, error) {
+	ctx, span := xtrace.StartSpan(ctx, "VAT Report")
+	defer span.End()
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("receipt_taxes.rate", "SUM(receipt_taxes.base)::BIGINT AS base", "SUM(receipt_taxes.amount)::BIGINT AS amount", "COUNT(DISTINCT receipts.id) AS receipts").
+		From("receipt_taxes").
+		Join("receipts ON receipts.id = receipt_taxes.receipt_id").
+		Where(household.ColumnVisibleTo("receipts.user_email", email)).
+		Where(sq.Eq{"receipts.status": []Status{StatusReviewed, StatusArchived}}).
+		Where(sq.NotEq{"receipts.vendor_tax_id": nil}).
+		Where(sq.GtOrEq{"receipts.receipt_date": from}).
+		Where(sq.Lt{"receipts.receipt_date": to}).
+		GroupBy("receipt_taxes.rate").
+		OrderBy("receipt_taxes.rate DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("compile query: %w", err)
+	}
+
+	var rows []struct {
+		Rate     float64 `db:"rate"`
+		Base     int64   `db:"base"`
+		Amount   int64   `db:"amount"`
+		Receipts int     `db:"receipts"`
+	}
+
+	err = r.dbx.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select taxes: %w", err)
+	}
+
+	rates := make([]VATRate, len(rows))
+	for i, row := range rows {
+		rates[i] = VATRate{
+			Rate:     row.Rate,
+			Base:     expense.ConvertToDollar(int32(row.Base)),
+			Amount:   expense.ConvertToDollar(int32(row.Amount)),
+			Receipts: row.Receipts,
+		}
+	}
+
+	return rates, nil
+}
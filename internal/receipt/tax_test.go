@@ -0,0 +1,29 @@
+package receipt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+)
+
+func TestQuarter(t *testing.T) {
+	from, to, err := receipt.Quarter(2024, 1)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), to)
+
+	from, to, err = receipt.Quarter(2024, 4)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), to)
+
+	_, _, err = receipt.Quarter(2024, 0)
+	assert.Error(t, err)
+
+	_, _, err = receipt.Quarter(2024, 5)
+	assert.Error(t, err)
+}
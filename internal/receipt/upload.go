@@ -0,0 +1,126 @@
+package receipt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+)
+
+const (
+	// MaxFileSize is the largest receipt file which can be uploaded.
+	MaxFileSize = 10 << 20
+	// MaxUploadSize is the largest total size of the files of a single upload.
+	MaxUploadSize = 50 << 20
+)
+
+// AllowedMIMETypes are the kinds of files receipts can be uploaded as.
+var AllowedMIMETypes = []string{
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	// The parser converts these to JPEG, see parser.ConvertImage.
+	"image/heic",
+	"image/heif",
+	"image/avif",
+	"image/tiff",
+}
+
+// ParseableMIMETypes are the kinds of files receipts are read from: the ones
+// which can be uploaded, and the text of e-receipts.
+var ParseableMIMETypes = append(slices.Clone(AllowedMIMETypes), "text/plain")
+
+var (
+	ErrFileTooLarge     = errors.New("file too large")
+	ErrUploadTooLarge   = errors.New("upload too large")
+	ErrUnsupportedType  = errors.New("unsupported file type")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	ErrIncompleteFile   = errors.New("incomplete file")
+)
+
+// Upload puts together a receipt file sent in chunks, and checks it matches
+// what the client declared before the first one. The file is kept in memory.
+type Upload struct {
+	Name     string
+	MIMEType string
+	Size     uint64
+	// Checksum is the hex encoded SHA-256 of the file.
+	Checksum string
+
+	data bytes.Buffer
+}
+
+func NewUpload(name, mimeType string, size uint64, checksum string) (*Upload, error) {
+	if !slices.Contains(AllowedMIMETypes, mimeType) {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, mimeType)
+	}
+
+	if size == 0 {
+		return nil, fmt.Errorf("%w: empty file", ErrIncompleteFile)
+	}
+
+	if size > MaxFileSize {
+		return nil, fmt.Errorf("%w: %d bytes, the limit is %d", ErrFileTooLarge, size, MaxFileSize)
+	}
+
+	if decoded, err := hex.DecodeString(checksum); err != nil || len(decoded) != sha256.Size {
+		return nil, fmt.Errorf("%w: %q isn't a hex encoded SHA-256", ErrChecksumMismatch, checksum)
+	}
+
+	return &Upload{Name: name, MIMEType: mimeType, Size: size, Checksum: strings.ToLower(checksum)}, nil
+}
+
+// Write appends the chunk to the file. It fails as soon as the file grows
+// past its declared size.
+func (u *Upload) Write(chunk []byte) error {
+	if uint64(u.data.Len()+len(chunk)) > u.Size {
+		return fmt.Errorf("%w: %s is larger than the declared %d bytes", ErrFileTooLarge, u.Name, u.Size)
+	}
+
+	u.data.Write(chunk)
+	return nil
+}
+
+// Bytes returns the file once all of it has been written and it matches its
+// checksum and type.
+func (u *Upload) Bytes() ([]byte, error) {
+	data := u.data.Bytes()
+
+	if uint64(len(data)) != u.Size {
+		return nil, fmt.Errorf("%w: got %d of the %d bytes of %s", ErrIncompleteFile, len(data), u.Size, u.Name)
+	}
+
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != u.Checksum {
+		return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, u.Name)
+	}
+
+	// The declared type is only trusted as far as the contents agree with it.
+	detected := DetectMIMEType(data)
+	if detected != u.MIMEType {
+		return nil, fmt.Errorf("%w: %s was declared as %s but is %s", ErrUnsupportedType, u.Name, u.MIMEType, detected)
+	}
+
+	return data, nil
+}
+
+// DetectMIMEType tells the kind of file from its contents, without parameters
+// like the charset.
+func DetectMIMEType(data []byte) string {
+	mimeType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	if mimeType == "application/octet-stream" {
+		// The standard library doesn't know the formats of phones and
+		// scanners, like HEIC or TIFF.
+		mimeType, _, _ = strings.Cut(mimetype.Detect(data).String(), ";")
+	}
+
+	return mimeType
+}
@@ -0,0 +1,98 @@
+package receipt_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/receipt"
+)
+
+func TestUpload(t *testing.T) {
+	pdf := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+	sum := sha256.Sum256(pdf)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("file sent in chunks", func(t *testing.T) {
+		u, err := receipt.NewUpload("receipt.pdf", "application/pdf", uint64(len(pdf)), checksum)
+		require.NoError(t, err)
+
+		require.NoError(t, u.Write(pdf[:10]))
+		require.NoError(t, u.Write(pdf[10:]))
+
+		data, err := u.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, pdf, data)
+	})
+
+	t.Run("photos of phones", func(t *testing.T) {
+		heic := []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic")
+		sum := sha256.Sum256(heic)
+
+		u, err := receipt.NewUpload("receipt.heic", "image/heic", uint64(len(heic)), hex.EncodeToString(sum[:]))
+		require.NoError(t, err)
+
+		require.NoError(t, u.Write(heic))
+
+		data, err := u.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, heic, data)
+	})
+
+	t.Run("type which isn't allowed", func(t *testing.T) {
+		_, err := receipt.NewUpload("receipt.html", "text/html", uint64(len(pdf)), checksum)
+		assert.ErrorIs(t, err, receipt.ErrUnsupportedType)
+	})
+
+	t.Run("file over the limit", func(t *testing.T) {
+		_, err := receipt.NewUpload("receipt.pdf", "application/pdf", receipt.MaxFileSize+1, checksum)
+		assert.ErrorIs(t, err, receipt.ErrFileTooLarge)
+	})
+
+	t.Run("malformed checksum", func(t *testing.T) {
+		_, err := receipt.NewUpload("receipt.pdf", "application/pdf", uint64(len(pdf)), "abc")
+		assert.Error(t, err)
+	})
+
+	t.Run("chunks past the declared size", func(t *testing.T) {
+		u, err := receipt.NewUpload("receipt.pdf", "application/pdf", 10, checksum)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, u.Write(pdf), receipt.ErrFileTooLarge)
+	})
+
+	t.Run("chunks short of the declared size", func(t *testing.T) {
+		u, err := receipt.NewUpload("receipt.pdf", "application/pdf", uint64(len(pdf)), checksum)
+		require.NoError(t, err)
+
+		require.NoError(t, u.Write(pdf[:10]))
+
+		_, err = u.Bytes()
+		assert.ErrorIs(t, err, receipt.ErrIncompleteFile)
+	})
+
+	t.Run("contents which don't match the checksum", func(t *testing.T) {
+		u, err := receipt.NewUpload("receipt.pdf", "application/pdf", uint64(len(pdf)), checksum)
+		require.NoError(t, err)
+
+		tampered := append([]byte{}, pdf...)
+		tampered[len(tampered)-2] = 'X'
+		require.NoError(t, u.Write(tampered))
+
+		_, err = u.Bytes()
+		assert.ErrorIs(t, err, receipt.ErrChecksumMismatch)
+	})
+
+	t.Run("contents of another type than declared", func(t *testing.T) {
+		u, err := receipt.NewUpload("receipt.png", "image/png", uint64(len(pdf)), checksum)
+		require.NoError(t, err)
+
+		require.NoError(t, u.Write(pdf))
+
+		_, err = u.Bytes()
+		assert.ErrorIs(t, err, receipt.ErrUnsupportedType)
+	})
+}
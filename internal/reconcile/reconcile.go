@@ -0,0 +1,291 @@
+// Package reconcile matches receipts with the bank transactions which paid
+// them, so that importing a bank statement doesn't count the money twice.
+package reconcile
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Like in the settle package, amounts are handled as cents.
+
+// Receipt is the spending side of a match: a receipt and the total of its
+// expenses.
+type Receipt struct {
+	ID        uint64
+	UserEmail string
+	Vendor    string
+	Date      time.Time
+	Amount    int64
+}
+
+// Transaction is an expense imported from a bank statement: paid from an
+// account and not attached to any receipt.
+type Transaction struct {
+	ID          uint64
+	UserEmail   string
+	AccountID   uint64
+	Date        time.Time
+	Amount      int64
+	Description string
+	Category    string
+	Subcategory string
+}
+
+// text is what tells the vendor of the transaction. Statements don't have a
+// vendor column, so it's whatever the user imported as description or
+// categories.
+func (t Transaction) text() string {
+	return strings.Join([]string{t.Description, t.Category, t.Subcategory}, " ")
+}
+
+// Options are how far a transaction can be from a receipt and still pay for
+// it.
+type Options struct {
+	// Tip is how much more than the receipt a charge can be, as a fraction
+	// of the receipt, for tips added after printing it.
+	Tip float64
+
+	// FX is how much a charge can differ either way, as a fraction of the
+	// receipt, for receipts paid in a foreign currency.
+	FX float64
+
+	// DaysBefore and DaysAfter are the window around the date of the
+	// receipt in which the charge can be. Banks usually book charges a few
+	// days later, and earlier only because of time zones.
+	DaysBefore int
+	DaysAfter  int
+
+	// MinScore is the lowest score which is proposed.
+	MinScore float64
+}
+
+var DefaultOptions = Options{
+	Tip:        0.25,
+	FX:         0.05,
+	DaysBefore: 1,
+	DaysAfter:  5,
+	MinScore:   0.5,
+}
+
+// The amount weighs the most since it's what tells apart charges made at the
+// same place. Bank descriptions are often too mangled to rely on the vendor.
+const (
+	amountWeight = 0.5
+	dateWeight   = 0.2
+	vendorWeight = 0.3
+)
+
+type Proposal struct {
+	Receipt     Receipt
+	Transaction Transaction
+
+	// Score goes from 0 to 1, the latter being an exact match of the amount,
+	// the date and the vendor.
+	Score float64
+}
+
+// Match proposes the transaction which most likely paid each receipt. Every
+// receipt and transaction is proposed at most once, the best matches first.
+// Receipts are only matched with transactions of the same user, even when they
+// share a household ledger, so that merging them never moves spending between
+// people.
+func Match(receipts []Receipt, transactions []Transaction, opts Options) []Proposal {
+	var candidates []Proposal
+	for _, r := range receipts {
+		for _, t := range transactions {
+			if r.UserEmail != t.UserEmail {
+				continue
+			}
+
+			score, ok := Score(r, t, opts)
+			if !ok || score < opts.MinScore {
+				continue
+			}
+
+			candidates = append(candidates, Proposal{Receipt: r, Transaction: t, Score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+
+		if candidates[i].Receipt.ID != candidates[j].Receipt.ID {
+			return candidates[i].Receipt.ID < candidates[j].Receipt.ID
+		}
+
+		return candidates[i].Transaction.ID < candidates[j].Transaction.ID
+	})
+
+	matchedReceipts := map[uint64]bool{}
+	matchedTransactions := map[uint64]bool{}
+
+	var proposals []Proposal
+	for _, c := range candidates {
+		if matchedReceipts[c.Receipt.ID] || matchedTransactions[c.Transaction.ID] {
+			continue
+		}
+
+		matchedReceipts[c.Receipt.ID] = true
+		matchedTransactions[c.Transaction.ID] = true
+		proposals = append(proposals, c)
+	}
+
+	return proposals
+}
+
+// Score tells how likely the transaction is to have paid the receipt. It's
+// not ok when the transaction is out of the tolerances.
+func Score(r Receipt, t Transaction, opts Options) (float64, bool) {
+	if r.Amount <= 0 || t.Amount <= 0 {
+		return 0, false
+	}
+
+	diff := float64(t.Amount - r.Amount)
+
+	// Charges can be higher because of tips, but only lower because of the
+	// exchange rate.
+	limit := float64(r.Amount) * opts.FX
+	if diff > 0 {
+		limit = float64(r.Amount) * (opts.FX + opts.Tip)
+	}
+
+	if math.Abs(diff) > limit {
+		return 0, false
+	}
+
+	amountScore := 1.0
+	if diff != 0 {
+		amountScore = 1 - math.Abs(diff)/limit
+	}
+
+	days := daysBetween(r.Date, t.Date)
+	window := opts.DaysAfter
+	if days < 0 {
+		days, window = -days, opts.DaysBefore
+	}
+
+	if days > window {
+		return 0, false
+	}
+
+	// The days at the edge of the window still count for something.
+	dateScore := 1 - float64(days)/float64(window+1)
+
+	score := amountWeight*amountScore + dateWeight*dateScore + vendorWeight*VendorSimilarity(r.Vendor, t.text())
+	return score, true
+}
+
+// daysBetween counts the calendar days from one date to the other, regardless
+// of the time of the day.
+func daysBetween(from, to time.Time) int {
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(math.Round(toDay.Sub(fromDay).Hours() / 24))
+}
+
+// VendorSimilarity goes from 0 to 1 depending on how much of the vendor can be
+// found in the description of a bank transaction. Descriptions are usually
+// upper case, cut short and full of card numbers and city names, as in
+// "COMPRA TARJ. 5402XXXX MERCADONA VALENCIA".
+func VendorSimilarity(vendor, description string) float64 {
+	vendorWords := words(vendor)
+	descriptionWords := words(description)
+	if len(vendorWords) == 0 || len(descriptionWords) == 0 {
+		return 0
+	}
+
+	// Banks often squash the words together, so they are looked for in the
+	// whole description too.
+	squashed := strings.Join(descriptionWords, "")
+
+	var found int
+	for _, w := range vendorWords {
+		if strings.Contains(squashed, w) {
+			found++
+			continue
+		}
+
+		// Words are cut short when the description is too long.
+		for _, d := range descriptionWords {
+			if len(d) >= 4 && strings.HasPrefix(w, d) {
+				found++
+				break
+			}
+		}
+	}
+
+	return math.Max(float64(found)/float64(len(vendorWords)), dice(strings.Join(vendorWords, ""), squashed))
+}
+
+// words splits the text in lower case words, leaving out numbers and those
+// too short to tell anything, like "SL" or "de". Accents are removed, since
+// banks often can't print them.
+func words(text string) []string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), text)
+	if err == nil {
+		text = folded
+	}
+
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var out []string
+	for _, f := range fields {
+		if len([]rune(f)) < 3 || strings.IndexFunc(f, unicode.IsLetter) == -1 {
+			continue
+		}
+
+		out = append(out, f)
+	}
+
+	return out
+}
+
+// dice is the Sørensen–Dice coefficient of the bigrams of both strings, which
+// tolerates typos and abbreviations.
+func dice(a, b string) float64 {
+	aBigrams := bigrams(a)
+	bBigrams := bigrams(b)
+	if len(aBigrams) == 0 || len(bBigrams) == 0 {
+		return 0
+	}
+
+	var shared int
+	for bigram, count := range aBigrams {
+		shared += min(count, bBigrams[bigram])
+	}
+
+	var total int
+	for _, count := range aBigrams {
+		total += count
+	}
+
+	for _, count := range bBigrams {
+		total += count
+	}
+
+	return 2 * float64(shared) / float64(total)
+}
+
+func bigrams(s string) map[string]int {
+	runes := []rune(s)
+
+	out := map[string]int{}
+	for i := 0; i+1 < len(runes); i++ {
+		out[string(runes[i:i+2])]++
+	}
+
+	return out
+}
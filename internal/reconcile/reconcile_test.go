@@ -0,0 +1,105 @@
+package reconcile_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manzanit0/mcduck/internal/reconcile"
+)
+
+func date(day int) time.Time {
+	return time.Date(2024, time.May, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestScore(t *testing.T) {
+	r := reconcile.Receipt{ID: 1, Vendor: "Mercadona", Date: date(10), Amount: 4000}
+
+	t.Run("exact match", func(t *testing.T) {
+		score, ok := reconcile.Score(r, reconcile.Transaction{Date: date(10), Amount: 4000, Description: "COMPRA TARJ. 5402XXXX MERCADONA VALENCIA"}, reconcile.DefaultOptions)
+		require.True(t, ok)
+		assert.InDelta(t, 1, score, 0.001)
+	})
+
+	t.Run("charge with a tip, booked days later", func(t *testing.T) {
+		score, ok := reconcile.Score(r, reconcile.Transaction{Date: date(13), Amount: 4600, Description: "MERCADONA"}, reconcile.DefaultOptions)
+		require.True(t, ok)
+		assert.Less(t, score, 1.0)
+		assert.Greater(t, score, reconcile.DefaultOptions.MinScore)
+	})
+
+	t.Run("charge lower because of the exchange rate", func(t *testing.T) {
+		_, ok := reconcile.Score(r, reconcile.Transaction{Date: date(10), Amount: 3850}, reconcile.DefaultOptions)
+		assert.True(t, ok)
+	})
+
+	t.Run("charge lower than the exchange rate allows", func(t *testing.T) {
+		_, ok := reconcile.Score(r, reconcile.Transaction{Date: date(10), Amount: 3500}, reconcile.DefaultOptions)
+		assert.False(t, ok)
+	})
+
+	t.Run("charge higher than a tip", func(t *testing.T) {
+		_, ok := reconcile.Score(r, reconcile.Transaction{Date: date(10), Amount: 6000}, reconcile.DefaultOptions)
+		assert.False(t, ok)
+	})
+
+	t.Run("charge out of the date window", func(t *testing.T) {
+		_, ok := reconcile.Score(r, reconcile.Transaction{Date: date(16), Amount: 4000}, reconcile.DefaultOptions)
+		assert.False(t, ok)
+
+		_, ok = reconcile.Score(r, reconcile.Transaction{Date: date(8), Amount: 4000}, reconcile.DefaultOptions)
+		assert.False(t, ok)
+	})
+}
+
+func TestVendorSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, reconcile.VendorSimilarity("Mercadona", "COMPRA TARJ. 5402XXXX MERCADONA VALENCIA"))
+	assert.Equal(t, 1.0, reconcile.VendorSimilarity("El Corte Inglés", "PAGO ELCORTEINGLES 1234"))
+	assert.Equal(t, 1.0, reconcile.VendorSimilarity("Restaurante La Pepica", "RESTAURA LA PEPICA"))
+	assert.Greater(t, reconcile.VendorSimilarity("Starbucks Coffee", "STARBUKS 0042"), 0.5)
+	assert.Less(t, reconcile.VendorSimilarity("Mercadona", "AMAZON MKTPLACE"), 0.2)
+	assert.Equal(t, 0.0, reconcile.VendorSimilarity("", "MERCADONA"))
+}
+
+func TestMatch(t *testing.T) {
+	receipts := []reconcile.Receipt{
+		{ID: 1, Vendor: "Mercadona", Date: date(10), Amount: 4000},
+		{ID: 2, Vendor: "Bar Manolo", Date: date(10), Amount: 2000},
+		{ID: 3, Vendor: "Ikea", Date: date(1), Amount: 10000},
+	}
+
+	transactions := []reconcile.Transaction{
+		{ID: 10, Date: date(11), Amount: 2300, Description: "BAR MANOLO"},
+		{ID: 11, Date: date(11), Amount: 4000, Description: "MERCADONA"},
+		// Same amount as the Mercadona receipt, but somewhere else.
+		{ID: 12, Date: date(10), Amount: 4000, Description: "ZARA"},
+		{ID: 13, Date: date(20), Amount: 10000, Description: "IKEA"},
+	}
+
+	proposals := reconcile.Match(receipts, transactions, reconcile.DefaultOptions)
+	require.Len(t, proposals, 2)
+
+	assert.Equal(t, uint64(1), proposals[0].Receipt.ID)
+	assert.Equal(t, uint64(11), proposals[0].Transaction.ID)
+
+	assert.Equal(t, uint64(2), proposals[1].Receipt.ID)
+	assert.Equal(t, uint64(10), proposals[1].Transaction.ID)
+}
+
+func TestMatchOnlyPairsTheSameUser(t *testing.T) {
+	receipts := []reconcile.Receipt{
+		{ID: 1, UserEmail: "foo@email.com", Vendor: "Mercadona", Date: date(10), Amount: 4000},
+	}
+
+	transactions := []reconcile.Transaction{
+		// The partner paid the same at the same place.
+		{ID: 10, UserEmail: "bar@email.com", Date: date(10), Amount: 4000, Description: "MERCADONA"},
+		{ID: 11, UserEmail: "foo@email.com", Date: date(12), Amount: 4100, Description: "MERCADONA"},
+	}
+
+	proposals := reconcile.Match(receipts, transactions, reconcile.DefaultOptions)
+	require.Len(t, proposals, 1)
+	assert.Equal(t, uint64(11), proposals[0].Transaction.ID)
+}
@@ -0,0 +1,307 @@
+package reconcile
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/internal/household"
+	"github.com/manzanit0/mcduck/pkg/xsql"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+var (
+	ErrNotFound          = errors.New("receipt or transaction not found")
+	ErrAlreadyReconciled = errors.New("receipt is already reconciled")
+	ErrAmountMismatch    = errors.New("transaction is too small for the expenses of the receipt")
+	ErrNoExpenses        = errors.New("receipt has no expenses")
+)
+
+type Repository struct {
+	dbx *sqlx.DB
+}
+
+func NewRepository(dbx *sqlx.DB) *Repository {
+	return &Repository{dbx: dbx}
+}
+
+// ListCandidates lists the receipts of the ledger of the user, their
+// household's included, which haven't been reconciled yet, and the
+// transactions which could have paid them. Match only pairs those of the same
+// person, so that merging them never moves spending between people.
+func (r *Repository) ListCandidates(ctx context.Context, email string) ([]Receipt, []Transaction, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Reconciliation Candidates")
+	defer span.End()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("receipts.id", "receipts.user_email", "COALESCE(receipts.vendor, '') AS vendor", "receipts.receipt_date", "totals.amount").
+		From("receipts").
+		JoinClause("JOIN LATERAL (SELECT SUM(amount)::BIGINT AS amount FROM expenses WHERE receipt_id = receipts.id) AS totals ON TRUE").
+		Where(household.ColumnVisibleTo("receipts.user_email", email)).
+		Where(sq.Gt{"totals.amount": 0}).
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM receipt_reconciliations WHERE receipt_id = receipts.id)")).
+		OrderBy("receipts.id").
+		ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("compile receipts query: %w", err)
+	}
+
+	var receipts []struct {
+		ID        uint64    `db:"id"`
+		UserEmail string    `db:"user_email"`
+		Vendor    string    `db:"vendor"`
+		Date      time.Time `db:"receipt_date"`
+		Amount    int64     `db:"amount"`
+	}
+
+	err = r.dbx.SelectContext(ctx, &receipts, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("select receipts: %w", err)
+	}
+
+	if len(receipts) == 0 {
+		return nil, nil, nil
+	}
+
+	out := make([]Receipt, len(receipts))
+	from, to := receipts[0].Date, receipts[0].Date
+	for i, r := range receipts {
+		out[i] = Receipt(r)
+
+		if r.Date.Before(from) {
+			from = r.Date
+		}
+
+		if r.Date.After(to) {
+			to = r.Date
+		}
+	}
+
+	// Transactions too far from every receipt aren't worth loading.
+	query, args, err = psql.
+		Select("id", "user_email", "account_id", "expense_date", "amount", "COALESCE(description, '') AS description", "COALESCE(category, '') AS category", "COALESCE(sub_category, '') AS sub_category").
+		From("expenses").
+		Where(household.VisibleTo(email)).
+		Where(sq.Eq{"receipt_id": nil}).
+		Where(sq.NotEq{"account_id": nil}).
+		Where(sq.GtOrEq{"expense_date": from.AddDate(0, 0, -DefaultOptions.DaysBefore)}).
+		Where(sq.LtOrEq{"expense_date": to.AddDate(0, 0, DefaultOptions.DaysAfter)}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("compile transactions query: %w", err)
+	}
+
+	var transactions []struct {
+		ID          uint64    `db:"id"`
+		UserEmail   string    `db:"user_email"`
+		AccountID   uint64    `db:"account_id"`
+		Date        time.Time `db:"expense_date"`
+		Amount      int64     `db:"amount"`
+		Description string    `db:"description"`
+		Category    string    `db:"category"`
+		Subcategory string    `db:"sub_category"`
+	}
+
+	err = r.dbx.SelectContext(ctx, &transactions, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("select transactions: %w", err)
+	}
+
+	outTransactions := make([]Transaction, len(transactions))
+	for i, t := range transactions {
+		outTransactions[i] = Transaction(t)
+	}
+
+	return out, outTransactions, nil
+}
+
+// Reconcile merges the transaction into the expenses of the receipt: they
+// take the account it was paid from and the transaction is deleted. When the
+// charge differs from the receipt, because of a tip or the exchange rate, the
+// difference goes to the largest expense so the receipt adds up to what was
+// actually paid.
+//
+// The receipt can be of anybody in the household of the user, who must be
+// allowed to write on their ledger, but the transaction must be of the owner
+// of the receipt, so that merging them never moves spending between people.
+func (r *Repository) Reconcile(ctx context.Context, email string, receiptID, transactionID uint64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Reconcile Receipt")
+	defer span.End()
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("user_email").
+		From("receipts").
+		Where(sq.Eq{"id": receiptID}).
+		Where(household.VisibleTo(email)).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile receipt query: %w", err)
+	}
+
+	var receiptOwner string
+	err = txn.GetContext(ctx, &receiptOwner, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: receipt %d doesn't exist", ErrNotFound, receiptID)
+	} else if err != nil {
+		return fmt.Errorf("select receipt: %w", err)
+	}
+
+	query, args, err = psql.
+		Select("1").
+		Prefix("SELECT EXISTS (").
+		From("receipt_reconciliations").
+		Where(sq.Eq{"receipt_id": receiptID}).
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile reconciliation query: %w", err)
+	}
+
+	var reconciled bool
+	err = txn.GetContext(ctx, &reconciled, query, args...)
+	if err != nil {
+		return fmt.Errorf("check reconciliation: %w", err)
+	}
+
+	if reconciled {
+		return ErrAlreadyReconciled
+	}
+
+	query, args, err = psql.
+		Select("account_id", "expense_date", "amount", "description").
+		From("expenses").
+		Where(sq.Eq{"id": transactionID, "user_email": receiptOwner, "receipt_id": nil}).
+		Where(sq.NotEq{"account_id": nil}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile transaction query: %w", err)
+	}
+
+	var transaction struct {
+		AccountID   uint64    `db:"account_id"`
+		Date        time.Time `db:"expense_date"`
+		Amount      int64     `db:"amount"`
+		Description *string   `db:"description"`
+	}
+
+	err = txn.GetContext(ctx, &transaction, query, args...)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: transaction %d doesn't exist", ErrNotFound, transactionID)
+	} else if err != nil {
+		return fmt.Errorf("select transaction: %w", err)
+	}
+
+	query, args, err = psql.
+		Select("id", "amount").
+		From("expenses").
+		Where(sq.Eq{"receipt_id": receiptID}).
+		OrderBy("amount DESC", "id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile expenses query: %w", err)
+	}
+
+	var expenses []struct {
+		ID     uint64 `db:"id"`
+		Amount int64  `db:"amount"`
+	}
+
+	err = txn.SelectContext(ctx, &expenses, query, args...)
+	if err != nil {
+		return fmt.Errorf("select receipt expenses: %w", err)
+	}
+
+	var total int64
+	for _, e := range expenses {
+		total += e.Amount
+	}
+
+	if len(expenses) == 0 || total <= 0 {
+		return ErrNoExpenses
+	}
+
+	largest := expenses[0]
+	if largest.Amount+transaction.Amount-total <= 0 {
+		return ErrAmountMismatch
+	}
+
+	query, args, err = psql.
+		Update("expenses").
+		Set("account_id", transaction.AccountID).
+		Where(sq.Eq{"receipt_id": receiptID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile update query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update account of expenses: %w", err)
+	}
+
+	if transaction.Amount != total {
+		query, args, err = psql.
+			Update("expenses").
+			Set("amount", largest.Amount+transaction.Amount-total).
+			Where(sq.Eq{"id": largest.ID}).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("compile update query: %w", err)
+		}
+
+		_, err = txn.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("update amount of expense: %w", err)
+		}
+	}
+
+	query, args, err = psql.Delete("expenses").Where(sq.Eq{"id": transactionID}).ToSql()
+	if err != nil {
+		return fmt.Errorf("compile delete query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete transaction: %w", err)
+	}
+
+	query, args, err = psql.
+		Insert("receipt_reconciliations").
+		Columns("receipt_id", "account_id", "transaction_date", "transaction_amount", "transaction_description").
+		Values(receiptID, transaction.AccountID, transaction.Date, transaction.Amount, transaction.Description).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("compile insert query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("insert reconciliation: %w", err)
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
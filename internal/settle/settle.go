@@ -0,0 +1,527 @@
+package settle
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/manzanit0/mcduck/pkg/xsql"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// Unlike the rest of the app, amounts in this package are handled as cents,
+// since splitting floats among people makes pennies disappear.
+
+type Method string
+
+const (
+	MethodEqual      Method = "equal"
+	MethodPercentage Method = "percentage"
+	MethodExact      Method = "exact"
+)
+
+// Participant is somebody taking part in a split. Percentage is only used by
+// percentage splits and Amount by exact splits.
+type Participant struct {
+	Email      string
+	Percentage float64
+	Amount     int64
+}
+
+type Share struct {
+	Email  string
+	Amount int64
+}
+
+// Split divides the amount among the participants. The cents which can't be
+// evenly divided are given, one by one, to the first participants.
+func Split(amount int64, method Method, participants []Participant) ([]Share, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("only positive amounts can be split")
+	}
+
+	if len(participants) == 0 {
+		return nil, fmt.Errorf("no participants")
+	}
+
+	seen := map[string]bool{}
+	for _, p := range participants {
+		if p.Email == "" {
+			return nil, fmt.Errorf("participant without email")
+		}
+
+		if seen[strings.ToLower(p.Email)] {
+			return nil, fmt.Errorf("participant %s is repeated", p.Email)
+		}
+
+		seen[strings.ToLower(p.Email)] = true
+	}
+
+	shares := make([]Share, len(participants))
+	for i, p := range participants {
+		shares[i].Email = p.Email
+	}
+
+	switch method {
+	case MethodEqual:
+		n := int64(len(participants))
+		for i := range shares {
+			shares[i].Amount = amount / n
+		}
+
+		distributeRemainder(shares, amount)
+
+	case MethodPercentage:
+		// Percentages are added up in basis points, so that they must add up
+		// to exactly 100 and the shares never add up to more than the amount.
+		var total int64
+		for i, p := range participants {
+			if p.Percentage < 0 {
+				return nil, fmt.Errorf("negative percentage for %s", p.Email)
+			}
+
+			basisPoints := math.Round(p.Percentage * 100)
+			if math.Abs(p.Percentage*100-basisPoints) > 1e-6 {
+				return nil, fmt.Errorf("percentage for %s has more than two decimals", p.Email)
+			}
+
+			total += int64(basisPoints)
+			shares[i].Amount = amount * int64(basisPoints) / 10000
+		}
+
+		if total != 10000 {
+			return nil, fmt.Errorf("percentages add up to %.2f instead of 100", float64(total)/100)
+		}
+
+		distributeRemainder(shares, amount)
+
+	case MethodExact:
+		var total int64
+		for i, p := range participants {
+			if p.Amount < 0 {
+				return nil, fmt.Errorf("negative amount for %s", p.Email)
+			}
+
+			total += p.Amount
+			shares[i].Amount = p.Amount
+		}
+
+		if total != amount {
+			return nil, fmt.Errorf("amounts add up to %d instead of %d", total, amount)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown split method %q", method)
+	}
+
+	return shares, nil
+}
+
+func distributeRemainder(shares []Share, amount int64) {
+	var assigned int64
+	for _, s := range shares {
+		assigned += s.Amount
+	}
+
+	for i := 0; assigned < amount; i = (i + 1) % len(shares) {
+		shares[i].Amount++
+		assigned++
+	}
+}
+
+// SplitExpense is an expense as seen from the settle-up perspective: who paid
+// it and how it's shared.
+type SplitExpense struct {
+	ID     uint64
+	PaidBy string
+	Amount int64
+	Shares []Share
+}
+
+type Settlement struct {
+	ID        uint64
+	FromEmail string
+	ToEmail   string
+	Amount    int64
+	Date      time.Time
+}
+
+// Balances returns the net balance of each person: positive when they are
+// owed money and negative when they owe it.
+func Balances(expenses []SplitExpense, settlements []Settlement) map[string]int64 {
+	balances := map[string]int64{}
+
+	for _, e := range expenses {
+		if len(e.Shares) == 0 {
+			continue
+		}
+
+		balances[strings.ToLower(e.PaidBy)] += e.Amount
+		for _, s := range e.Shares {
+			balances[strings.ToLower(s.Email)] -= s.Amount
+		}
+	}
+
+	for _, s := range settlements {
+		balances[strings.ToLower(s.FromEmail)] += s.Amount
+		balances[strings.ToLower(s.ToEmail)] -= s.Amount
+	}
+
+	for k, v := range balances {
+		if v == 0 {
+			delete(balances, k)
+		}
+	}
+
+	return balances
+}
+
+type Debt struct {
+	From   string
+	To     string
+	Amount int64
+}
+
+// Simplify returns the minimal-ish set of payments which squares all the
+// balances: the biggest debtor pays the biggest creditor until everybody is
+// even.
+func Simplify(balances map[string]int64) []Debt {
+	type entry struct {
+		email  string
+		amount int64
+	}
+
+	var creditors, debtors []entry
+	for email, amount := range balances {
+		if amount > 0 {
+			creditors = append(creditors, entry{email, amount})
+		} else if amount < 0 {
+			debtors = append(debtors, entry{email, -amount})
+		}
+	}
+
+	byAmount := func(s []entry) func(i, j int) bool {
+		return func(i, j int) bool {
+			if s[i].amount == s[j].amount {
+				return s[i].email < s[j].email
+			}
+
+			return s[i].amount > s[j].amount
+		}
+	}
+
+	var debts []Debt
+	for len(creditors) > 0 && len(debtors) > 0 {
+		sort.Slice(creditors, byAmount(creditors))
+		sort.Slice(debtors, byAmount(debtors))
+
+		amount := min(creditors[0].amount, debtors[0].amount)
+		debts = append(debts, Debt{From: debtors[0].email, To: creditors[0].email, Amount: amount})
+
+		creditors[0].amount -= amount
+		debtors[0].amount -= amount
+
+		if creditors[0].amount == 0 {
+			creditors = creditors[1:]
+		}
+
+		if debtors[0].amount == 0 {
+			debtors = debtors[1:]
+		}
+	}
+
+	return debts
+}
+
+type Repository struct {
+	dbx *sqlx.DB
+}
+
+func NewRepository(dbx *sqlx.DB) *Repository {
+	return &Repository{dbx: dbx}
+}
+
+type SplitRequest struct {
+	ExpenseID uint64
+	PaidBy    string
+	Method    Method
+	Shares    []Share
+}
+
+// SetSplit replaces how the expense is paid and shared.
+func (r *Repository) SetSplit(ctx context.Context, input SplitRequest) error {
+	ctx, span := xtrace.StartSpan(ctx, "Set Expense Split")
+	defer span.End()
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	_, err = txn.ExecContext(ctx, `UPDATE expenses SET paid_by = $1, split_method = $2 WHERE id = $3`, input.PaidBy, string(input.Method), input.ExpenseID)
+	if err != nil {
+		return fmt.Errorf("update expense: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, `DELETE FROM expense_splits WHERE expense_id = $1`, input.ExpenseID)
+	if err != nil {
+		return fmt.Errorf("delete splits: %w", err)
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	builder := psql.Insert("expense_splits").Columns("expense_id", "user_email", "share")
+	for _, s := range input.Shares {
+		builder = builder.Values(input.ExpenseID, s.Email, s.Amount)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to build query: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("insert splits: %w", err)
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveSplit makes the expense fully on its owner again.
+func (r *Repository) RemoveSplit(ctx context.Context, expenseID uint64) error {
+	ctx, span := xtrace.StartSpan(ctx, "Remove Expense Split")
+	defer span.End()
+
+	txn, err := r.dbx.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer xsql.TxClose(txn)
+
+	_, err = txn.ExecContext(ctx, `UPDATE expenses SET paid_by = NULL, split_method = NULL WHERE id = $1`, expenseID)
+	if err != nil {
+		return fmt.Errorf("update expense: %w", err)
+	}
+
+	_, err = txn.ExecContext(ctx, `DELETE FROM expense_splits WHERE expense_id = $1`, expenseID)
+	if err != nil {
+		return fmt.Errorf("delete splits: %w", err)
+	}
+
+	err = txn.Commit()
+	if err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+// ListSplitExpenses lists the split expenses in which any of the given people
+// take part, either paying or sharing.
+func (r *Repository) ListSplitExpenses(ctx context.Context, emails []string) ([]SplitExpense, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Split Expenses")
+	defer span.End()
+
+	var records []struct {
+		ID     uint64 `db:"id"`
+		PaidBy string `db:"paid_by"`
+		Amount int64  `db:"amount"`
+		Email  string `db:"user_email"`
+		Share  int64  `db:"share"`
+	}
+
+	participating, participatingArgs, err := sq.
+		Select("expense_id").
+		From("expense_splits").
+		Where(sq.Eq{"user_email": emails}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build subquery: %w", err)
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("e.id", "COALESCE(e.paid_by, e.user_email) AS paid_by", "e.amount", "s.user_email", "s.share").
+		From("expenses e").
+		Join("expense_splits s ON s.expense_id = e.id").
+		Where(sq.Or{
+			sq.Expr("e.id IN ("+participating+")", participatingArgs...),
+			sq.Eq{"e.paid_by": emails},
+		}).
+		OrderBy("e.id", "s.user_email").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	var expenses []SplitExpense
+	for _, record := range records {
+		if len(expenses) == 0 || expenses[len(expenses)-1].ID != record.ID {
+			expenses = append(expenses, SplitExpense{ID: record.ID, PaidBy: record.PaidBy, Amount: record.Amount})
+		}
+
+		last := &expenses[len(expenses)-1]
+		last.Shares = append(last.Shares, Share{Email: record.Email, Amount: record.Share})
+	}
+
+	return expenses, nil
+}
+
+// GetSplit returns how the expense is shared. The shares are empty when the
+// expense isn't split.
+func (r *Repository) GetSplit(ctx context.Context, expenseID uint64) (*SplitExpense, Method, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Expense Split")
+	defer span.End()
+
+	var record struct {
+		PaidBy string  `db:"paid_by"`
+		Amount int64   `db:"amount"`
+		Method *string `db:"split_method"`
+	}
+
+	err := r.dbx.GetContext(ctx, &record, `SELECT COALESCE(paid_by, user_email) AS paid_by, amount, split_method FROM expenses WHERE id = $1`, expenseID)
+	if err != nil {
+		return nil, "", fmt.Errorf("select expense: %w", err)
+	}
+
+	e := SplitExpense{ID: expenseID, PaidBy: record.PaidBy, Amount: record.Amount}
+
+	var shares []struct {
+		Email string `db:"user_email"`
+		Share int64  `db:"share"`
+	}
+
+	err = r.dbx.SelectContext(ctx, &shares, `SELECT user_email, share FROM expense_splits WHERE expense_id = $1 ORDER BY user_email`, expenseID)
+	if err != nil {
+		return nil, "", fmt.Errorf("select splits: %w", err)
+	}
+
+	for _, s := range shares {
+		e.Shares = append(e.Shares, Share{Email: s.Email, Amount: s.Share})
+	}
+
+	var method Method
+	if record.Method != nil {
+		method = Method(*record.Method)
+	}
+
+	return &e, method, nil
+}
+
+func (r *Repository) CreateSettlement(ctx context.Context, s Settlement) (*Settlement, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Create Settlement")
+	defer span.End()
+
+	if strings.EqualFold(s.FromEmail, s.ToEmail) {
+		return nil, fmt.Errorf("unable to settle with oneself")
+	}
+
+	if s.Amount <= 0 {
+		return nil, fmt.Errorf("settlement amount must be positive")
+	}
+
+	var record struct {
+		ID   uint64    `db:"id"`
+		Date time.Time `db:"settled_at"`
+	}
+
+	err := r.dbx.GetContext(ctx, &record, `INSERT INTO settlements (from_email, to_email, amount, settled_at) VALUES ($1, $2, $3, $4) RETURNING id, settled_at`, s.FromEmail, s.ToEmail, s.Amount, s.Date)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	s.ID = record.ID
+	s.Date = record.Date
+
+	return &s, nil
+}
+
+// ListSettlements lists the settlements between any of the given people.
+func (r *Repository) ListSettlements(ctx context.Context, emails []string) ([]Settlement, error) {
+	ctx, span := xtrace.StartSpan(ctx, "List Settlements")
+	defer span.End()
+
+	var records []struct {
+		ID        uint64    `db:"id"`
+		FromEmail string    `db:"from_email"`
+		ToEmail   string    `db:"to_email"`
+		Amount    int64     `db:"amount"`
+		Date      time.Time `db:"settled_at"`
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.
+		Select("id", "from_email", "to_email", "amount", "settled_at").
+		From("settlements").
+		Where(sq.Or{sq.Eq{"from_email": emails}, sq.Eq{"to_email": emails}}).
+		OrderBy("settled_at DESC", "id DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build query: %w", err)
+	}
+
+	err = r.dbx.SelectContext(ctx, &records, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute query: %w", err)
+	}
+
+	settlements := make([]Settlement, len(records))
+	for i, record := range records {
+		settlements[i] = Settlement(record)
+	}
+
+	return settlements, nil
+}
+
+// Summary is the settle-up status among a group of people.
+type Summary struct {
+	Balances    map[string]int64
+	Debts       []Debt
+	Settlements []Settlement
+}
+
+// GetSummary computes who owes whom among the given people, taking into
+// account the settlements they've already made.
+func (r *Repository) GetSummary(ctx context.Context, emails []string) (*Summary, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Get Settle Up Summary")
+	defer span.End()
+
+	expenses, err := r.ListSplitExpenses(ctx, emails)
+	if err != nil {
+		return nil, fmt.Errorf("list split expenses: %w", err)
+	}
+
+	settlements, err := r.ListSettlements(ctx, emails)
+	if err != nil {
+		return nil, fmt.Errorf("list settlements: %w", err)
+	}
+
+	balances := Balances(expenses, settlements)
+
+	return &Summary{
+		Balances:    balances,
+		Debts:       Simplify(balances),
+		Settlements: settlements,
+	}, nil
+}
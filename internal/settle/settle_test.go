@@ -0,0 +1,152 @@
+package settle_test
+
+import (
+	"testing"
+
+	"github.com/manzanit0/mcduck/internal/settle"
+)
+
+func TestSplit(t *testing.T) {
+	t.Run("equal split gives the odd cents to the first participants", func(t *testing.T) {
+		shares, err := settle.Split(1000, settle.MethodEqual, []settle.Participant{{Email: "a"}, {Email: "b"}, {Email: "c"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := []int64{334, 333, 333}
+		for i, s := range shares {
+			if s.Amount != expected[i] {
+				t.Errorf("expected %d for %s, got %d", expected[i], s.Email, s.Amount)
+			}
+		}
+	})
+
+	t.Run("percentage split adds up to the amount", func(t *testing.T) {
+		shares, err := settle.Split(999, settle.MethodPercentage, []settle.Participant{
+			{Email: "a", Percentage: 60},
+			{Email: "b", Percentage: 40},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if shares[0].Amount != 600 || shares[1].Amount != 399 {
+			t.Errorf("unexpected shares %+v", shares)
+		}
+	})
+
+	t.Run("percentages must add up to 100", func(t *testing.T) {
+		_, err := settle.Split(1000, settle.MethodPercentage, []settle.Participant{
+			{Email: "a", Percentage: 60},
+			{Email: "b", Percentage: 30},
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("percentages must add up to exactly 100", func(t *testing.T) {
+		_, err := settle.Split(1000, settle.MethodPercentage, []settle.Participant{
+			{Email: "a", Percentage: 60.0009},
+			{Email: "b", Percentage: 40},
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		shares, err := settle.Split(1000, settle.MethodPercentage, []settle.Participant{
+			{Email: "a", Percentage: 33.33},
+			{Email: "b", Percentage: 33.33},
+			{Email: "c", Percentage: 33.34},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if shares[0].Amount != 334 || shares[1].Amount != 333 || shares[2].Amount != 333 {
+			t.Errorf("unexpected shares %+v", shares)
+		}
+	})
+
+	t.Run("exact amounts must add up to the total", func(t *testing.T) {
+		_, err := settle.Split(1000, settle.MethodExact, []settle.Participant{
+			{Email: "a", Amount: 700},
+			{Email: "b", Amount: 200},
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		shares, err := settle.Split(1000, settle.MethodExact, []settle.Participant{
+			{Email: "a", Amount: 700},
+			{Email: "b", Amount: 300},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if shares[0].Amount != 700 || shares[1].Amount != 300 {
+			t.Errorf("unexpected shares %+v", shares)
+		}
+	})
+
+	t.Run("participants can't be repeated", func(t *testing.T) {
+		_, err := settle.Split(1000, settle.MethodEqual, []settle.Participant{{Email: "a"}, {Email: "A"}})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestBalancesAndSimplify(t *testing.T) {
+	expenses := []settle.SplitExpense{
+		// a pays 30 for a, b and c.
+		{ID: 1, PaidBy: "a", Amount: 3000, Shares: []settle.Share{{"a", 1000}, {"b", 1000}, {"c", 1000}}},
+		// b pays 30 for a, b and c.
+		{ID: 2, PaidBy: "b", Amount: 3000, Shares: []settle.Share{{"a", 1000}, {"b", 1000}, {"c", 1000}}},
+		// Not split, so it doesn't count.
+		{ID: 3, PaidBy: "c", Amount: 5000},
+	}
+
+	balances := settle.Balances(expenses, nil)
+	if balances["a"] != 1000 || balances["b"] != 1000 || balances["c"] != -2000 {
+		t.Fatalf("unexpected balances %+v", balances)
+	}
+
+	debts := settle.Simplify(balances)
+	if len(debts) != 2 {
+		t.Fatalf("expected 2 debts, got %+v", debts)
+	}
+
+	for _, d := range debts {
+		if d.From != "c" || d.Amount != 1000 {
+			t.Errorf("unexpected debt %+v", d)
+		}
+	}
+
+	settlements := []settle.Settlement{
+		{FromEmail: "c", ToEmail: "a", Amount: 1000},
+		{FromEmail: "c", ToEmail: "b", Amount: 1000},
+	}
+
+	balances = settle.Balances(expenses, settlements)
+	if len(balances) != 0 {
+		t.Fatalf("expected everybody to be even, got %+v", balances)
+	}
+
+	if debts := settle.Simplify(balances); len(debts) != 0 {
+		t.Fatalf("expected no debts, got %+v", debts)
+	}
+}
+
+func TestSimplifyChain(t *testing.T) {
+	// a owes b 10 and b owes c 10: a should pay c directly.
+	debts := settle.Simplify(map[string]int64{"a": -1000, "b": 0, "c": 1000})
+	if len(debts) != 1 {
+		t.Fatalf("expected a single debt, got %+v", debts)
+	}
+
+	if debts[0] != (settle.Debt{From: "a", To: "c", Amount: 1000}) {
+		t.Errorf("unexpected debt %+v", debts[0])
+	}
+}
@@ -14,6 +14,9 @@ type User struct {
 	HashedPassword string `db:"hashed_password"`
 	Password       string
 	TelegramChatID *int64 `db:"telegram_chat_id"`
+	// InboundToken identifies the user in the address they forward receipts
+	// to. See inbound.Address.
+	InboundToken string `db:"inbound_token"`
 }
 
 func Create(ctx context.Context, db *sqlx.DB, u User) (User, error) {
@@ -40,7 +43,7 @@ func Find(ctx context.Context, db *sqlx.DB, email string) (*User, error) {
 	defer span.End()
 
 	var u User
-	err := db.GetContext(ctx, &u, `SELECT email, hashed_password, telegram_chat_id FROM users WHERE email = $1`, email)
+	err := db.GetContext(ctx, &u, `SELECT email, hashed_password, telegram_chat_id, inbound_token FROM users WHERE email = $1`, email)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +56,20 @@ func FindByChatID(ctx context.Context, db *sqlx.DB, chatID int64) (*User, error)
 	defer span.End()
 
 	var u User
-	err := db.GetContext(ctx, &u, `SELECT email, hashed_password, telegram_chat_id FROM users WHERE telegram_chat_id = $1`, chatID)
+	err := db.GetContext(ctx, &u, `SELECT email, hashed_password, telegram_chat_id, inbound_token FROM users WHERE telegram_chat_id = $1`, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func FindByInboundToken(ctx context.Context, db *sqlx.DB, token string) (*User, error) {
+	ctx, span := xtrace.StartSpan(ctx, "Find User By Inbound Token")
+	defer span.End()
+
+	var u User
+	err := db.GetContext(ctx, &u, `SELECT email, hashed_password, telegram_chat_id, inbound_token FROM users WHERE inbound_token = $1`, token)
 	if err != nil {
 		return nil, err
 	}
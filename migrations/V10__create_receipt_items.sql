@@ -0,0 +1,29 @@
+BEGIN;
+
+-- Line items as read from the receipt. They're kept regardless of how the
+-- receipt was turned into expenses so the review page can always show what
+-- was bought. Amounts are in cents.
+CREATE TABLE receipt_items (
+    id SERIAL PRIMARY KEY,
+
+    receipt_id INTEGER NOT NULL REFERENCES receipts (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    item_name VARCHAR(255) NOT NULL,
+    quantity NUMERIC(10, 3) NOT NULL DEFAULT 1,
+    unit_price BIGINT NOT NULL,
+    total BIGINT NOT NULL,
+    category VARCHAR(255),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_receipt_items_position
+    UNIQUE (receipt_id, position)
+);
+
+CREATE TRIGGER receipt_items_set_timestamp
+BEFORE UPDATE ON receipt_items
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Blobs are content-addressed, so the key is the digest of the data and
+-- identical uploads are only stored once.
+CREATE TABLE blobs (
+    blob_key VARCHAR(255) PRIMARY KEY,
+
+    data BYTEA NOT NULL,
+    size BIGINT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Receipts reference their image through the blob store from now on. The old
+-- receipt_image column is kept until the images have been moved out of it with
+-- the blobs migrate command.
+ALTER TABLE receipts
+ADD COLUMN image_key VARCHAR(255);
+
+CREATE INDEX idx_receipts_image_key ON receipts (image_key);
+
+COMMIT;
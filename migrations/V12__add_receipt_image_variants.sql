@@ -0,0 +1,12 @@
+BEGIN;
+
+-- Smaller copies of the receipt image: large is upright and downscaled for
+-- parsing, and thumbnail is used in listings. They're empty for files which
+-- aren't images, like PDFs, and those are served in their original form.
+ALTER TABLE receipts
+ADD COLUMN large_key VARCHAR(255);
+
+ALTER TABLE receipts
+ADD COLUMN thumbnail_key VARCHAR(255);
+
+COMMIT;
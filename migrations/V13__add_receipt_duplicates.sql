@@ -0,0 +1,17 @@
+BEGIN;
+
+-- The perceptual hash of the receipt image, used to spot the same receipt
+-- photographed twice. It's stored as a signed BIGINT, the bits being the ones
+-- of the unsigned 64-bit hash.
+ALTER TABLE receipts
+ADD COLUMN image_hash BIGINT;
+
+-- The existing receipt which a receipt was flagged as a likely duplicate of,
+-- and why.
+ALTER TABLE receipts
+ADD COLUMN duplicate_of INTEGER REFERENCES receipts (id) ON DELETE SET NULL;
+
+ALTER TABLE receipts
+ADD COLUMN duplicate_reason VARCHAR(32);
+
+COMMIT;
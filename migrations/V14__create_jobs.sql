@@ -0,0 +1,41 @@
+BEGIN;
+
+-- Background jobs, pulled by workers with FOR UPDATE SKIP LOCKED so that
+-- several of them can run at once. Jobs which fail are retried with backoff
+-- until they run out of attempts, at which point they're left dead for
+-- inspection.
+CREATE TABLE jobs (
+    id BIGSERIAL PRIMARY KEY,
+
+    kind VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL DEFAULT 5,
+    run_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_at TIMESTAMPTZ,
+    last_error TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT jobs_status_check
+    CHECK (status IN ('pending', 'running', 'done', 'dead'))
+);
+
+CREATE INDEX jobs_runnable_idx ON jobs (run_at) WHERE status IN ('pending', 'running');
+
+CREATE TRIGGER jobs_set_timestamp
+BEFORE UPDATE ON jobs
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- Receipts are parsed in the background, so they exist before their details
+-- are known. Existing receipts were parsed on upload.
+ALTER TABLE receipts
+ADD COLUMN processing_status VARCHAR(16) NOT NULL DEFAULT 'processed';
+
+ALTER TABLE receipts
+ADD COLUMN processing_error TEXT;
+
+COMMIT;
@@ -0,0 +1,54 @@
+BEGIN;
+
+-- The lifecycle of a receipt replaces the pending_review flag and the
+-- processing status: uploaded -> parsing -> pending_review -> reviewed, plus
+-- failed and archived. The reason explains the last transition, like why the
+-- receipt couldn't be parsed.
+ALTER TABLE receipts
+ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'uploaded';
+
+ALTER TABLE receipts
+ADD COLUMN status_reason TEXT;
+
+ALTER TABLE receipts
+ADD COLUMN status_changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE receipts SET
+    status = CASE
+        WHEN processing_status = 'failed' THEN 'failed'
+        WHEN processing_status = 'processing' THEN 'uploaded'
+        WHEN pending_review IS FALSE THEN 'reviewed'
+        ELSE 'pending_review'
+    END,
+    status_reason = processing_error,
+    status_changed_at = updated_at;
+
+ALTER TABLE receipts
+ADD CONSTRAINT receipts_status_check
+CHECK (status IN ('uploaded', 'parsing', 'pending_review', 'reviewed', 'failed', 'archived'));
+
+CREATE INDEX receipts_status_idx ON receipts (status);
+
+ALTER TABLE receipts DROP COLUMN pending_review;
+ALTER TABLE receipts DROP COLUMN processing_status;
+ALTER TABLE receipts DROP COLUMN processing_error;
+
+-- Every change of status, so that the history of a receipt can be told.
+CREATE TABLE receipt_status_transitions (
+    id SERIAL PRIMARY KEY,
+
+    receipt_id INTEGER NOT NULL REFERENCES receipts (id) ON DELETE CASCADE,
+    from_status VARCHAR(16),
+    to_status VARCHAR(16) NOT NULL,
+    reason TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX receipt_status_transitions_receipt_id_idx ON receipt_status_transitions (receipt_id);
+
+-- Existing receipts start their history in their current status.
+INSERT INTO receipt_status_transitions (receipt_id, to_status, reason, created_at)
+SELECT id, status, status_reason, status_changed_at FROM receipts;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+-- The text read from the receipt by the parser: the OCR output for PDFs and
+-- the transcription of the model for pictures.
+ALTER TABLE receipts
+ADD COLUMN ocr_text TEXT;
+
+-- The simple configuration doesn't stem, since receipts come in any language.
+ALTER TABLE receipts
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(vendor, '')), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(ocr_text, '')), 'C')
+) STORED;
+
+CREATE INDEX receipts_search_vector_idx ON receipts USING GIN (search_vector);
+
+ALTER TABLE expenses
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('simple', COALESCE(description, ''))
+) STORED;
+
+CREATE INDEX expenses_search_vector_idx ON expenses USING GIN (search_vector);
+
+COMMIT;
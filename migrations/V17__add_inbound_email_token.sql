@@ -0,0 +1,10 @@
+BEGIN;
+
+-- Users forward e-receipts to receipts+<inbound_token>@<INBOUND_EMAIL_DOMAIN>.
+-- The token is random so that addresses can't be guessed from emails.
+ALTER TABLE users
+ADD COLUMN inbound_token VARCHAR(32) NOT NULL DEFAULT replace(gen_random_uuid()::TEXT, '-', '');
+
+CREATE UNIQUE INDEX users_inbound_token_idx ON users (inbound_token);
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- A reconciliation records the bank transaction which was merged into the
+-- expenses of a receipt, since the transaction itself is deleted so that the
+-- money isn't counted twice. Reconciled receipts aren't proposed again.
+CREATE TABLE receipt_reconciliations (
+    receipt_id INTEGER PRIMARY KEY REFERENCES receipts (id) ON DELETE CASCADE,
+
+    account_id INTEGER REFERENCES accounts (id) ON DELETE SET NULL,
+    transaction_date DATE NOT NULL,
+    transaction_amount BIGINT NOT NULL,
+    transaction_description VARCHAR(255),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER receipt_reconciliations_set_timestamp
+BEFORE UPDATE ON receipt_reconciliations
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+COMMIT;
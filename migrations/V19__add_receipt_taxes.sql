@@ -0,0 +1,33 @@
+BEGIN;
+
+-- The details accountants need to deduct the taxes of business expenses.
+-- Amounts are in cents.
+ALTER TABLE receipts
+ADD COLUMN subtotal BIGINT,
+ADD COLUMN vendor_tax_id VARCHAR(32),
+ADD COLUMN invoice_number VARCHAR(64);
+
+-- The taxes broken down in the receipt, one per rate. The rate is a
+-- percentage.
+CREATE TABLE receipt_taxes (
+    id SERIAL PRIMARY KEY,
+
+    receipt_id INTEGER NOT NULL REFERENCES receipts (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    rate NUMERIC(5, 2) NOT NULL,
+    base BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_receipt_taxes_position
+    UNIQUE (receipt_id, position)
+);
+
+CREATE TRIGGER receipt_taxes_set_timestamp
+BEFORE UPDATE ON receipt_taxes
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+COMMIT;
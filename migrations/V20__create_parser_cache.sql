@@ -0,0 +1,22 @@
+BEGIN;
+
+-- What the parser read from each document, so that parsing the same one again
+-- doesn't pay for the model or OCR. The key is the SHA-256 of the document
+-- along with the parser and prompt version which read it.
+CREATE TABLE parser_cache (
+    cache_key VARCHAR(255) PRIMARY KEY,
+    receipt JSONB NOT NULL,
+
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_parser_cache_expires_at ON parser_cache (expires_at);
+
+CREATE TRIGGER parser_cache_set_timestamp
+BEFORE UPDATE ON parser_cache
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+COMMIT;
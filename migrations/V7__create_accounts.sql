@@ -0,0 +1,62 @@
+BEGIN;
+
+CREATE TABLE accounts (
+    id SERIAL PRIMARY KEY,
+
+    user_email VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    account_type VARCHAR(32) NOT NULL DEFAULT 'cash',
+    opening_balance BIGINT NOT NULL DEFAULT 0,
+    opening_date DATE NOT NULL DEFAULT CURRENT_DATE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_user_email
+    FOREIGN KEY (user_email)
+    REFERENCES users (email) ON DELETE CASCADE,
+
+    CONSTRAINT uq_accounts_user_email_name
+    UNIQUE (user_email, name)
+);
+
+CREATE TRIGGER accounts_set_timestamp
+BEFORE UPDATE ON accounts
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+ALTER TABLE expenses
+ADD COLUMN account_id INTEGER REFERENCES accounts (id) ON DELETE SET NULL;
+
+-- Transfers move money between two accounts of the same user. They are kept
+-- apart from expenses so they never count as spending.
+CREATE TABLE transfers (
+    id SERIAL PRIMARY KEY,
+
+    user_email VARCHAR(255) NOT NULL,
+    from_account_id INTEGER NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    to_account_id INTEGER NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL,
+    transfer_date DATE NOT NULL,
+    description VARCHAR(255),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_user_email
+    FOREIGN KEY (user_email)
+    REFERENCES users (email) ON DELETE CASCADE,
+
+    CONSTRAINT chk_transfers_distinct_accounts
+    CHECK (from_account_id <> to_account_id),
+
+    CONSTRAINT chk_transfers_positive_amount
+    CHECK (amount > 0)
+);
+
+CREATE TRIGGER transfers_set_timestamp
+BEFORE UPDATE ON transfers
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+COMMIT;
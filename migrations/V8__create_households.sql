@@ -0,0 +1,71 @@
+BEGIN;
+
+-- A household is a shared ledger: every member sees the expenses and receipts
+-- of the rest of members.
+CREATE TABLE households (
+    id SERIAL PRIMARY KEY,
+
+    name VARCHAR(255) NOT NULL,
+    owner_email VARCHAR(255) NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_owner_email
+    FOREIGN KEY (owner_email)
+    REFERENCES users (email) ON DELETE CASCADE
+);
+
+CREATE TRIGGER households_set_timestamp
+BEFORE UPDATE ON households
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE household_members (
+    household_id INTEGER NOT NULL REFERENCES households (id) ON DELETE CASCADE,
+    user_email VARCHAR(255) NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    member_role VARCHAR(16) NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (household_id, user_email),
+
+    -- A user can only share a single ledger.
+    CONSTRAINT uq_household_members_user_email
+    UNIQUE (user_email),
+
+    CONSTRAINT chk_household_members_role
+    CHECK (member_role IN ('owner', 'read_write', 'read'))
+);
+
+CREATE TRIGGER household_members_set_timestamp
+BEFORE UPDATE ON household_members
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE household_invites (
+    id SERIAL PRIMARY KEY,
+
+    household_id INTEGER NOT NULL REFERENCES households (id) ON DELETE CASCADE,
+    inviter_email VARCHAR(255) NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    invitee_email VARCHAR(255) NOT NULL,
+    member_role VARCHAR(16) NOT NULL,
+    invite_status VARCHAR(16) NOT NULL DEFAULT 'pending',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_household_invites_role
+    CHECK (member_role IN ('read_write', 'read')),
+
+    CONSTRAINT chk_household_invites_status
+    CHECK (invite_status IN ('pending', 'accepted', 'declined'))
+);
+
+CREATE TRIGGER household_invites_set_timestamp
+BEFORE UPDATE ON household_invites
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+COMMIT;
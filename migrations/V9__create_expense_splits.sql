@@ -0,0 +1,52 @@
+BEGIN;
+
+-- Expenses can be paid by somebody other than the owner and split among
+-- several people. Expenses without splits are fully on the payer.
+ALTER TABLE expenses
+ADD COLUMN paid_by VARCHAR(255) REFERENCES users (email) ON DELETE SET NULL;
+
+ALTER TABLE expenses
+ADD COLUMN split_method VARCHAR(16);
+
+CREATE TABLE expense_splits (
+    expense_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE,
+    user_email VARCHAR(255) NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    share BIGINT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (expense_id, user_email)
+);
+
+CREATE TRIGGER expense_splits_set_timestamp
+BEFORE UPDATE ON expense_splits
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- A settlement is a payment from a debtor to a creditor to square their
+-- balance. It's not spending, so it's kept apart from expenses.
+CREATE TABLE settlements (
+    id SERIAL PRIMARY KEY,
+
+    from_email VARCHAR(255) NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    to_email VARCHAR(255) NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    amount BIGINT NOT NULL,
+    settled_at DATE NOT NULL DEFAULT CURRENT_DATE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_settlements_distinct_users
+    CHECK (from_email <> to_email),
+
+    CONSTRAINT chk_settlements_positive_amount
+    CHECK (amount > 0)
+);
+
+CREATE TRIGGER settlements_set_timestamp
+BEFORE UPDATE ON settlements
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+COMMIT;
@@ -44,38 +44,69 @@ func GetInfo(ctx context.Context) any {
 	return ctx.Value(infoKey)
 }
 
-func AuthenticationInterceptor() connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (resp connect.AnyResponse, err error) {
-			_, span := otel.GetTracerProvider().Tracer("github.com/manzanit0/mcduck/pkg/auth").Start(ctx, "Middleware: Authentication")
-
-			auth := req.Header().Get("Authorization")
-			const prefix = "Bearer "
-			if !strings.HasPrefix(auth, prefix) {
-				span.End()
-				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("no Authorization header"))
-			}
-
-			token := auth[len(prefix):]
-
-			user, isValid := ValidateJWT(token)
-			if !isValid {
-				span.End()
-				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
-			}
-
-			ctx = WithInfo(ctx, user)
-			span.End()
-
-			resp, err = next(ctx, req)
-			return
+// AuthenticationInterceptor validates the JWT of unary and streaming requests,
+// and puts the email of the user in the context of the handler.
+func AuthenticationInterceptor() connect.Interceptor {
+	return &authenticationInterceptor{}
+}
+
+type authenticationInterceptor struct{}
+
+func (i *authenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
 		}
+
+		return next(ctx, req)
+	}
+}
+
+func (i *authenticationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authenticationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+
+		return next(ctx, conn)
 	}
 }
 
+func authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	_, span := otel.GetTracerProvider().Tracer("github.com/manzanit0/mcduck/pkg/auth").Start(ctx, "Middleware: Authentication")
+	defer span.End()
+
+	auth := header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(auth, prefix) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("no Authorization header"))
+	}
+
+	token := auth[len(prefix):]
+
+	user, isValid := ValidateJWT(token)
+	if !isValid {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
+	}
+
+	return WithInfo(ctx, user), nil
+}
+
 func CopyAuthHeader[T any](new *connect.Request[T], old *http.Request) error {
+	return CopyAuthHeaderTo(new.Header(), old)
+}
+
+// CopyAuthHeaderTo is CopyAuthHeader for the headers of streams, which aren't
+// sent as a connect.Request.
+func CopyAuthHeaderTo(header http.Header, old *http.Request) error {
 	if old.Header.Get("Authorization") != "" {
-		new.Header().Add("Authorization", old.Header.Get("Authorization"))
+		header.Add("Authorization", old.Header.Get("Authorization"))
 	}
 
 	cookie, err := old.Cookie(authCookieName)
@@ -88,6 +119,6 @@ func CopyAuthHeader[T any](new *connect.Request[T], old *http.Request) error {
 		return fmt.Errorf("unescape cookie: %w", err)
 	}
 
-	new.Header().Add("Authorization", fmt.Sprintf("Bearer %s", val))
+	header.Add("Authorization", fmt.Sprintf("Bearer %s", val))
 	return nil
 }
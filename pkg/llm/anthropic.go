@@ -0,0 +1,191 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manzanit0/mcduck/pkg/xhttp"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// anthropicVersion is the version of the Messages API the client speaks.
+const anthropicVersion = "2023-06-01"
+
+// anthropicClient uses the Messages API of Anthropic.
+type anthropicClient struct {
+	cfg Config
+	h   *http.Client
+}
+
+var _ Client = (*anthropicClient)(nil)
+
+func newAnthropic(cfg Config) *anthropicClient {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = "https://api.anthropic.com/v1"
+	}
+
+	if cfg.Model == "" {
+		cfg.Model = "claude-3-5-sonnet-latest"
+	}
+
+	return &anthropicClient{cfg: cfg, h: xhttp.NewClient()}
+}
+
+// -- Request structures
+type anthropicRequest struct {
+	Model      string               `json:"model"`
+	Messages   []anthropicMessage   `json:"messages"`
+	MaxTokens  int                  `json:"max_tokens"`
+	Tools      []anthropicTool      `json:"tools,omitempty"`
+	ToolChoice *anthropicToolChoice `json:"tool_choice,omitempty"`
+}
+
+type anthropicTool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"input_schema"`
+}
+
+type anthropicToolChoice struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type anthropicMessage struct {
+	Role    string             `json:"role"`
+	Content []anthropicContent `json:"content"`
+}
+
+type anthropicContent struct {
+	Type   string                `json:"type"`
+	Text   string                `json:"text,omitempty"`
+	Source *anthropicImageSource `json:"source,omitempty"`
+	// Input is what the model calls a tool with.
+	Input json.RawMessage `json:"input,omitempty"`
+}
+
+type anthropicImageSource struct {
+	Type      string `json:"type"`
+	MediaType string `json:"media_type"`
+	Data      string `json:"data"`
+}
+
+// -- Response structures
+type anthropicResponse struct {
+	ID         string             `json:"id"`
+	Model      string             `json:"model"`
+	Content    []anthropicContent `json:"content"`
+	StopReason string             `json:"stop_reason"`
+	Usage      struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+}
+
+func (c *anthropicClient) Chat(ctx context.Context, req Request) (*Response, error) {
+	ctx, span := xtrace.StartSpan(ctx, "LLM: Anthropic Message")
+	defer span.End()
+
+	request := anthropicRequest{
+		Model:     c.cfg.Model,
+		MaxTokens: maxTokens(c.cfg, req),
+		Messages:  make([]anthropicMessage, len(req.Messages)),
+	}
+
+	// Anthropic has no response formats, but the input of a tool the model is
+	// made to call follows its schema.
+	if req.Schema != nil {
+		request.Tools = []anthropicTool{{Name: req.Schema.Name, Description: req.Schema.Description, InputSchema: req.Schema.Definition}}
+		request.ToolChoice = &anthropicToolChoice{Type: "tool", Name: req.Schema.Name}
+	}
+
+	for i, m := range req.Messages {
+		request.Messages[i] = anthropicMessage{Role: string(m.Role), Content: make([]anthropicContent, len(m.Content))}
+		for j, content := range m.Content {
+			if content.Image != nil {
+				request.Messages[i].Content[j] = anthropicContent{
+					Type: "image",
+					Source: &anthropicImageSource{
+						Type:      "base64",
+						MediaType: content.MIMEType,
+						Data:      base64.StdEncoding.EncodeToString(content.Image),
+					},
+				}
+			} else {
+				request.Messages[i].Content[j] = anthropicContent{Type: "text", Text: content.Text}
+			}
+		}
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(c.cfg.BaseURL, "/")+"/messages", bytes.NewBuffer(payload))
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Api-Key", c.cfg.APIKey)
+	httpReq.Header.Set("Anthropic-Version", anthropicVersion)
+
+	res, err := c.h.Do(httpReq)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		err := newAPIError(ProviderAnthropic, res.StatusCode, body)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var result anthropicResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	response := &Response{
+		Provider:   ProviderAnthropic,
+		Model:      result.Model,
+		StopReason: result.StopReason,
+		Usage:      Usage{InputTokens: result.Usage.InputTokens, OutputTokens: result.Usage.OutputTokens},
+	}
+
+	var text strings.Builder
+	for _, content := range result.Content {
+		switch content.Type {
+		case "text":
+			text.WriteString(content.Text)
+		case "tool_use":
+			// The answer which follows the schema; any text is just chatter.
+			response.Text = string(content.Input)
+			return response, nil
+		}
+	}
+
+	if text.Len() == 0 {
+		return response, ErrEmptyResponse
+	}
+
+	response.Text = text.String()
+	return response, nil
+}
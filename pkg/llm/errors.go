@@ -0,0 +1,73 @@
+package llm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrEmptyResponse is returned when the model answers with nothing usable.
+var ErrEmptyResponse = errors.New("the model answered nothing")
+
+// APIError is an error response of the API of a provider.
+type APIError struct {
+	Provider   Provider
+	StatusCode int
+	// Type is the kind of error as the provider names it, like
+	// rate_limit_error or invalid_request_error, when it tells.
+	Type    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("%s api error %d: %s", e.Provider, e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("%s api error %d (%s): %s", e.Provider, e.StatusCode, e.Type, e.Message)
+}
+
+// Temporary reports whether the request may succeed when retried later, like
+// when rate limited or the provider is overloaded.
+func (e *APIError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// newAPIError reads the body of an error response. OpenAI, Anthropic and most
+// compatible servers nest the details under "error", but some only give a
+// string.
+func newAPIError(provider Provider, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{Provider: provider, StatusCode: statusCode, Message: http.StatusText(statusCode)}
+
+	var payload struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || payload.Error == nil {
+		if len(body) > 0 {
+			apiErr.Message = string(body)
+		}
+
+		return apiErr
+	}
+
+	var details struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(payload.Error, &details); err == nil {
+		apiErr.Type = details.Type
+		if details.Message != "" {
+			apiErr.Message = details.Message
+		}
+
+		return apiErr
+	}
+
+	var message string
+	if err := json.Unmarshal(payload.Error, &message); err == nil && message != "" {
+		apiErr.Message = message
+	}
+
+	return apiErr
+}
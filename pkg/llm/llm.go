@@ -0,0 +1,149 @@
+// Package llm talks to the large language models which read receipts. The
+// provider is picked by configuration, so that self-hosters can run a local
+// model instead of sending their receipts to a third party.
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Provider string
+
+const (
+	ProviderOpenAI    Provider = "openai"
+	ProviderAnthropic Provider = "anthropic"
+	// ProviderOllama is any server with an OpenAI-compatible API, like Ollama
+	// or llama.cpp, which usually run locally and need no API key.
+	ProviderOllama Provider = "ollama"
+)
+
+// Client chats with a model.
+type Client interface {
+	Chat(ctx context.Context, req Request) (*Response, error)
+}
+
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+)
+
+type Request struct {
+	Messages []Message
+	// MaxTokens is how long the answer can be. The MaxTokens of the Config,
+	// when set, takes precedence.
+	MaxTokens int
+	// Schema, when set, makes the model answer with JSON which follows it.
+	Schema *Schema
+}
+
+// Schema is the JSON schema of an answer. Providers enforce it with their own
+// structured outputs: response formats for OpenAI and compatible servers, and
+// a forced tool call for Anthropic.
+type Schema struct {
+	// Name identifies the schema, like "receipt".
+	Name        string
+	Description string
+	Definition  json.RawMessage
+}
+
+type Message struct {
+	Role    Role
+	Content []Content
+}
+
+// Content is a part of a message: either text or an image.
+type Content struct {
+	Text string
+
+	Image []byte
+	// MIMEType is the type of the image, like image/jpeg.
+	MIMEType string
+}
+
+func Text(text string) Content {
+	return Content{Text: text}
+}
+
+func Image(data []byte, mimeType string) Content {
+	return Content{Image: data, MIMEType: mimeType}
+}
+
+// UserMessage is a message of the user made of the contents, the most common
+// request.
+func UserMessage(contents ...Content) Message {
+	return Message{Role: RoleUser, Content: contents}
+}
+
+// AssistantMessage is a previous answer of the model, to continue the
+// conversation from it.
+func AssistantMessage(text string) Message {
+	return Message{Role: RoleAssistant, Content: []Content{Text(text)}}
+}
+
+type Response struct {
+	Provider Provider `json:"provider"`
+	// Model is the model which answered, which may be a specific version of
+	// the one requested.
+	Model string `json:"model"`
+	Text  string `json:"text"`
+	// StopReason tells why the model stopped, like having run out of tokens.
+	StopReason string `json:"stop_reason"`
+	Usage      Usage  `json:"usage"`
+}
+
+type Usage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+type Config struct {
+	Provider Provider
+	// Model defaults to one of the provider which can read images.
+	Model string
+	// BaseURL is where the API of the provider is, without the path of the
+	// endpoint. It defaults to the public API of the provider, or to the
+	// default address of Ollama.
+	BaseURL string
+	APIKey  string
+	// MaxTokens, when set, replaces the MaxTokens of every request.
+	MaxTokens int
+}
+
+// New returns the client of the provider of the configuration, filling in
+// the defaults.
+func New(cfg Config) (Client, error) {
+	switch cfg.Provider {
+	case ProviderOpenAI, "":
+		cfg.Provider = ProviderOpenAI
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("the openai provider needs an API key")
+		}
+
+		return newOpenAI(cfg, "https://api.openai.com/v1", "gpt-4o"), nil
+
+	case ProviderOllama:
+		return newOpenAI(cfg, "http://localhost:11434/v1", "llama3.2-vision"), nil
+
+	case ProviderAnthropic:
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("the anthropic provider needs an API key")
+		}
+
+		return newAnthropic(cfg), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported llm provider: %s", cfg.Provider)
+	}
+}
+
+func maxTokens(cfg Config, req Request) int {
+	if cfg.MaxTokens > 0 {
+		return cfg.MaxTokens
+	}
+
+	return req.MaxTokens
+}
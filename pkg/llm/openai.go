@@ -0,0 +1,188 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manzanit0/mcduck/pkg/xhttp"
+	"github.com/manzanit0/mcduck/pkg/xtrace"
+)
+
+// openAIClient uses the chat completions API of OpenAI, which many other
+// servers implement too.
+type openAIClient struct {
+	cfg Config
+	h   *http.Client
+}
+
+var _ Client = (*openAIClient)(nil)
+
+func newOpenAI(cfg Config, baseURL, model string) *openAIClient {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = baseURL
+	}
+
+	if cfg.Model == "" {
+		cfg.Model = model
+	}
+
+	return &openAIClient{cfg: cfg, h: xhttp.NewClient()}
+}
+
+// -- Request structures
+type openAIRequest struct {
+	Model          string                `json:"model"`
+	Messages       []openAIMessage       `json:"messages"`
+	MaxTokens      int                   `json:"max_tokens"`
+	ResponseFormat *openAIResponseFormat `json:"response_format,omitempty"`
+}
+
+type openAIResponseFormat struct {
+	Type       string           `json:"type"`
+	JSONSchema openAIJSONSchema `json:"json_schema"`
+}
+
+type openAIJSONSchema struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+type openAIImageURL struct {
+	URL string `json:"url"`
+}
+
+type openAIContent struct {
+	Type     string          `json:"type"`
+	Text     string          `json:"text,omitempty"`
+	ImageURL *openAIImageURL `json:"image_url,omitempty"`
+}
+
+type openAIMessage struct {
+	Role    string          `json:"role"`
+	Content []openAIContent `json:"content"`
+}
+
+// -- Response structures
+type openAIResponse struct {
+	ID      string         `json:"id"`
+	Model   string         `json:"model"`
+	Choices []openAIChoice `json:"choices"`
+	Usage   openAIUsage    `json:"usage"`
+}
+
+type openAIChoice struct {
+	Index   int `json:"index"`
+	Message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	} `json:"message"`
+	FinishReason string `json:"finish_reason"`
+}
+
+type openAIUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+func (c *openAIClient) Chat(ctx context.Context, req Request) (*Response, error) {
+	ctx, span := xtrace.StartSpan(ctx, "LLM: Chat Completion")
+	defer span.End()
+
+	request := openAIRequest{
+		Model:     c.cfg.Model,
+		MaxTokens: maxTokens(c.cfg, req),
+		Messages:  make([]openAIMessage, len(req.Messages)),
+	}
+
+	if req.Schema != nil {
+		request.ResponseFormat = &openAIResponseFormat{
+			Type: "json_schema",
+			JSONSchema: openAIJSONSchema{
+				Name:        req.Schema.Name,
+				Description: req.Schema.Description,
+				Schema:      req.Schema.Definition,
+			},
+		}
+	}
+
+	for i, m := range req.Messages {
+		request.Messages[i] = openAIMessage{Role: string(m.Role), Content: make([]openAIContent, len(m.Content))}
+		for j, content := range m.Content {
+			if content.Image != nil {
+				url := fmt.Sprintf("data:%s;base64,%s", content.MIMEType, base64.StdEncoding.EncodeToString(content.Image))
+				request.Messages[i].Content[j] = openAIContent{Type: "image_url", ImageURL: &openAIImageURL{URL: url}}
+			} else {
+				request.Messages[i].Content[j] = openAIContent{Type: "text", Text: content.Text}
+			}
+		}
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(c.cfg.BaseURL, "/")+"/chat/completions", bytes.NewBuffer(payload))
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	// Local servers usually don't need one.
+	if c.cfg.APIKey != "" {
+		httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.cfg.APIKey))
+	}
+
+	res, err := c.h.Do(httpReq)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		err := newAPIError(c.cfg.Provider, res.StatusCode, body)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var result openAIResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	response := &Response{
+		Provider: c.cfg.Provider,
+		Model:    result.Model,
+		Usage:    Usage{InputTokens: result.Usage.PromptTokens, OutputTokens: result.Usage.CompletionTokens},
+	}
+
+	if len(result.Choices) == 0 {
+		return response, fmt.Errorf("response has no choices: %w", ErrEmptyResponse)
+	}
+
+	response.Text = result.Choices[0].Message.Content
+	response.StopReason = result.Choices[0].FinishReason
+
+	if response.Text == "" {
+		return response, ErrEmptyResponse
+	}
+
+	return response, nil
+}
@@ -0,0 +1,358 @@
+// Package pdfgen writes simple PDF documents: text in the standard Helvetica
+// fonts, lines, JPEG images and attached files. It's enough for reports, and
+// keeps them from depending on any external service.
+package pdfgen
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"sort"
+	"strings"
+)
+
+// A4 in points, which are the unit of every measure of the package. The
+// origin of the page is its bottom left corner.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+type Document struct {
+	pages       []*Page
+	images      []jpegImage
+	attachments []attachment
+}
+
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+	images  []int
+}
+
+type jpegImage struct {
+	data       []byte
+	width      int
+	height     int
+	colorSpace string
+}
+
+type attachment struct {
+	name     string
+	mimeType string
+	data     []byte
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+func (d *Document) AddPage() *Page {
+	p := &Page{doc: d}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Attach embeds the file in the document, so that readers list it among its
+// attachments.
+func (d *Document) Attach(name, mimeType string, data []byte) {
+	d.attachments = append(d.attachments, attachment{name: name, mimeType: mimeType, data: data})
+}
+
+// Text writes the text with its baseline starting at x, y.
+func (p *Page) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, num(size), num(x), num(y), escape(text))
+}
+
+// TextRight writes the text so that it ends at x.
+func (p *Page) TextRight(x, y, size float64, bold bool, text string) {
+	p.Text(x-TextWidth(text, size, bold), y, size, bold, text)
+}
+
+// Line draws a thin line from x1, y1 to x2, y2.
+func (p *Page) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.content, "0.5 w %s %s m %s %s l S\n", num(x1), num(y1), num(x2), num(y2))
+}
+
+// JPEG draws the image scaled to fit in the box whose bottom left corner is x,
+// y, keeping its proportions and centering it.
+func (p *Page) JPEG(data []byte, x, y, width, height float64) error {
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("decode jpeg config: %w", err)
+	}
+
+	colorSpace := "DeviceRGB"
+	switch cfg.ColorModel {
+	case color.GrayModel:
+		colorSpace = "DeviceGray"
+	case color.CMYKModel:
+		colorSpace = "DeviceCMYK"
+	}
+
+	p.doc.images = append(p.doc.images, jpegImage{data: data, width: cfg.Width, height: cfg.Height, colorSpace: colorSpace})
+	index := len(p.doc.images) - 1
+	p.images = append(p.images, index)
+
+	w, h := fit(cfg, width, height)
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /Im%d Do Q\n", num(w), num(h), num(x+(width-w)/2), num(y+(height-h)/2), index)
+
+	return nil
+}
+
+func fit(cfg image.Config, width, height float64) (float64, float64) {
+	scale := min(width/float64(cfg.Width), height/float64(cfg.Height))
+	return float64(cfg.Width) * scale, float64(cfg.Height) * scale
+}
+
+// WriteTo writes the whole document.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	b := builder{}
+
+	catalog := b.reserve()
+	pages := b.reserve()
+	regular := b.add(fontObject("Helvetica"))
+	bold := b.add(fontObject("Helvetica-Bold"))
+
+	imageRefs := make([]int, len(d.images))
+	for i, img := range d.images {
+		imageRefs[i] = b.addStream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /%s /BitsPerComponent 8 /Filter /DCTDecode", img.width, img.height, img.colorSpace), img.data)
+	}
+
+	var kids []string
+	for _, p := range d.pages {
+		compressed, err := deflate(p.content.Bytes())
+		if err != nil {
+			return 0, err
+		}
+
+		content := b.addStream("/Filter /FlateDecode", compressed)
+
+		var xobjects strings.Builder
+		for _, i := range p.images {
+			fmt.Fprintf(&xobjects, "/Im%d %d 0 R ", i, imageRefs[i])
+		}
+
+		page := b.add(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Contents %d 0 R /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> /XObject << %s>> >> >>",
+			pages, num(PageWidth), num(PageHeight), content, regular, bold, xobjects.String()))
+		kids = append(kids, fmt.Sprintf("%d 0 R", page))
+	}
+
+	b.set(pages, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids)))
+
+	// The names of the attachments must be sorted.
+	attachments := append([]attachment(nil), d.attachments...)
+	sort.SliceStable(attachments, func(i, j int) bool { return attachments[i].name < attachments[j].name })
+
+	var names []string
+	for _, a := range attachments {
+		compressed, err := deflate(a.data)
+		if err != nil {
+			return 0, err
+		}
+
+		file := b.addStream(fmt.Sprintf("/Type /EmbeddedFile /Subtype /%s /Filter /FlateDecode /Params << /Size %d >>", nameEscape(a.mimeType), len(a.data)), compressed)
+		spec := b.add(fmt.Sprintf("<< /Type /Filespec /F (%s) /UF (%s) /EF << /F %d 0 R >> >>", escape(a.name), escape(a.name), file))
+		names = append(names, fmt.Sprintf("(%s) %d 0 R", escape(a.name), spec))
+	}
+
+	if len(names) > 0 {
+		b.set(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R /Names << /EmbeddedFiles << /Names [%s] >> >> >>", pages, strings.Join(names, " ")))
+	} else {
+		b.set(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pages))
+	}
+
+	return b.writeTo(w, catalog)
+}
+
+func fontObject(name string) string {
+	return fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name)
+}
+
+// builder numbers the objects of the document. Objects can be reserved before
+// their contents are known, like the page tree, which its pages point to.
+type builder struct {
+	objects [][]byte
+}
+
+func (b *builder) reserve() int {
+	b.objects = append(b.objects, nil)
+	return len(b.objects)
+}
+
+func (b *builder) set(ref int, object string) {
+	b.objects[ref-1] = []byte(object)
+}
+
+func (b *builder) add(object string) int {
+	ref := b.reserve()
+	b.set(ref, object)
+	return ref
+}
+
+func (b *builder) addStream(dict string, data []byte) int {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<< %s /Length %d >>\nstream\n", dict, len(data))
+	buf.Write(data)
+	buf.WriteString("\nendstream")
+
+	ref := b.reserve()
+	b.objects[ref-1] = buf.Bytes()
+	return ref
+}
+
+func (b *builder) writeTo(w io.Writer, root int) (int64, error) {
+	var buf bytes.Buffer
+
+	// The binary comment tells tools the file isn't plain text.
+	buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(b.objects))
+	for i, object := range b.objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n", i+1)
+		buf.Write(object)
+		buf.WriteString("\nendobj\n")
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(b.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(b.objects)+1, root, xref)
+
+	return buf.WriteTo(w)
+}
+
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+
+	_, err := zw.Write(data)
+	if err != nil {
+		return nil, fmt.Errorf("compress stream: %w", err)
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return nil, fmt.Errorf("compress stream: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func num(f float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+}
+
+// escape encodes the text in WinAnsiEncoding, the encoding of the standard
+// fonts, as a literal string. Characters it doesn't have are replaced by a
+// question mark.
+func escape(text string) string {
+	var out strings.Builder
+	for _, r := range text {
+		c, ok := winAnsi(r)
+		if !ok {
+			c = '?'
+		}
+
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			out.WriteByte('\\')
+			out.WriteByte(c)
+		case c < 32 || c > 126:
+			fmt.Fprintf(&out, "\\%03o", c)
+		default:
+			out.WriteByte(c)
+		}
+	}
+
+	return out.String()
+}
+
+// winAnsi maps the rune to WinAnsiEncoding, which matches Latin-1 but for a
+// few symbols like the euro sign.
+func winAnsi(r rune) (byte, bool) {
+	switch {
+	case r == '€':
+		return 0x80, true
+	case r == '\t':
+		return ' ', true
+	case r >= 32 && r <= 126, r >= 0xA0 && r <= 0xFF:
+		return byte(r), true
+	default:
+		return 0, false
+	}
+}
+
+// nameEscape writes the MIME type as a PDF name, where the slash has to be
+// escaped.
+func nameEscape(s string) string {
+	return strings.ReplaceAll(s, "/", "#2F")
+}
+
+// TextWidth is how wide the text is written at the size.
+func TextWidth(text string, size float64, bold bool) float64 {
+	widths := &helveticaWidths
+	if bold {
+		widths = &helveticaBoldWidths
+	}
+
+	var total int
+	for _, r := range text {
+		if r >= 32 && r <= 126 {
+			total += widths[r-32]
+		} else {
+			// Accented letters are as wide as most lower case ones.
+			total += 556
+		}
+	}
+
+	return float64(total) * size / 1000
+}
+
+// Truncate cuts the text short so that it's no wider than width, ending it in
+// an ellipsis.
+func Truncate(text string, size float64, bold bool, width float64) string {
+	if TextWidth(text, size, bold) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && TextWidth(string(runes)+"...", size, bold) > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return strings.TrimSpace(string(runes)) + "..."
+}
+
+// The widths of the printable ASCII characters, from the space to the tilde,
+// in thousandths of the size of the font.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -179,6 +179,52 @@ func (_c *MockClient_SendMessage_Call) RunAndReturn(run func(SendMessageRequest)
 	return _c
 }
 
+// SendPhoto provides a mock function with given fields: _a0
+func (_m *MockClient) SendPhoto(_a0 SendPhotoRequest) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPhoto")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(SendPhotoRequest) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_SendPhoto_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPhoto'
+type MockClient_SendPhoto_Call struct {
+	*mock.Call
+}
+
+// SendPhoto is a helper method to define mock.On call
+//   - _a0 SendPhotoRequest
+func (_e *MockClient_Expecter) SendPhoto(_a0 interface{}) *MockClient_SendPhoto_Call {
+	return &MockClient_SendPhoto_Call{Call: _e.mock.On("SendPhoto", _a0)}
+}
+
+func (_c *MockClient_SendPhoto_Call) Run(run func(_a0 SendPhotoRequest)) *MockClient_SendPhoto_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(SendPhotoRequest))
+	})
+	return _c
+}
+
+func (_c *MockClient_SendPhoto_Call) Return(_a0 error) *MockClient_SendPhoto_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_SendPhoto_Call) RunAndReturn(run func(SendPhotoRequest) error) *MockClient_SendPhoto_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockClient creates a new instance of MockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockClient(t interface {
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"strconv"
 )
 
 type WebhookRequest struct {
@@ -175,6 +177,7 @@ func NewMessage(chatID, text string) Message {
 
 type Client interface {
 	SendMessage(SendMessageRequest) error
+	SendPhoto(SendPhotoRequest) error
 	GetFile(GetFileRequest) (*File, error)
 	DownloadFile(*File) ([]byte, error)
 }
@@ -234,6 +237,79 @@ func (c *client) SendMessage(m SendMessageRequest) error {
 	return fmt.Errorf("request failed with status %d and body %s", res.StatusCode, string(data))
 }
 
+type SendPhotoRequest struct {
+	ChatID    int64
+	Photo     []byte
+	Filename  string
+	Caption   string
+	ParseMode ParseMode
+}
+
+// SendPhoto uploads the photo along with the message, since Telegram only
+// accepts photos by URL or by file ID otherwise.
+func (c *client) SendPhoto(m SendPhotoRequest) error {
+	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendPhoto", c.botToken)
+
+	b := &bytes.Buffer{}
+	w := multipart.NewWriter(b)
+
+	fields := map[string]string{"chat_id": strconv.FormatInt(m.ChatID, 10)}
+	if m.Caption != "" {
+		fields["caption"] = m.Caption
+	}
+
+	if m.ParseMode != "" {
+		fields["parse_mode"] = string(m.ParseMode)
+	}
+
+	for k, v := range fields {
+		err := w.WriteField(k, v)
+		if err != nil {
+			return fmt.Errorf("unable to write field %s: %w", k, err)
+		}
+	}
+
+	part, err := w.CreateFormFile("photo", m.Filename)
+	if err != nil {
+		return fmt.Errorf("unable to create form file: %w", err)
+	}
+
+	_, err = part.Write(m.Photo)
+	if err != nil {
+		return fmt.Errorf("unable to write photo: %w", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("unable to close multipart writer: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, b)
+	if err != nil {
+		return fmt.Errorf("unable to create http req: %w", err)
+	}
+
+	req.Header.Add("Content-Type", w.FormDataContentType())
+
+	res, err := c.h.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to do request: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("request failed with status %d, but unable to read body: %w", res.StatusCode, err)
+	}
+
+	return fmt.Errorf("request failed with status %d and body %s", res.StatusCode, string(data))
+}
+
 type GetFileRequest struct {
 	FileID string `json:"file_id"`
 }
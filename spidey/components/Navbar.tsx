@@ -23,7 +23,7 @@ export default function Navbar(props: NavbarProps) {
               </div>
               <div class="hidden sm:ml-6 sm:block">
                 <div class="flex space-x-4">
-                  {navLink("Dashboard", "/", props.currentRoute)}
+                  {navLink("Dashboard", "/dashboard", props.currentRoute)}
                   {navLink("Expenses", "/greet/javier", props.currentRoute)}
                   {navLink("Receips", "/receipts", props.currentRoute)}
                 </div>
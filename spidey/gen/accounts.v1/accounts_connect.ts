@@ -0,0 +1,89 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file accounts.v1/accounts.proto (package accounts.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateAccountRequest, CreateAccountResponse, CreateTransferRequest, CreateTransferResponse, DeleteAccountRequest, DeleteAccountResponse, DeleteTransferRequest, DeleteTransferResponse, GetBalanceHistoryRequest, GetBalanceHistoryResponse, ListAccountsRequest, ListAccountsResponse, ListTransfersRequest, ListTransfersResponse, UpdateAccountRequest, UpdateAccountResponse } from "./accounts_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service accounts.v1.AccountsService
+ */
+export const AccountsService = {
+  typeName: "accounts.v1.AccountsService",
+  methods: {
+    /**
+     * @generated from rpc accounts.v1.AccountsService.CreateAccount
+     */
+    createAccount: {
+      name: "CreateAccount",
+      I: CreateAccountRequest,
+      O: CreateAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc accounts.v1.AccountsService.UpdateAccount
+     */
+    updateAccount: {
+      name: "UpdateAccount",
+      I: UpdateAccountRequest,
+      O: UpdateAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc accounts.v1.AccountsService.DeleteAccount
+     */
+    deleteAccount: {
+      name: "DeleteAccount",
+      I: DeleteAccountRequest,
+      O: DeleteAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc accounts.v1.AccountsService.ListAccounts
+     */
+    listAccounts: {
+      name: "ListAccounts",
+      I: ListAccountsRequest,
+      O: ListAccountsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc accounts.v1.AccountsService.CreateTransfer
+     */
+    createTransfer: {
+      name: "CreateTransfer",
+      I: CreateTransferRequest,
+      O: CreateTransferResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc accounts.v1.AccountsService.DeleteTransfer
+     */
+    deleteTransfer: {
+      name: "DeleteTransfer",
+      I: DeleteTransferRequest,
+      O: DeleteTransferResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc accounts.v1.AccountsService.ListTransfers
+     */
+    listTransfers: {
+      name: "ListTransfers",
+      I: ListTransfersRequest,
+      O: ListTransfersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc accounts.v1.AccountsService.GetBalanceHistory
+     */
+    getBalanceHistory: {
+      name: "GetBalanceHistory",
+      I: GetBalanceHistoryRequest,
+      O: GetBalanceHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,855 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file accounts.v1/accounts.proto (package accounts.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum accounts.v1.AccountType
+ */
+export enum AccountType {
+  /**
+   * @generated from enum value: ACCOUNT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACCOUNT_TYPE_CASH = 1;
+   */
+  CASH = 1,
+
+  /**
+   * @generated from enum value: ACCOUNT_TYPE_CARD = 2;
+   */
+  CARD = 2,
+
+  /**
+   * @generated from enum value: ACCOUNT_TYPE_BANK = 3;
+   */
+  BANK = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AccountType)
+proto3.util.setEnumType(AccountType, "accounts.v1.AccountType", [
+  { no: 0, name: "ACCOUNT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "ACCOUNT_TYPE_CASH" },
+  { no: 2, name: "ACCOUNT_TYPE_CARD" },
+  { no: 3, name: "ACCOUNT_TYPE_BANK" },
+]);
+
+/**
+ * @generated from message accounts.v1.CreateAccountRequest
+ */
+export class CreateAccountRequest extends Message<CreateAccountRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: accounts.v1.AccountType type = 2;
+   */
+  type = AccountType.UNSPECIFIED;
+
+  /**
+   * @generated from field: int64 opening_balance = 3;
+   */
+  openingBalance = protoInt64.zero;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp opening_date = 4;
+   */
+  openingDate?: Timestamp;
+
+  constructor(data?: PartialMessage<CreateAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.CreateAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(AccountType) },
+    { no: 3, name: "opening_balance", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "opening_date", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAccountRequest {
+    return new CreateAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAccountRequest {
+    return new CreateAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAccountRequest {
+    return new CreateAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAccountRequest | PlainMessage<CreateAccountRequest> | undefined, b: CreateAccountRequest | PlainMessage<CreateAccountRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAccountRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.CreateAccountResponse
+ */
+export class CreateAccountResponse extends Message<CreateAccountResponse> {
+  /**
+   * @generated from field: accounts.v1.Account account = 1;
+   */
+  account?: Account;
+
+  constructor(data?: PartialMessage<CreateAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.CreateAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account", kind: "message", T: Account },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAccountResponse {
+    return new CreateAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAccountResponse {
+    return new CreateAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAccountResponse {
+    return new CreateAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAccountResponse | PlainMessage<CreateAccountResponse> | undefined, b: CreateAccountResponse | PlainMessage<CreateAccountResponse> | undefined): boolean {
+    return proto3.util.equals(CreateAccountResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.UpdateAccountRequest
+ */
+export class UpdateAccountRequest extends Message<UpdateAccountRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional accounts.v1.AccountType type = 3;
+   */
+  type?: AccountType;
+
+  /**
+   * @generated from field: optional int64 opening_balance = 4;
+   */
+  openingBalance?: bigint;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp opening_date = 5;
+   */
+  openingDate?: Timestamp;
+
+  constructor(data?: PartialMessage<UpdateAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.UpdateAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(AccountType), opt: true },
+    { no: 4, name: "opening_balance", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 5, name: "opening_date", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAccountRequest {
+    return new UpdateAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAccountRequest {
+    return new UpdateAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAccountRequest {
+    return new UpdateAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAccountRequest | PlainMessage<UpdateAccountRequest> | undefined, b: UpdateAccountRequest | PlainMessage<UpdateAccountRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateAccountRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.UpdateAccountResponse
+ */
+export class UpdateAccountResponse extends Message<UpdateAccountResponse> {
+  constructor(data?: PartialMessage<UpdateAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.UpdateAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAccountResponse {
+    return new UpdateAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAccountResponse {
+    return new UpdateAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAccountResponse {
+    return new UpdateAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAccountResponse | PlainMessage<UpdateAccountResponse> | undefined, b: UpdateAccountResponse | PlainMessage<UpdateAccountResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateAccountResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.DeleteAccountRequest
+ */
+export class DeleteAccountRequest extends Message<DeleteAccountRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.DeleteAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAccountRequest {
+    return new DeleteAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAccountRequest {
+    return new DeleteAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAccountRequest {
+    return new DeleteAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAccountRequest | PlainMessage<DeleteAccountRequest> | undefined, b: DeleteAccountRequest | PlainMessage<DeleteAccountRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAccountRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.DeleteAccountResponse
+ */
+export class DeleteAccountResponse extends Message<DeleteAccountResponse> {
+  constructor(data?: PartialMessage<DeleteAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.DeleteAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAccountResponse {
+    return new DeleteAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAccountResponse {
+    return new DeleteAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAccountResponse {
+    return new DeleteAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAccountResponse | PlainMessage<DeleteAccountResponse> | undefined, b: DeleteAccountResponse | PlainMessage<DeleteAccountResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAccountResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.ListAccountsRequest
+ */
+export class ListAccountsRequest extends Message<ListAccountsRequest> {
+  constructor(data?: PartialMessage<ListAccountsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.ListAccountsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAccountsRequest {
+    return new ListAccountsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAccountsRequest {
+    return new ListAccountsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAccountsRequest {
+    return new ListAccountsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAccountsRequest | PlainMessage<ListAccountsRequest> | undefined, b: ListAccountsRequest | PlainMessage<ListAccountsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAccountsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.ListAccountsResponse
+ */
+export class ListAccountsResponse extends Message<ListAccountsResponse> {
+  /**
+   * @generated from field: repeated accounts.v1.Account accounts = 1;
+   */
+  accounts: Account[] = [];
+
+  constructor(data?: PartialMessage<ListAccountsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.ListAccountsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "accounts", kind: "message", T: Account, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAccountsResponse {
+    return new ListAccountsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAccountsResponse {
+    return new ListAccountsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAccountsResponse {
+    return new ListAccountsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAccountsResponse | PlainMessage<ListAccountsResponse> | undefined, b: ListAccountsResponse | PlainMessage<ListAccountsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAccountsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.CreateTransferRequest
+ */
+export class CreateTransferRequest extends Message<CreateTransferRequest> {
+  /**
+   * @generated from field: uint64 from_account_id = 1;
+   */
+  fromAccountId = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 to_account_id = 2;
+   */
+  toAccountId = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 4;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description = "";
+
+  constructor(data?: PartialMessage<CreateTransferRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.CreateTransferRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "to_account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "date", kind: "message", T: Timestamp },
+    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTransferRequest {
+    return new CreateTransferRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTransferRequest {
+    return new CreateTransferRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTransferRequest {
+    return new CreateTransferRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTransferRequest | PlainMessage<CreateTransferRequest> | undefined, b: CreateTransferRequest | PlainMessage<CreateTransferRequest> | undefined): boolean {
+    return proto3.util.equals(CreateTransferRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.CreateTransferResponse
+ */
+export class CreateTransferResponse extends Message<CreateTransferResponse> {
+  /**
+   * @generated from field: accounts.v1.Transfer transfer = 1;
+   */
+  transfer?: Transfer;
+
+  constructor(data?: PartialMessage<CreateTransferResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.CreateTransferResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transfer", kind: "message", T: Transfer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTransferResponse {
+    return new CreateTransferResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTransferResponse {
+    return new CreateTransferResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTransferResponse {
+    return new CreateTransferResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTransferResponse | PlainMessage<CreateTransferResponse> | undefined, b: CreateTransferResponse | PlainMessage<CreateTransferResponse> | undefined): boolean {
+    return proto3.util.equals(CreateTransferResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.DeleteTransferRequest
+ */
+export class DeleteTransferRequest extends Message<DeleteTransferRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteTransferRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.DeleteTransferRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTransferRequest {
+    return new DeleteTransferRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTransferRequest {
+    return new DeleteTransferRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTransferRequest {
+    return new DeleteTransferRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTransferRequest | PlainMessage<DeleteTransferRequest> | undefined, b: DeleteTransferRequest | PlainMessage<DeleteTransferRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteTransferRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.DeleteTransferResponse
+ */
+export class DeleteTransferResponse extends Message<DeleteTransferResponse> {
+  constructor(data?: PartialMessage<DeleteTransferResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.DeleteTransferResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTransferResponse {
+    return new DeleteTransferResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTransferResponse {
+    return new DeleteTransferResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTransferResponse {
+    return new DeleteTransferResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTransferResponse | PlainMessage<DeleteTransferResponse> | undefined, b: DeleteTransferResponse | PlainMessage<DeleteTransferResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteTransferResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.ListTransfersRequest
+ */
+export class ListTransfersRequest extends Message<ListTransfersRequest> {
+  /**
+   * @generated from field: optional uint64 account_id = 1;
+   */
+  accountId?: bigint;
+
+  constructor(data?: PartialMessage<ListTransfersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.ListTransfersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTransfersRequest {
+    return new ListTransfersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTransfersRequest {
+    return new ListTransfersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTransfersRequest {
+    return new ListTransfersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTransfersRequest | PlainMessage<ListTransfersRequest> | undefined, b: ListTransfersRequest | PlainMessage<ListTransfersRequest> | undefined): boolean {
+    return proto3.util.equals(ListTransfersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.ListTransfersResponse
+ */
+export class ListTransfersResponse extends Message<ListTransfersResponse> {
+  /**
+   * @generated from field: repeated accounts.v1.Transfer transfers = 1;
+   */
+  transfers: Transfer[] = [];
+
+  constructor(data?: PartialMessage<ListTransfersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.ListTransfersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transfers", kind: "message", T: Transfer, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTransfersResponse {
+    return new ListTransfersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTransfersResponse {
+    return new ListTransfersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTransfersResponse {
+    return new ListTransfersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTransfersResponse | PlainMessage<ListTransfersResponse> | undefined, b: ListTransfersResponse | PlainMessage<ListTransfersResponse> | undefined): boolean {
+    return proto3.util.equals(ListTransfersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.GetBalanceHistoryRequest
+ */
+export class GetBalanceHistoryRequest extends Message<GetBalanceHistoryRequest> {
+  /**
+   * @generated from field: uint64 account_id = 1;
+   */
+  accountId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetBalanceHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.GetBalanceHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBalanceHistoryRequest {
+    return new GetBalanceHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBalanceHistoryRequest {
+    return new GetBalanceHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBalanceHistoryRequest {
+    return new GetBalanceHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBalanceHistoryRequest | PlainMessage<GetBalanceHistoryRequest> | undefined, b: GetBalanceHistoryRequest | PlainMessage<GetBalanceHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetBalanceHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.GetBalanceHistoryResponse
+ */
+export class GetBalanceHistoryResponse extends Message<GetBalanceHistoryResponse> {
+  /**
+   * @generated from field: repeated accounts.v1.BalancePoint points = 1;
+   */
+  points: BalancePoint[] = [];
+
+  constructor(data?: PartialMessage<GetBalanceHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.GetBalanceHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "points", kind: "message", T: BalancePoint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBalanceHistoryResponse {
+    return new GetBalanceHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBalanceHistoryResponse {
+    return new GetBalanceHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBalanceHistoryResponse {
+    return new GetBalanceHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBalanceHistoryResponse | PlainMessage<GetBalanceHistoryResponse> | undefined, b: GetBalanceHistoryResponse | PlainMessage<GetBalanceHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetBalanceHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.Account
+ */
+export class Account extends Message<Account> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: accounts.v1.AccountType type = 3;
+   */
+  type = AccountType.UNSPECIFIED;
+
+  /**
+   * Balances are in cents and may be negative, i.e. credit cards.
+   *
+   * @generated from field: int64 opening_balance = 4;
+   */
+  openingBalance = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp opening_date = 5;
+   */
+  openingDate?: Timestamp;
+
+  /**
+   * @generated from field: int64 current_balance = 6;
+   */
+  currentBalance = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Account>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.Account";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(AccountType) },
+    { no: 4, name: "opening_balance", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "opening_date", kind: "message", T: Timestamp },
+    { no: 6, name: "current_balance", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Account {
+    return new Account().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Account {
+    return new Account().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Account {
+    return new Account().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Account | PlainMessage<Account> | undefined, b: Account | PlainMessage<Account> | undefined): boolean {
+    return proto3.util.equals(Account, a, b);
+  }
+}
+
+/**
+ * @generated from message accounts.v1.Transfer
+ */
+export class Transfer extends Message<Transfer> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 from_account_id = 2;
+   */
+  fromAccountId = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 to_account_id = 3;
+   */
+  toAccountId = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 amount = 4;
+   */
+  amount = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 5;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: string description = 6;
+   */
+  description = "";
+
+  constructor(data?: PartialMessage<Transfer>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.Transfer";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "from_account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "to_account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "date", kind: "message", T: Timestamp },
+    { no: 6, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Transfer {
+    return new Transfer().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Transfer {
+    return new Transfer().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Transfer {
+    return new Transfer().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Transfer | PlainMessage<Transfer> | undefined, b: Transfer | PlainMessage<Transfer> | undefined): boolean {
+    return proto3.util.equals(Transfer, a, b);
+  }
+}
+
+/**
+ * BalancePoint is the closing balance of an account at the end of a month.
+ *
+ * @generated from message accounts.v1.BalancePoint
+ */
+export class BalancePoint extends Message<BalancePoint> {
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 1;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: int64 balance = 2;
+   */
+  balance = protoInt64.zero;
+
+  constructor(data?: PartialMessage<BalancePoint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "accounts.v1.BalancePoint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "date", kind: "message", T: Timestamp },
+    { no: 2, name: "balance", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BalancePoint {
+    return new BalancePoint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BalancePoint {
+    return new BalancePoint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BalancePoint {
+    return new BalancePoint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BalancePoint | PlainMessage<BalancePoint> | undefined, b: BalancePoint | PlainMessage<BalancePoint> | undefined): boolean {
+    return proto3.util.equals(BalancePoint, a, b);
+  }
+}
+
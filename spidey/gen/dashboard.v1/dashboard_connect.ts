@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file dashboard.v1/dashboard.proto (package dashboard.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetDashboardRequest, GetDashboardResponse } from "./dashboard_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service dashboard.v1.DashboardService
+ */
+export const DashboardService = {
+  typeName: "dashboard.v1.DashboardService",
+  methods: {
+    /**
+     * @generated from rpc dashboard.v1.DashboardService.GetDashboard
+     */
+    getDashboard: {
+      name: "GetDashboard",
+      I: GetDashboardRequest,
+      O: GetDashboardResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
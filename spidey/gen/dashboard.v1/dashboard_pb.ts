@@ -0,0 +1,327 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file dashboard.v1/dashboard.proto (package dashboard.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message dashboard.v1.GetDashboardRequest
+ */
+export class GetDashboardRequest extends Message<GetDashboardRequest> {
+  constructor(data?: PartialMessage<GetDashboardRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "dashboard.v1.GetDashboardRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDashboardRequest {
+    return new GetDashboardRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDashboardRequest {
+    return new GetDashboardRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDashboardRequest {
+    return new GetDashboardRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDashboardRequest | PlainMessage<GetDashboardRequest> | undefined, b: GetDashboardRequest | PlainMessage<GetDashboardRequest> | undefined): boolean {
+    return proto3.util.equals(GetDashboardRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message dashboard.v1.GetDashboardResponse
+ */
+export class GetDashboardResponse extends Message<GetDashboardResponse> {
+  /**
+   * Date of the most recent expense. Unset when there are no expenses.
+   *
+   * @generated from field: optional google.protobuf.Timestamp most_recent = 1;
+   */
+  mostRecent?: Timestamp;
+
+  /**
+   * @generated from field: repeated string categories = 2;
+   */
+  categories: string[] = [];
+
+  /**
+   * @generated from field: dashboard.v1.Chart categories_chart = 3;
+   */
+  categoriesChart?: Chart;
+
+  /**
+   * One chart per category, breaking it down by subcategory.
+   *
+   * @generated from field: repeated dashboard.v1.Chart subcategories_charts = 4;
+   */
+  subcategoriesCharts: Chart[] = [];
+
+  /**
+   * Top three subcategories of the most recent month.
+   *
+   * @generated from field: repeated dashboard.v1.CategoryAggregate top_categories = 5;
+   */
+  topCategories: CategoryAggregate[] = [];
+
+  /**
+   * Total spend of each of the last three months, oldest first.
+   *
+   * @generated from field: repeated dashboard.v1.MonthlySpend total_spends = 6;
+   */
+  totalSpends: MonthlySpend[] = [];
+
+  constructor(data?: PartialMessage<GetDashboardResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "dashboard.v1.GetDashboardResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "most_recent", kind: "message", T: Timestamp, opt: true },
+    { no: 2, name: "categories", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "categories_chart", kind: "message", T: Chart },
+    { no: 4, name: "subcategories_charts", kind: "message", T: Chart, repeated: true },
+    { no: 5, name: "top_categories", kind: "message", T: CategoryAggregate, repeated: true },
+    { no: 6, name: "total_spends", kind: "message", T: MonthlySpend, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDashboardResponse {
+    return new GetDashboardResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDashboardResponse {
+    return new GetDashboardResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDashboardResponse {
+    return new GetDashboardResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDashboardResponse | PlainMessage<GetDashboardResponse> | undefined, b: GetDashboardResponse | PlainMessage<GetDashboardResponse> | undefined): boolean {
+    return proto3.util.equals(GetDashboardResponse, a, b);
+  }
+}
+
+/**
+ * Chart holds a dataset per month, each with a value per label.
+ *
+ * @generated from message dashboard.v1.Chart
+ */
+export class Chart extends Message<Chart> {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title = "";
+
+  /**
+   * @generated from field: repeated string labels = 2;
+   */
+  labels: string[] = [];
+
+  /**
+   * @generated from field: repeated dashboard.v1.Dataset datasets = 3;
+   */
+  datasets: Dataset[] = [];
+
+  constructor(data?: PartialMessage<Chart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "dashboard.v1.Chart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "labels", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "datasets", kind: "message", T: Dataset, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Chart {
+    return new Chart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Chart {
+    return new Chart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Chart {
+    return new Chart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Chart | PlainMessage<Chart> | undefined, b: Chart | PlainMessage<Chart> | undefined): boolean {
+    return proto3.util.equals(Chart, a, b);
+  }
+}
+
+/**
+ * Dataset is the series of a single month, labelled as 2006-01. The data is in
+ * cents and follows the order of the labels of the chart.
+ *
+ * @generated from message dashboard.v1.Dataset
+ */
+export class Dataset extends Message<Dataset> {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label = "";
+
+  /**
+   * @generated from field: string border_colour = 2;
+   */
+  borderColour = "";
+
+  /**
+   * @generated from field: string background_colour = 3;
+   */
+  backgroundColour = "";
+
+  /**
+   * @generated from field: bool hidden = 4;
+   */
+  hidden = false;
+
+  /**
+   * @generated from field: repeated uint64 data = 5;
+   */
+  data: bigint[] = [];
+
+  constructor(data?: PartialMessage<Dataset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "dashboard.v1.Dataset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "border_colour", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "background_colour", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "hidden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "data", kind: "scalar", T: 4 /* ScalarType.UINT64 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Dataset {
+    return new Dataset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Dataset {
+    return new Dataset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Dataset {
+    return new Dataset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Dataset | PlainMessage<Dataset> | undefined, b: Dataset | PlainMessage<Dataset> | undefined): boolean {
+    return proto3.util.equals(Dataset, a, b);
+  }
+}
+
+/**
+ * @generated from message dashboard.v1.CategoryAggregate
+ */
+export class CategoryAggregate extends Message<CategoryAggregate> {
+  /**
+   * @generated from field: string category = 1;
+   */
+  category = "";
+
+  /**
+   * @generated from field: string month_year = 2;
+   */
+  monthYear = "";
+
+  /**
+   * @generated from field: uint64 total_amount = 3;
+   */
+  totalAmount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CategoryAggregate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "dashboard.v1.CategoryAggregate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "month_year", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "total_amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CategoryAggregate {
+    return new CategoryAggregate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CategoryAggregate {
+    return new CategoryAggregate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CategoryAggregate {
+    return new CategoryAggregate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CategoryAggregate | PlainMessage<CategoryAggregate> | undefined, b: CategoryAggregate | PlainMessage<CategoryAggregate> | undefined): boolean {
+    return proto3.util.equals(CategoryAggregate, a, b);
+  }
+}
+
+/**
+ * @generated from message dashboard.v1.MonthlySpend
+ */
+export class MonthlySpend extends Message<MonthlySpend> {
+  /**
+   * @generated from field: string month_year = 1;
+   */
+  monthYear = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 2;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MonthlySpend>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "dashboard.v1.MonthlySpend";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "month_year", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "date", kind: "message", T: Timestamp },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MonthlySpend {
+    return new MonthlySpend().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MonthlySpend {
+    return new MonthlySpend().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MonthlySpend {
+    return new MonthlySpend().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MonthlySpend | PlainMessage<MonthlySpend> | undefined, b: MonthlySpend | PlainMessage<MonthlySpend> | undefined): boolean {
+    return proto3.util.equals(MonthlySpend, a, b);
+  }
+}
+
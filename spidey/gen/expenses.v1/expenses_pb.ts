@@ -25,6 +25,11 @@ export class CreateExpenseRequest extends Message<CreateExpenseRequest> {
    */
   receiptId?: bigint;
 
+  /**
+   * @generated from field: optional uint64 account_id = 4;
+   */
+  accountId?: bigint;
+
   constructor(data?: PartialMessage<CreateExpenseRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -36,6 +41,7 @@ export class CreateExpenseRequest extends Message<CreateExpenseRequest> {
     { no: 1, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 2, name: "date", kind: "message", T: Timestamp },
     { no: 3, name: "receipt_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 4, name: "account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateExpenseRequest {
@@ -131,6 +137,11 @@ export class UpdateExpenseRequest extends Message<UpdateExpenseRequest> {
    */
   description?: string;
 
+  /**
+   * @generated from field: optional uint64 account_id = 8;
+   */
+  accountId?: bigint;
+
   constructor(data?: PartialMessage<UpdateExpenseRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -146,6 +157,7 @@ export class UpdateExpenseRequest extends Message<UpdateExpenseRequest> {
     { no: 5, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 6, name: "subcategory", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 7, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateExpenseRequest {
@@ -389,6 +401,11 @@ export class Expense extends Message<Expense> {
    */
   description = "";
 
+  /**
+   * @generated from field: optional uint64 account_id = 8;
+   */
+  accountId?: bigint;
+
   constructor(data?: PartialMessage<Expense>) {
     super();
     proto3.util.initPartial(data, this);
@@ -404,6 +421,7 @@ export class Expense extends Message<Expense> {
     { no: 5, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "subcategory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Expense {
@@ -0,0 +1,98 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file households.v1/households.proto (package households.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { AcceptInviteRequest, AcceptInviteResponse, CreateHouseholdRequest, CreateHouseholdResponse, DeclineInviteRequest, DeclineInviteResponse, GetHouseholdRequest, GetHouseholdResponse, InviteMemberRequest, InviteMemberResponse, LeaveHouseholdRequest, LeaveHouseholdResponse, ListInvitesRequest, ListInvitesResponse, RemoveMemberRequest, RemoveMemberResponse, UpdateMemberRoleRequest, UpdateMemberRoleResponse } from "./households_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service households.v1.HouseholdsService
+ */
+export const HouseholdsService = {
+  typeName: "households.v1.HouseholdsService",
+  methods: {
+    /**
+     * @generated from rpc households.v1.HouseholdsService.CreateHousehold
+     */
+    createHousehold: {
+      name: "CreateHousehold",
+      I: CreateHouseholdRequest,
+      O: CreateHouseholdResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.GetHousehold
+     */
+    getHousehold: {
+      name: "GetHousehold",
+      I: GetHouseholdRequest,
+      O: GetHouseholdResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.InviteMember
+     */
+    inviteMember: {
+      name: "InviteMember",
+      I: InviteMemberRequest,
+      O: InviteMemberResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.ListInvites
+     */
+    listInvites: {
+      name: "ListInvites",
+      I: ListInvitesRequest,
+      O: ListInvitesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.AcceptInvite
+     */
+    acceptInvite: {
+      name: "AcceptInvite",
+      I: AcceptInviteRequest,
+      O: AcceptInviteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.DeclineInvite
+     */
+    declineInvite: {
+      name: "DeclineInvite",
+      I: DeclineInviteRequest,
+      O: DeclineInviteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.LeaveHousehold
+     */
+    leaveHousehold: {
+      name: "LeaveHousehold",
+      I: LeaveHouseholdRequest,
+      O: LeaveHouseholdResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.RemoveMember
+     */
+    removeMember: {
+      name: "RemoveMember",
+      I: RemoveMemberRequest,
+      O: RemoveMemberResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc households.v1.HouseholdsService.UpdateMemberRole
+     */
+    updateMemberRole: {
+      name: "UpdateMemberRole",
+      I: UpdateMemberRoleRequest,
+      O: UpdateMemberRoleResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
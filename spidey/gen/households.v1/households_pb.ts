@@ -0,0 +1,851 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file households.v1/households.proto (package households.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum households.v1.MemberRole
+ */
+export enum MemberRole {
+  /**
+   * @generated from enum value: MEMBER_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MEMBER_ROLE_OWNER = 1;
+   */
+  OWNER = 1,
+
+  /**
+   * @generated from enum value: MEMBER_ROLE_READ_WRITE = 2;
+   */
+  READ_WRITE = 2,
+
+  /**
+   * @generated from enum value: MEMBER_ROLE_READ = 3;
+   */
+  READ = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MemberRole)
+proto3.util.setEnumType(MemberRole, "households.v1.MemberRole", [
+  { no: 0, name: "MEMBER_ROLE_UNSPECIFIED" },
+  { no: 1, name: "MEMBER_ROLE_OWNER" },
+  { no: 2, name: "MEMBER_ROLE_READ_WRITE" },
+  { no: 3, name: "MEMBER_ROLE_READ" },
+]);
+
+/**
+ * @generated from message households.v1.CreateHouseholdRequest
+ */
+export class CreateHouseholdRequest extends Message<CreateHouseholdRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateHouseholdRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.CreateHouseholdRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateHouseholdRequest {
+    return new CreateHouseholdRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateHouseholdRequest {
+    return new CreateHouseholdRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateHouseholdRequest {
+    return new CreateHouseholdRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateHouseholdRequest | PlainMessage<CreateHouseholdRequest> | undefined, b: CreateHouseholdRequest | PlainMessage<CreateHouseholdRequest> | undefined): boolean {
+    return proto3.util.equals(CreateHouseholdRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.CreateHouseholdResponse
+ */
+export class CreateHouseholdResponse extends Message<CreateHouseholdResponse> {
+  /**
+   * @generated from field: households.v1.Household household = 1;
+   */
+  household?: Household;
+
+  constructor(data?: PartialMessage<CreateHouseholdResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.CreateHouseholdResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "household", kind: "message", T: Household },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateHouseholdResponse {
+    return new CreateHouseholdResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateHouseholdResponse {
+    return new CreateHouseholdResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateHouseholdResponse {
+    return new CreateHouseholdResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateHouseholdResponse | PlainMessage<CreateHouseholdResponse> | undefined, b: CreateHouseholdResponse | PlainMessage<CreateHouseholdResponse> | undefined): boolean {
+    return proto3.util.equals(CreateHouseholdResponse, a, b);
+  }
+}
+
+/**
+ * GetHouseholdRequest fetches the household of the requesting user.
+ *
+ * @generated from message households.v1.GetHouseholdRequest
+ */
+export class GetHouseholdRequest extends Message<GetHouseholdRequest> {
+  constructor(data?: PartialMessage<GetHouseholdRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.GetHouseholdRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetHouseholdRequest {
+    return new GetHouseholdRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetHouseholdRequest {
+    return new GetHouseholdRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetHouseholdRequest {
+    return new GetHouseholdRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetHouseholdRequest | PlainMessage<GetHouseholdRequest> | undefined, b: GetHouseholdRequest | PlainMessage<GetHouseholdRequest> | undefined): boolean {
+    return proto3.util.equals(GetHouseholdRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.GetHouseholdResponse
+ */
+export class GetHouseholdResponse extends Message<GetHouseholdResponse> {
+  /**
+   * @generated from field: households.v1.Household household = 1;
+   */
+  household?: Household;
+
+  constructor(data?: PartialMessage<GetHouseholdResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.GetHouseholdResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "household", kind: "message", T: Household },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetHouseholdResponse {
+    return new GetHouseholdResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetHouseholdResponse {
+    return new GetHouseholdResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetHouseholdResponse {
+    return new GetHouseholdResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetHouseholdResponse | PlainMessage<GetHouseholdResponse> | undefined, b: GetHouseholdResponse | PlainMessage<GetHouseholdResponse> | undefined): boolean {
+    return proto3.util.equals(GetHouseholdResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.InviteMemberRequest
+ */
+export class InviteMemberRequest extends Message<InviteMemberRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: households.v1.MemberRole role = 2;
+   */
+  role = MemberRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<InviteMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.InviteMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(MemberRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteMemberRequest {
+    return new InviteMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InviteMemberRequest {
+    return new InviteMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InviteMemberRequest {
+    return new InviteMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InviteMemberRequest | PlainMessage<InviteMemberRequest> | undefined, b: InviteMemberRequest | PlainMessage<InviteMemberRequest> | undefined): boolean {
+    return proto3.util.equals(InviteMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.InviteMemberResponse
+ */
+export class InviteMemberResponse extends Message<InviteMemberResponse> {
+  /**
+   * @generated from field: households.v1.Invite invite = 1;
+   */
+  invite?: Invite;
+
+  constructor(data?: PartialMessage<InviteMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.InviteMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invite", kind: "message", T: Invite },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteMemberResponse {
+    return new InviteMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InviteMemberResponse {
+    return new InviteMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InviteMemberResponse {
+    return new InviteMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InviteMemberResponse | PlainMessage<InviteMemberResponse> | undefined, b: InviteMemberResponse | PlainMessage<InviteMemberResponse> | undefined): boolean {
+    return proto3.util.equals(InviteMemberResponse, a, b);
+  }
+}
+
+/**
+ * ListInvitesRequest lists the pending invites of the requesting user.
+ *
+ * @generated from message households.v1.ListInvitesRequest
+ */
+export class ListInvitesRequest extends Message<ListInvitesRequest> {
+  constructor(data?: PartialMessage<ListInvitesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.ListInvitesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListInvitesRequest {
+    return new ListInvitesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListInvitesRequest {
+    return new ListInvitesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListInvitesRequest {
+    return new ListInvitesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListInvitesRequest | PlainMessage<ListInvitesRequest> | undefined, b: ListInvitesRequest | PlainMessage<ListInvitesRequest> | undefined): boolean {
+    return proto3.util.equals(ListInvitesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.ListInvitesResponse
+ */
+export class ListInvitesResponse extends Message<ListInvitesResponse> {
+  /**
+   * @generated from field: repeated households.v1.Invite invites = 1;
+   */
+  invites: Invite[] = [];
+
+  constructor(data?: PartialMessage<ListInvitesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.ListInvitesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invites", kind: "message", T: Invite, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListInvitesResponse {
+    return new ListInvitesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListInvitesResponse {
+    return new ListInvitesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListInvitesResponse {
+    return new ListInvitesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListInvitesResponse | PlainMessage<ListInvitesResponse> | undefined, b: ListInvitesResponse | PlainMessage<ListInvitesResponse> | undefined): boolean {
+    return proto3.util.equals(ListInvitesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.AcceptInviteRequest
+ */
+export class AcceptInviteRequest extends Message<AcceptInviteRequest> {
+  /**
+   * @generated from field: uint64 invite_id = 1;
+   */
+  inviteId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<AcceptInviteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.AcceptInviteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invite_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptInviteRequest {
+    return new AcceptInviteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptInviteRequest {
+    return new AcceptInviteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptInviteRequest {
+    return new AcceptInviteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptInviteRequest | PlainMessage<AcceptInviteRequest> | undefined, b: AcceptInviteRequest | PlainMessage<AcceptInviteRequest> | undefined): boolean {
+    return proto3.util.equals(AcceptInviteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.AcceptInviteResponse
+ */
+export class AcceptInviteResponse extends Message<AcceptInviteResponse> {
+  /**
+   * @generated from field: households.v1.Household household = 1;
+   */
+  household?: Household;
+
+  constructor(data?: PartialMessage<AcceptInviteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.AcceptInviteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "household", kind: "message", T: Household },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptInviteResponse {
+    return new AcceptInviteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptInviteResponse {
+    return new AcceptInviteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptInviteResponse {
+    return new AcceptInviteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptInviteResponse | PlainMessage<AcceptInviteResponse> | undefined, b: AcceptInviteResponse | PlainMessage<AcceptInviteResponse> | undefined): boolean {
+    return proto3.util.equals(AcceptInviteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.DeclineInviteRequest
+ */
+export class DeclineInviteRequest extends Message<DeclineInviteRequest> {
+  /**
+   * @generated from field: uint64 invite_id = 1;
+   */
+  inviteId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeclineInviteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.DeclineInviteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invite_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeclineInviteRequest {
+    return new DeclineInviteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeclineInviteRequest {
+    return new DeclineInviteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeclineInviteRequest {
+    return new DeclineInviteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeclineInviteRequest | PlainMessage<DeclineInviteRequest> | undefined, b: DeclineInviteRequest | PlainMessage<DeclineInviteRequest> | undefined): boolean {
+    return proto3.util.equals(DeclineInviteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.DeclineInviteResponse
+ */
+export class DeclineInviteResponse extends Message<DeclineInviteResponse> {
+  constructor(data?: PartialMessage<DeclineInviteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.DeclineInviteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeclineInviteResponse {
+    return new DeclineInviteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeclineInviteResponse {
+    return new DeclineInviteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeclineInviteResponse {
+    return new DeclineInviteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeclineInviteResponse | PlainMessage<DeclineInviteResponse> | undefined, b: DeclineInviteResponse | PlainMessage<DeclineInviteResponse> | undefined): boolean {
+    return proto3.util.equals(DeclineInviteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.LeaveHouseholdRequest
+ */
+export class LeaveHouseholdRequest extends Message<LeaveHouseholdRequest> {
+  constructor(data?: PartialMessage<LeaveHouseholdRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.LeaveHouseholdRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveHouseholdRequest {
+    return new LeaveHouseholdRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveHouseholdRequest {
+    return new LeaveHouseholdRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveHouseholdRequest {
+    return new LeaveHouseholdRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveHouseholdRequest | PlainMessage<LeaveHouseholdRequest> | undefined, b: LeaveHouseholdRequest | PlainMessage<LeaveHouseholdRequest> | undefined): boolean {
+    return proto3.util.equals(LeaveHouseholdRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.LeaveHouseholdResponse
+ */
+export class LeaveHouseholdResponse extends Message<LeaveHouseholdResponse> {
+  constructor(data?: PartialMessage<LeaveHouseholdResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.LeaveHouseholdResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveHouseholdResponse {
+    return new LeaveHouseholdResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveHouseholdResponse {
+    return new LeaveHouseholdResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveHouseholdResponse {
+    return new LeaveHouseholdResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveHouseholdResponse | PlainMessage<LeaveHouseholdResponse> | undefined, b: LeaveHouseholdResponse | PlainMessage<LeaveHouseholdResponse> | undefined): boolean {
+    return proto3.util.equals(LeaveHouseholdResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.RemoveMemberRequest
+ */
+export class RemoveMemberRequest extends Message<RemoveMemberRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<RemoveMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.RemoveMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveMemberRequest | PlainMessage<RemoveMemberRequest> | undefined, b: RemoveMemberRequest | PlainMessage<RemoveMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.RemoveMemberResponse
+ */
+export class RemoveMemberResponse extends Message<RemoveMemberResponse> {
+  constructor(data?: PartialMessage<RemoveMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.RemoveMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveMemberResponse | PlainMessage<RemoveMemberResponse> | undefined, b: RemoveMemberResponse | PlainMessage<RemoveMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.UpdateMemberRoleRequest
+ */
+export class UpdateMemberRoleRequest extends Message<UpdateMemberRoleRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: households.v1.MemberRole role = 2;
+   */
+  role = MemberRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<UpdateMemberRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.UpdateMemberRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(MemberRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMemberRoleRequest {
+    return new UpdateMemberRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMemberRoleRequest {
+    return new UpdateMemberRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMemberRoleRequest {
+    return new UpdateMemberRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMemberRoleRequest | PlainMessage<UpdateMemberRoleRequest> | undefined, b: UpdateMemberRoleRequest | PlainMessage<UpdateMemberRoleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateMemberRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.UpdateMemberRoleResponse
+ */
+export class UpdateMemberRoleResponse extends Message<UpdateMemberRoleResponse> {
+  constructor(data?: PartialMessage<UpdateMemberRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.UpdateMemberRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMemberRoleResponse {
+    return new UpdateMemberRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMemberRoleResponse {
+    return new UpdateMemberRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMemberRoleResponse {
+    return new UpdateMemberRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMemberRoleResponse | PlainMessage<UpdateMemberRoleResponse> | undefined, b: UpdateMemberRoleResponse | PlainMessage<UpdateMemberRoleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateMemberRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.Household
+ */
+export class Household extends Message<Household> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string owner_email = 3;
+   */
+  ownerEmail = "";
+
+  /**
+   * @generated from field: repeated households.v1.Member members = 4;
+   */
+  members: Member[] = [];
+
+  constructor(data?: PartialMessage<Household>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.Household";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "owner_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "members", kind: "message", T: Member, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Household {
+    return new Household().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Household {
+    return new Household().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Household {
+    return new Household().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Household | PlainMessage<Household> | undefined, b: Household | PlainMessage<Household> | undefined): boolean {
+    return proto3.util.equals(Household, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.Member
+ */
+export class Member extends Message<Member> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: households.v1.MemberRole role = 2;
+   */
+  role = MemberRole.UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp joined_at = 3;
+   */
+  joinedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Member>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.Member";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(MemberRole) },
+    { no: 3, name: "joined_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Member {
+    return new Member().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Member {
+    return new Member().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Member {
+    return new Member().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Member | PlainMessage<Member> | undefined, b: Member | PlainMessage<Member> | undefined): boolean {
+    return proto3.util.equals(Member, a, b);
+  }
+}
+
+/**
+ * @generated from message households.v1.Invite
+ */
+export class Invite extends Message<Invite> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 household_id = 2;
+   */
+  householdId = protoInt64.zero;
+
+  /**
+   * @generated from field: string inviter_email = 3;
+   */
+  inviterEmail = "";
+
+  /**
+   * @generated from field: string invitee_email = 4;
+   */
+  inviteeEmail = "";
+
+  /**
+   * @generated from field: households.v1.MemberRole role = 5;
+   */
+  role = MemberRole.UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Invite>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "households.v1.Invite";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "household_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "inviter_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "invitee_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "role", kind: "enum", T: proto3.getEnumType(MemberRole) },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Invite {
+    return new Invite().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Invite {
+    return new Invite().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Invite {
+    return new Invite().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Invite | PlainMessage<Invite> | undefined, b: Invite | PlainMessage<Invite> | undefined): boolean {
+    return proto3.util.equals(Invite, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateReceiptsRequest, CreateReceiptsResponse, DeleteReceiptRequest, DeleteReceiptResponse, GetReceiptRequest, GetReceiptResponse, ListReceiptsRequest, ListReceiptsResponse, UpdateReceiptRequest, UpdateReceiptResponse } from "./receipts_pb.ts";
+import { ApplyReceiptChangesRequest, ApplyReceiptChangesResponse, CreateReceiptsRequest, CreateReceiptsResponse, DeleteReceiptRequest, DeleteReceiptResponse, GenerateExpenseReportRequest, GenerateExpenseReportResponse, GetReceiptRequest, GetReceiptResponse, GetVATReportRequest, GetVATReportResponse, ListReceiptsRequest, ListReceiptsResponse, ListReconciliationProposalsRequest, ListReconciliationProposalsResponse, ReconcileReceiptRequest, ReconcileReceiptResponse, ReparseReceiptRequest, ReparseReceiptResponse, SearchReceiptsRequest, SearchReceiptsResponse, UpdateReceiptRequest, UpdateReceiptResponse, UploadReceiptsRequest } from "./receipts_pb.ts";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -21,6 +21,19 @@ export const ReceiptsService = {
       O: CreateReceiptsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Uploads receipts in chunks, so that large files don't have to fit in a
+     * single message. Rejected receipts reference the files in the order they
+     * were sent.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.UploadReceipts
+     */
+    uploadReceipts: {
+      name: "UploadReceipts",
+      I: UploadReceiptsRequest,
+      O: CreateReceiptsResponse,
+      kind: MethodKind.ClientStreaming,
+    },
     /**
      * @generated from rpc receipts.v1.ReceiptsService.UpdateReceipt
      */
@@ -57,6 +70,87 @@ export const ReceiptsService = {
       O: GetReceiptResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Runs the parser again on the stored file of the receipt and returns what
+     * would change, without changing anything.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.ReparseReceipt
+     */
+    reparseReceipt: {
+      name: "ReparseReceipt",
+      I: ReparseReceiptRequest,
+      O: ReparseReceiptResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Applies some or all of the changes returned by ReparseReceipt.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.ApplyReceiptChanges
+     */
+    applyReceiptChanges: {
+      name: "ApplyReceiptChanges",
+      I: ApplyReceiptChangesRequest,
+      O: ApplyReceiptChangesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Finds receipts by their vendor, the description of their expenses or their
+     * text, best matches first.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.SearchReceipts
+     */
+    searchReceipts: {
+      name: "SearchReceipts",
+      I: SearchReceiptsRequest,
+      O: SearchReceiptsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Proposes the bank transaction which most likely paid each receipt, so
+     * that imported statements don't count the money twice.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.ListReconciliationProposals
+     */
+    listReconciliationProposals: {
+      name: "ListReconciliationProposals",
+      I: ListReconciliationProposalsRequest,
+      O: ListReconciliationProposalsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Merges a bank transaction into the expenses of the receipt it paid.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.ReconcileReceipt
+     */
+    reconcileReceipt: {
+      name: "ReconcileReceipt",
+      I: ReconcileReceiptRequest,
+      O: ReconcileReceiptResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sums the deductible VAT of the receipts of a quarter by rate.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.GetVATReport
+     */
+    getVATReport: {
+      name: "GetVATReport",
+      I: GetVATReportRequest,
+      O: GetVATReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Generates a PDF with the expenses of the receipts which match the filters
+     * and the receipts themselves, to hand in for reimbursements.
+     *
+     * @generated from rpc receipts.v1.ReceiptsService.GenerateExpenseReport
+     */
+    generateExpenseReport: {
+      name: "GenerateExpenseReport",
+      I: GenerateExpenseReportRequest,
+      O: GenerateExpenseReportResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
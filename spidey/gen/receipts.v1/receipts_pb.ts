@@ -6,6 +6,210 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum receipts.v1.DuplicatePolicy
+ */
+export enum DuplicatePolicy {
+  /**
+   * Defaults to flagging them.
+   *
+   * @generated from enum value: DUPLICATE_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Create them but point them to the receipt they're a duplicate of.
+   *
+   * @generated from enum value: DUPLICATE_POLICY_FLAG = 1;
+   */
+  FLAG = 1,
+
+  /**
+   * Don't create them.
+   *
+   * @generated from enum value: DUPLICATE_POLICY_REJECT = 2;
+   */
+  REJECT = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DuplicatePolicy)
+proto3.util.setEnumType(DuplicatePolicy, "receipts.v1.DuplicatePolicy", [
+  { no: 0, name: "DUPLICATE_POLICY_UNSPECIFIED" },
+  { no: 1, name: "DUPLICATE_POLICY_FLAG" },
+  { no: 2, name: "DUPLICATE_POLICY_REJECT" },
+]);
+
+/**
+ * @generated from enum receipts.v1.DuplicateReason
+ */
+export enum DuplicateReason {
+  /**
+   * @generated from enum value: DUPLICATE_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The exact same file.
+   *
+   * @generated from enum value: DUPLICATE_REASON_SAME_FILE = 1;
+   */
+  SAME_FILE = 1,
+
+  /**
+   * A picture which looks like the existing one.
+   *
+   * @generated from enum value: DUPLICATE_REASON_SIMILAR_IMAGE = 2;
+   */
+  SIMILAR_IMAGE = 2,
+
+  /**
+   * The same vendor, date and amount.
+   *
+   * @generated from enum value: DUPLICATE_REASON_SAME_DETAILS = 3;
+   */
+  SAME_DETAILS = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DuplicateReason)
+proto3.util.setEnumType(DuplicateReason, "receipts.v1.DuplicateReason", [
+  { no: 0, name: "DUPLICATE_REASON_UNSPECIFIED" },
+  { no: 1, name: "DUPLICATE_REASON_SAME_FILE" },
+  { no: 2, name: "DUPLICATE_REASON_SIMILAR_IMAGE" },
+  { no: 3, name: "DUPLICATE_REASON_SAME_DETAILS" },
+]);
+
+/**
+ * @generated from enum receipts.v1.ReceiptSplit
+ */
+export enum ReceiptSplit {
+  /**
+   * @generated from enum value: RECEIPT_SPLIT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RECEIPT_SPLIT_NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * @generated from enum value: RECEIPT_SPLIT_PER_ITEM = 2;
+   */
+  PER_ITEM = 2,
+
+  /**
+   * @generated from enum value: RECEIPT_SPLIT_PER_CATEGORY = 3;
+   */
+  PER_CATEGORY = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ReceiptSplit)
+proto3.util.setEnumType(ReceiptSplit, "receipts.v1.ReceiptSplit", [
+  { no: 0, name: "RECEIPT_SPLIT_UNSPECIFIED" },
+  { no: 1, name: "RECEIPT_SPLIT_NONE" },
+  { no: 2, name: "RECEIPT_SPLIT_PER_ITEM" },
+  { no: 3, name: "RECEIPT_SPLIT_PER_CATEGORY" },
+]);
+
+/**
+ * @generated from enum receipts.v1.FileErrorReason
+ */
+export enum FileErrorReason {
+  /**
+   * @generated from enum value: FILE_ERROR_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Not a PDF nor an image.
+   *
+   * @generated from enum value: FILE_ERROR_REASON_UNSUPPORTED_TYPE = 1;
+   */
+  UNSUPPORTED_TYPE = 1,
+
+  /**
+   * @generated from enum value: FILE_ERROR_REASON_TOO_LARGE = 2;
+   */
+  TOO_LARGE = 2,
+
+  /**
+   * The file doesn't match its declared size or checksum, usually because
+   * the upload was cut short.
+   *
+   * @generated from enum value: FILE_ERROR_REASON_CORRUPTED = 3;
+   */
+  CORRUPTED = 3,
+
+  /**
+   * The file is of a supported type but can't be decoded, so the parser
+   * wouldn't read it either.
+   *
+   * @generated from enum value: FILE_ERROR_REASON_UNREADABLE = 4;
+   */
+  UNREADABLE = 4,
+
+  /**
+   * The file looks like an existing receipt and duplicates are rejected.
+   *
+   * @generated from enum value: FILE_ERROR_REASON_DUPLICATE = 5;
+   */
+  DUPLICATE = 5,
+
+  /**
+   * Storing the receipt failed.
+   *
+   * @generated from enum value: FILE_ERROR_REASON_INTERNAL = 6;
+   */
+  INTERNAL = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FileErrorReason)
+proto3.util.setEnumType(FileErrorReason, "receipts.v1.FileErrorReason", [
+  { no: 0, name: "FILE_ERROR_REASON_UNSPECIFIED" },
+  { no: 1, name: "FILE_ERROR_REASON_UNSUPPORTED_TYPE" },
+  { no: 2, name: "FILE_ERROR_REASON_TOO_LARGE" },
+  { no: 3, name: "FILE_ERROR_REASON_CORRUPTED" },
+  { no: 4, name: "FILE_ERROR_REASON_UNREADABLE" },
+  { no: 5, name: "FILE_ERROR_REASON_DUPLICATE" },
+  { no: 6, name: "FILE_ERROR_REASON_INTERNAL" },
+]);
+
+/**
+ * @generated from enum receipts.v1.ListReceiptsOrder
+ */
+export enum ListReceiptsOrder {
+  /**
+   * Defaults to the most recent first.
+   *
+   * @generated from enum value: LIST_RECEIPTS_ORDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LIST_RECEIPTS_ORDER_DATE_DESC = 1;
+   */
+  DATE_DESC = 1,
+
+  /**
+   * @generated from enum value: LIST_RECEIPTS_ORDER_DATE_ASC = 2;
+   */
+  DATE_ASC = 2,
+
+  /**
+   * @generated from enum value: LIST_RECEIPTS_ORDER_AMOUNT_DESC = 3;
+   */
+  AMOUNT_DESC = 3,
+
+  /**
+   * @generated from enum value: LIST_RECEIPTS_ORDER_AMOUNT_ASC = 4;
+   */
+  AMOUNT_ASC = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ListReceiptsOrder)
+proto3.util.setEnumType(ListReceiptsOrder, "receipts.v1.ListReceiptsOrder", [
+  { no: 0, name: "LIST_RECEIPTS_ORDER_UNSPECIFIED" },
+  { no: 1, name: "LIST_RECEIPTS_ORDER_DATE_DESC" },
+  { no: 2, name: "LIST_RECEIPTS_ORDER_DATE_ASC" },
+  { no: 3, name: "LIST_RECEIPTS_ORDER_AMOUNT_DESC" },
+  { no: 4, name: "LIST_RECEIPTS_ORDER_AMOUNT_ASC" },
+]);
+
 /**
  * @generated from enum receipts.v1.ListReceiptsSince
  */
@@ -39,6 +243,10 @@ proto3.util.setEnumType(ListReceiptsSince, "receipts.v1.ListReceiptsSince", [
 ]);
 
 /**
+ * The lifecycle of a receipt: uploaded, parsing, pending review and reviewed.
+ * Receipts which can't be parsed end up failed, and any of them can be
+ * archived.
+ *
  * @generated from enum receipts.v1.ReceiptStatus
  */
 export enum ReceiptStatus {
@@ -48,6 +256,8 @@ export enum ReceiptStatus {
   UNSPECIFIED = 0,
 
   /**
+   * The receipt has been parsed and its expenses have to be checked.
+   *
    * @generated from enum value: RECEIPT_STATUS_PENDING_REVIEW = 1;
    */
   PENDING_REVIEW = 1,
@@ -56,15 +266,119 @@ export enum ReceiptStatus {
    * @generated from enum value: RECEIPT_STATUS_REVIEWED = 2;
    */
   REVIEWED = 2,
+
+  /**
+   * The receipt is waiting to be parsed in the background.
+   *
+   * @generated from enum value: RECEIPT_STATUS_UPLOADED = 3;
+   */
+  UPLOADED = 3,
+
+  /**
+   * The receipt couldn't be parsed, so its details have to be filled in by
+   * hand.
+   *
+   * @generated from enum value: RECEIPT_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+
+  /**
+   * @generated from enum value: RECEIPT_STATUS_PARSING = 5;
+   */
+  PARSING = 5,
+
+  /**
+   * @generated from enum value: RECEIPT_STATUS_ARCHIVED = 6;
+   */
+  ARCHIVED = 6,
 }
 // Retrieve enum metadata with: proto3.getEnumType(ReceiptStatus)
 proto3.util.setEnumType(ReceiptStatus, "receipts.v1.ReceiptStatus", [
   { no: 0, name: "RECEIPT_STATUS_UNSPECIFIED" },
   { no: 1, name: "RECEIPT_STATUS_PENDING_REVIEW" },
   { no: 2, name: "RECEIPT_STATUS_REVIEWED" },
+  { no: 3, name: "RECEIPT_STATUS_UPLOADED" },
+  { no: 4, name: "RECEIPT_STATUS_FAILED" },
+  { no: 5, name: "RECEIPT_STATUS_PARSING" },
+  { no: 6, name: "RECEIPT_STATUS_ARCHIVED" },
+]);
+
+/**
+ * @generated from enum receipts.v1.ParserBackend
+ */
+export enum ParserBackend {
+  /**
+   * @generated from enum value: PARSER_BACKEND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PARSER_BACKEND_VISION = 1;
+   */
+  VISION = 1,
+
+  /**
+   * @generated from enum value: PARSER_BACKEND_TEXTRACT = 2;
+   */
+  TEXTRACT = 2,
+
+  /**
+   * @generated from enum value: PARSER_BACKEND_PDF_TEXT = 3;
+   */
+  PDF_TEXT = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ParserBackend)
+proto3.util.setEnumType(ParserBackend, "receipts.v1.ParserBackend", [
+  { no: 0, name: "PARSER_BACKEND_UNSPECIFIED" },
+  { no: 1, name: "PARSER_BACKEND_VISION" },
+  { no: 2, name: "PARSER_BACKEND_TEXTRACT" },
+  { no: 3, name: "PARSER_BACKEND_PDF_TEXT" },
+]);
+
+/**
+ * @generated from enum receipts.v1.ReceiptField
+ */
+export enum ReceiptField {
+  /**
+   * @generated from enum value: RECEIPT_FIELD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RECEIPT_FIELD_VENDOR = 1;
+   */
+  VENDOR = 1,
+
+  /**
+   * @generated from enum value: RECEIPT_FIELD_DATE = 2;
+   */
+  DATE = 2,
+
+  /**
+   * @generated from enum value: RECEIPT_FIELD_AMOUNT = 3;
+   */
+  AMOUNT = 3,
+
+  /**
+   * @generated from enum value: RECEIPT_FIELD_DESCRIPTION = 4;
+   */
+  DESCRIPTION = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ReceiptField)
+proto3.util.setEnumType(ReceiptField, "receipts.v1.ReceiptField", [
+  { no: 0, name: "RECEIPT_FIELD_UNSPECIFIED" },
+  { no: 1, name: "RECEIPT_FIELD_VENDOR" },
+  { no: 2, name: "RECEIPT_FIELD_DATE" },
+  { no: 3, name: "RECEIPT_FIELD_AMOUNT" },
+  { no: 4, name: "RECEIPT_FIELD_DESCRIPTION" },
 ]);
 
 /**
+ * Receipts are created straight away with the uploaded status and parsed in
+ * the background. Only duplicates of an existing file or image are rejected in
+ * the response: those with the same details are only spotted once parsed, and
+ * deleted then. Files must be PDFs, images or the text of an e-receipt.
+ *
  * @generated from message receipts.v1.CreateReceiptsRequest
  */
 export class CreateReceiptsRequest extends Message<CreateReceiptsRequest> {
@@ -73,6 +387,21 @@ export class CreateReceiptsRequest extends Message<CreateReceiptsRequest> {
    */
   receiptFiles: Uint8Array[] = [];
 
+  /**
+   * How the amount of each receipt is turned into expenses. Splitting only
+   * happens when the line items add up to the total of the receipt.
+   *
+   * @generated from field: receipts.v1.ReceiptSplit split = 2;
+   */
+  split = ReceiptSplit.UNSPECIFIED;
+
+  /**
+   * What to do with receipts which look like one the user already has.
+   *
+   * @generated from field: receipts.v1.DuplicatePolicy duplicate_policy = 3;
+   */
+  duplicatePolicy = DuplicatePolicy.UNSPECIFIED;
+
   constructor(data?: PartialMessage<CreateReceiptsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -82,6 +411,8 @@ export class CreateReceiptsRequest extends Message<CreateReceiptsRequest> {
   static readonly typeName = "receipts.v1.CreateReceiptsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "receipt_files", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
+    { no: 2, name: "split", kind: "enum", T: proto3.getEnumType(ReceiptSplit) },
+    { no: 3, name: "duplicate_policy", kind: "enum", T: proto3.getEnumType(DuplicatePolicy) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateReceiptsRequest {
@@ -102,542 +433,2341 @@ export class CreateReceiptsRequest extends Message<CreateReceiptsRequest> {
 }
 
 /**
- * @generated from message receipts.v1.CreateReceiptsResponse
+ * The options, if any, are sent first. Then each file is sent as its header
+ * followed by its chunks.
+ *
+ * @generated from message receipts.v1.UploadReceiptsRequest
  */
-export class CreateReceiptsResponse extends Message<CreateReceiptsResponse> {
+export class UploadReceiptsRequest extends Message<UploadReceiptsRequest> {
   /**
-   * @generated from field: repeated receipts.v1.Receipt receipts = 1;
+   * @generated from oneof receipts.v1.UploadReceiptsRequest.payload
    */
-  receipts: Receipt[] = [];
+  payload: {
+    /**
+     * @generated from field: receipts.v1.UploadOptions options = 1;
+     */
+    value: UploadOptions;
+    case: "options";
+  } | {
+    /**
+     * @generated from field: receipts.v1.UploadedFile file = 2;
+     */
+    value: UploadedFile;
+    case: "file";
+  } | {
+    /**
+     * @generated from field: bytes chunk = 3;
+     */
+    value: Uint8Array;
+    case: "chunk";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<UploadReceiptsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  constructor(data?: PartialMessage<CreateReceiptsResponse>) {
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.UploadReceiptsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "options", kind: "message", T: UploadOptions, oneof: "payload" },
+    { no: 2, name: "file", kind: "message", T: UploadedFile, oneof: "payload" },
+    { no: 3, name: "chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "payload" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadReceiptsRequest {
+    return new UploadReceiptsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadReceiptsRequest {
+    return new UploadReceiptsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadReceiptsRequest {
+    return new UploadReceiptsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadReceiptsRequest | PlainMessage<UploadReceiptsRequest> | undefined, b: UploadReceiptsRequest | PlainMessage<UploadReceiptsRequest> | undefined): boolean {
+    return proto3.util.equals(UploadReceiptsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.UploadOptions
+ */
+export class UploadOptions extends Message<UploadOptions> {
+  /**
+   * @generated from field: receipts.v1.ReceiptSplit split = 1;
+   */
+  split = ReceiptSplit.UNSPECIFIED;
+
+  /**
+   * @generated from field: receipts.v1.DuplicatePolicy duplicate_policy = 2;
+   */
+  duplicatePolicy = DuplicatePolicy.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<UploadOptions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.CreateReceiptsResponse";
+  static readonly typeName = "receipts.v1.UploadOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "receipts", kind: "message", T: Receipt, repeated: true },
+    { no: 1, name: "split", kind: "enum", T: proto3.getEnumType(ReceiptSplit) },
+    { no: 2, name: "duplicate_policy", kind: "enum", T: proto3.getEnumType(DuplicatePolicy) },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateReceiptsResponse {
-    return new CreateReceiptsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadOptions {
+    return new UploadOptions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateReceiptsResponse {
-    return new CreateReceiptsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadOptions {
+    return new UploadOptions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateReceiptsResponse {
-    return new CreateReceiptsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadOptions {
+    return new UploadOptions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateReceiptsResponse | PlainMessage<CreateReceiptsResponse> | undefined, b: CreateReceiptsResponse | PlainMessage<CreateReceiptsResponse> | undefined): boolean {
-    return proto3.util.equals(CreateReceiptsResponse, a, b);
+  static equals(a: UploadOptions | PlainMessage<UploadOptions> | undefined, b: UploadOptions | PlainMessage<UploadOptions> | undefined): boolean {
+    return proto3.util.equals(UploadOptions, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.UpdateReceiptRequest
+ * The chunks of the file must add up to its size and checksum, and its
+ * contents must be of the declared MIME type. Only PDFs and JPEG, PNG, GIF
+ * and WebP images are accepted, up to 10 MiB each and 50 MiB per upload.
+ *
+ * @generated from message receipts.v1.UploadedFile
  */
-export class UpdateReceiptRequest extends Message<UpdateReceiptRequest> {
+export class UploadedFile extends Message<UploadedFile> {
   /**
-   * @generated from field: uint64 id = 1;
+   * Only used to report errors.
+   *
+   * @generated from field: string name = 1;
    */
-  id = protoInt64.zero;
+  name = "";
 
   /**
-   * @generated from field: optional string vendor = 2;
+   * @generated from field: string mime_type = 2;
    */
-  vendor?: string;
+  mimeType = "";
 
   /**
-   * @generated from field: optional bool pending_review = 3;
+   * @generated from field: uint64 size = 3;
    */
-  pendingReview?: boolean;
+  size = protoInt64.zero;
 
   /**
-   * @generated from field: optional google.protobuf.Timestamp date = 4;
+   * The hex encoded SHA-256 of the file.
+   *
+   * @generated from field: string sha256 = 4;
    */
-  date?: Timestamp;
+  sha256 = "";
 
-  constructor(data?: PartialMessage<UpdateReceiptRequest>) {
+  constructor(data?: PartialMessage<UploadedFile>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.UpdateReceiptRequest";
+  static readonly typeName = "receipts.v1.UploadedFile";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 3, name: "pending_review", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
-    { no: 4, name: "date", kind: "message", T: Timestamp, opt: true },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReceiptRequest {
-    return new UpdateReceiptRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadedFile {
+    return new UploadedFile().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReceiptRequest {
-    return new UpdateReceiptRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadedFile {
+    return new UploadedFile().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReceiptRequest {
-    return new UpdateReceiptRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadedFile {
+    return new UploadedFile().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateReceiptRequest | PlainMessage<UpdateReceiptRequest> | undefined, b: UpdateReceiptRequest | PlainMessage<UpdateReceiptRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateReceiptRequest, a, b);
+  static equals(a: UploadedFile | PlainMessage<UploadedFile> | undefined, b: UploadedFile | PlainMessage<UploadedFile> | undefined): boolean {
+    return proto3.util.equals(UploadedFile, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.UpdateReceiptResponse
+ * @generated from message receipts.v1.Duplicate
  */
-export class UpdateReceiptResponse extends Message<UpdateReceiptResponse> {
-  constructor(data?: PartialMessage<UpdateReceiptResponse>) {
+export class Duplicate extends Message<Duplicate> {
+  /**
+   * The existing receipt which matched.
+   *
+   * @generated from field: uint64 receipt_id = 1;
+   */
+  receiptId = protoInt64.zero;
+
+  /**
+   * @generated from field: receipts.v1.DuplicateReason reason = 2;
+   */
+  reason = DuplicateReason.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<Duplicate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.UpdateReceiptResponse";
+  static readonly typeName = "receipts.v1.Duplicate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "receipt_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "reason", kind: "enum", T: proto3.getEnumType(DuplicateReason) },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReceiptResponse {
-    return new UpdateReceiptResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Duplicate {
+    return new Duplicate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReceiptResponse {
-    return new UpdateReceiptResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Duplicate {
+    return new Duplicate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReceiptResponse {
-    return new UpdateReceiptResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Duplicate {
+    return new Duplicate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateReceiptResponse | PlainMessage<UpdateReceiptResponse> | undefined, b: UpdateReceiptResponse | PlainMessage<UpdateReceiptResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateReceiptResponse, a, b);
+  static equals(a: Duplicate | PlainMessage<Duplicate> | undefined, b: Duplicate | PlainMessage<Duplicate> | undefined): boolean {
+    return proto3.util.equals(Duplicate, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.DeleteReceiptRequest
+ * Each file succeeds or fails on its own: a file which can't be stored
+ * doesn't stop the rest from being created.
+ *
+ * @generated from message receipts.v1.CreateReceiptsResponse
  */
-export class DeleteReceiptRequest extends Message<DeleteReceiptRequest> {
+export class CreateReceiptsResponse extends Message<CreateReceiptsResponse> {
   /**
-   * @generated from field: uint64 id = 1;
+   * The receipts created, also found in results.
+   *
+   * @generated from field: repeated receipts.v1.Receipt receipts = 1;
    */
-  id = protoInt64.zero;
+  receipts: Receipt[] = [];
 
-  constructor(data?: PartialMessage<DeleteReceiptRequest>) {
+  /**
+   * Superseded by results, which also include the duplicates rejected.
+   *
+   * @generated from field: repeated receipts.v1.RejectedReceipt rejected = 2;
+   */
+  rejected: RejectedReceipt[] = [];
+
+  /**
+   * One for each file, in the order they were sent.
+   *
+   * @generated from field: repeated receipts.v1.FileResult results = 3;
+   */
+  results: FileResult[] = [];
+
+  constructor(data?: PartialMessage<CreateReceiptsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.DeleteReceiptRequest";
+  static readonly typeName = "receipts.v1.CreateReceiptsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 1, name: "receipts", kind: "message", T: Receipt, repeated: true },
+    { no: 2, name: "rejected", kind: "message", T: RejectedReceipt, repeated: true },
+    { no: 3, name: "results", kind: "message", T: FileResult, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReceiptRequest {
-    return new DeleteReceiptRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateReceiptsResponse {
+    return new CreateReceiptsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReceiptRequest {
-    return new DeleteReceiptRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateReceiptsResponse {
+    return new CreateReceiptsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateReceiptsResponse {
+    return new CreateReceiptsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateReceiptsResponse | PlainMessage<CreateReceiptsResponse> | undefined, b: CreateReceiptsResponse | PlainMessage<CreateReceiptsResponse> | undefined): boolean {
+    return proto3.util.equals(CreateReceiptsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.FileResult
+ */
+export class FileResult extends Message<FileResult> {
+  /**
+   * The position of the file in the request.
+   *
+   * @generated from field: uint32 file_index = 1;
+   */
+  fileIndex = 0;
+
+  /**
+   * The name of the file, for uploads which send it.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from oneof receipts.v1.FileResult.result
+   */
+  result: {
+    /**
+     * @generated from field: receipts.v1.Receipt receipt = 3;
+     */
+    value: Receipt;
+    case: "receipt";
+  } | {
+    /**
+     * @generated from field: receipts.v1.FileError error = 4;
+     */
+    value: FileError;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<FileResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.FileResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "file_index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "receipt", kind: "message", T: Receipt, oneof: "result" },
+    { no: 4, name: "error", kind: "message", T: FileError, oneof: "result" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FileResult {
+    return new FileResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FileResult {
+    return new FileResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FileResult {
+    return new FileResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FileResult | PlainMessage<FileResult> | undefined, b: FileResult | PlainMessage<FileResult> | undefined): boolean {
+    return proto3.util.equals(FileResult, a, b);
+  }
+}
+
+/**
+ * Parsing happens after the receipts are created, so files which can't be
+ * read only fail here when they can't even be decoded. Otherwise the receipt
+ * ends up with the failed status.
+ *
+ * @generated from message receipts.v1.FileError
+ */
+export class FileError extends Message<FileError> {
+  /**
+   * @generated from field: receipts.v1.FileErrorReason reason = 1;
+   */
+  reason = FileErrorReason.UNSPECIFIED;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * Set when the reason is a duplicate.
+   *
+   * @generated from field: receipts.v1.Duplicate duplicate_of = 3;
+   */
+  duplicateOf?: Duplicate;
+
+  /**
+   * Whether sending the same file again may work.
+   *
+   * @generated from field: bool retryable = 4;
+   */
+  retryable = false;
+
+  constructor(data?: PartialMessage<FileError>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.FileError";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reason", kind: "enum", T: proto3.getEnumType(FileErrorReason) },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "duplicate_of", kind: "message", T: Duplicate },
+    { no: 4, name: "retryable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FileError {
+    return new FileError().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FileError {
+    return new FileError().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FileError {
+    return new FileError().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FileError | PlainMessage<FileError> | undefined, b: FileError | PlainMessage<FileError> | undefined): boolean {
+    return proto3.util.equals(FileError, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.RejectedReceipt
+ */
+export class RejectedReceipt extends Message<RejectedReceipt> {
+  /**
+   * The position of the file in the request.
+   *
+   * @generated from field: uint32 file_index = 1;
+   */
+  fileIndex = 0;
+
+  /**
+   * @generated from field: receipts.v1.Duplicate duplicate_of = 2;
+   */
+  duplicateOf?: Duplicate;
+
+  constructor(data?: PartialMessage<RejectedReceipt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.RejectedReceipt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "file_index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "duplicate_of", kind: "message", T: Duplicate },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectedReceipt {
+    return new RejectedReceipt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectedReceipt {
+    return new RejectedReceipt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectedReceipt {
+    return new RejectedReceipt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectedReceipt | PlainMessage<RejectedReceipt> | undefined, b: RejectedReceipt | PlainMessage<RejectedReceipt> | undefined): boolean {
+    return proto3.util.equals(RejectedReceipt, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.UpdateReceiptRequest
+ */
+export class UpdateReceiptRequest extends Message<UpdateReceiptRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: optional string vendor = 2;
+   */
+  vendor?: string;
+
+  /**
+   * Superseded by status: true moves the receipt to pending review and false
+   * to reviewed. Ignored when status is set.
+   *
+   * @generated from field: optional bool pending_review = 3;
+   */
+  pendingReview?: boolean;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp date = 4;
+   */
+  date?: Timestamp;
+
+  /**
+   * Moves the receipt to the status. Fails with a failed precondition error
+   * when the receipt can't get there from its current status.
+   *
+   * @generated from field: optional receipts.v1.ReceiptStatus status = 5;
+   */
+  status?: ReceiptStatus;
+
+  constructor(data?: PartialMessage<UpdateReceiptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.UpdateReceiptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "pending_review", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 4, name: "date", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "status", kind: "enum", T: proto3.getEnumType(ReceiptStatus), opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReceiptRequest {
+    return new UpdateReceiptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReceiptRequest {
+    return new UpdateReceiptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReceiptRequest {
+    return new UpdateReceiptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateReceiptRequest | PlainMessage<UpdateReceiptRequest> | undefined, b: UpdateReceiptRequest | PlainMessage<UpdateReceiptRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateReceiptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.UpdateReceiptResponse
+ */
+export class UpdateReceiptResponse extends Message<UpdateReceiptResponse> {
+  constructor(data?: PartialMessage<UpdateReceiptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.UpdateReceiptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReceiptResponse {
+    return new UpdateReceiptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReceiptResponse {
+    return new UpdateReceiptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReceiptResponse {
+    return new UpdateReceiptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateReceiptResponse | PlainMessage<UpdateReceiptResponse> | undefined, b: UpdateReceiptResponse | PlainMessage<UpdateReceiptResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateReceiptResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.DeleteReceiptRequest
+ */
+export class DeleteReceiptRequest extends Message<DeleteReceiptRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteReceiptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.DeleteReceiptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReceiptRequest {
+    return new DeleteReceiptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReceiptRequest {
+    return new DeleteReceiptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReceiptRequest {
+    return new DeleteReceiptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteReceiptRequest | PlainMessage<DeleteReceiptRequest> | undefined, b: DeleteReceiptRequest | PlainMessage<DeleteReceiptRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteReceiptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.DeleteReceiptResponse
+ */
+export class DeleteReceiptResponse extends Message<DeleteReceiptResponse> {
+  constructor(data?: PartialMessage<DeleteReceiptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.DeleteReceiptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReceiptResponse {
+    return new DeleteReceiptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReceiptResponse {
+    return new DeleteReceiptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReceiptResponse {
+    return new DeleteReceiptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteReceiptResponse | PlainMessage<DeleteReceiptResponse> | undefined, b: DeleteReceiptResponse | PlainMessage<DeleteReceiptResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteReceiptResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.ListReceiptsRequest
+ */
+export class ListReceiptsRequest extends Message<ListReceiptsRequest> {
+  /**
+   * Superseded by date_range, which takes precedence when set.
+   *
+   * @generated from field: receipts.v1.ListReceiptsSince since = 1;
+   */
+  since = ListReceiptsSince.UNSPECIFIED;
+
+  /**
+   * Only lists the receipts in the status. Archived receipts are left out
+   * unless they're asked for.
+   *
+   * @generated from field: receipts.v1.ReceiptStatus status = 2;
+   */
+  status = ReceiptStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: receipts.v1.DateRange date_range = 3;
+   */
+  dateRange?: DateRange;
+
+  /**
+   * Only lists the receipts whose vendor contains it, regardless of case.
+   *
+   * @generated from field: string vendor = 4;
+   */
+  vendor = "";
+
+  /**
+   * Bounds of the total of the expenses of the receipt, in cents.
+   *
+   * @generated from field: optional uint64 min_amount = 5;
+   */
+  minAmount?: bigint;
+
+  /**
+   * @generated from field: optional uint64 max_amount = 6;
+   */
+  maxAmount?: bigint;
+
+  /**
+   * @generated from field: receipts.v1.ListReceiptsOrder order = 7;
+   */
+  order = ListReceiptsOrder.UNSPECIFIED;
+
+  /**
+   * Lists every receipt when zero.
+   *
+   * @generated from field: uint32 page_size = 8;
+   */
+  pageSize = 0;
+
+  /**
+   * The next_page_token of the previous page, listed with the same order.
+   *
+   * @generated from field: string page_token = 9;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListReceiptsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ListReceiptsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since", kind: "enum", T: proto3.getEnumType(ListReceiptsSince) },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
+    { no: 3, name: "date_range", kind: "message", T: DateRange },
+    { no: 4, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "min_amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 6, name: "max_amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 7, name: "order", kind: "enum", T: proto3.getEnumType(ListReceiptsOrder) },
+    { no: 8, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReceiptsRequest {
+    return new ListReceiptsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReceiptsRequest {
+    return new ListReceiptsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReceiptsRequest {
+    return new ListReceiptsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListReceiptsRequest | PlainMessage<ListReceiptsRequest> | undefined, b: ListReceiptsRequest | PlainMessage<ListReceiptsRequest> | undefined): boolean {
+    return proto3.util.equals(ListReceiptsRequest, a, b);
+  }
+}
+
+/**
+ * Either end can be left out. From is inclusive and to exclusive.
+ *
+ * @generated from message receipts.v1.DateRange
+ */
+export class DateRange extends Message<DateRange> {
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 1;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 2;
+   */
+  to?: Timestamp;
+
+  constructor(data?: PartialMessage<DateRange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.DateRange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from", kind: "message", T: Timestamp },
+    { no: 2, name: "to", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DateRange {
+    return new DateRange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DateRange {
+    return new DateRange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DateRange {
+    return new DateRange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DateRange | PlainMessage<DateRange> | undefined, b: DateRange | PlainMessage<DateRange> | undefined): boolean {
+    return proto3.util.equals(DateRange, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.StatusTransition
+ */
+export class StatusTransition extends Message<StatusTransition> {
+  /**
+   * Unspecified for the status the receipt was created in.
+   *
+   * @generated from field: receipts.v1.ReceiptStatus from = 1;
+   */
+  from = ReceiptStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: receipts.v1.ReceiptStatus to = 2;
+   */
+  to = ReceiptStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp at = 4;
+   */
+  at?: Timestamp;
+
+  constructor(data?: PartialMessage<StatusTransition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.StatusTransition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
+    { no: 2, name: "to", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusTransition {
+    return new StatusTransition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusTransition {
+    return new StatusTransition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusTransition {
+    return new StatusTransition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusTransition | PlainMessage<StatusTransition> | undefined, b: StatusTransition | PlainMessage<StatusTransition> | undefined): boolean {
+    return proto3.util.equals(StatusTransition, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.Receipt
+ */
+export class Receipt extends Message<Receipt> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: receipts.v1.ReceiptStatus status = 2;
+   */
+  status = ReceiptStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string vendor = 3;
+   */
+  vendor = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 4;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: repeated receipts.v1.Expense expenses = 5;
+   */
+  expenses: Expense[] = [];
+
+  /**
+   * @generated from field: optional receipts.v1.Duplicate duplicate_of = 6;
+   */
+  duplicateOf?: Duplicate;
+
+  constructor(data?: PartialMessage<Receipt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.Receipt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
+    { no: 3, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "date", kind: "message", T: Timestamp },
+    { no: 5, name: "expenses", kind: "message", T: Expense, repeated: true },
+    { no: 6, name: "duplicate_of", kind: "message", T: Duplicate, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Receipt {
+    return new Receipt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Receipt {
+    return new Receipt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Receipt {
+    return new Receipt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Receipt | PlainMessage<Receipt> | undefined, b: Receipt | PlainMessage<Receipt> | undefined): boolean {
+    return proto3.util.equals(Receipt, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.Expense
+ */
+export class Expense extends Message<Expense> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 2;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: string category = 3;
+   */
+  category = "";
+
+  /**
+   * @generated from field: string subcategory = 4;
+   */
+  subcategory = "";
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description = "";
+
+  /**
+   * @generated from field: uint64 amount = 6;
+   */
+  amount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Expense>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.Expense";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "date", kind: "message", T: Timestamp },
+    { no: 3, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "subcategory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Expense {
+    return new Expense().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Expense {
+    return new Expense().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Expense {
+    return new Expense().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Expense | PlainMessage<Expense> | undefined, b: Expense | PlainMessage<Expense> | undefined): boolean {
+    return proto3.util.equals(Expense, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.ListReceiptsResponse
+ */
+export class ListReceiptsResponse extends Message<ListReceiptsResponse> {
+  /**
+   * @generated from field: repeated receipts.v1.Receipt receipts = 1;
+   */
+  receipts: Receipt[] = [];
+
+  /**
+   * Empty on the last page.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListReceiptsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ListReceiptsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "receipts", kind: "message", T: Receipt, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReceiptsResponse {
+    return new ListReceiptsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReceiptsResponse {
+    return new ListReceiptsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReceiptsResponse {
+    return new ListReceiptsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListReceiptsResponse | PlainMessage<ListReceiptsResponse> | undefined, b: ListReceiptsResponse | PlainMessage<ListReceiptsResponse> | undefined): boolean {
+    return proto3.util.equals(ListReceiptsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.GetReceiptRequest
+ */
+export class GetReceiptRequest extends Message<GetReceiptRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetReceiptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.GetReceiptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReceiptRequest {
+    return new GetReceiptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReceiptRequest {
+    return new GetReceiptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReceiptRequest {
+    return new GetReceiptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetReceiptRequest | PlainMessage<GetReceiptRequest> | undefined, b: GetReceiptRequest | PlainMessage<GetReceiptRequest> | undefined): boolean {
+    return proto3.util.equals(GetReceiptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.GetReceiptResponse
+ */
+export class GetReceiptResponse extends Message<GetReceiptResponse> {
+  /**
+   * @generated from field: receipts.v1.FullReceipt receipt = 1;
+   */
+  receipt?: FullReceipt;
+
+  constructor(data?: PartialMessage<GetReceiptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.GetReceiptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "receipt", kind: "message", T: FullReceipt },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReceiptResponse {
+    return new GetReceiptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReceiptResponse {
+    return new GetReceiptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReceiptResponse {
+    return new GetReceiptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetReceiptResponse | PlainMessage<GetReceiptResponse> | undefined, b: GetReceiptResponse | PlainMessage<GetReceiptResponse> | undefined): boolean {
+    return proto3.util.equals(GetReceiptResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.FullReceipt
+ */
+export class FullReceipt extends Message<FullReceipt> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: receipts.v1.ReceiptStatus status = 2;
+   */
+  status = ReceiptStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string vendor = 3;
+   */
+  vendor = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 4;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: bytes file = 5;
+   */
+  file = new Uint8Array(0);
+
+  /**
+   * @generated from field: repeated receipts.v1.Expense expenses = 6;
+   */
+  expenses: Expense[] = [];
+
+  /**
+   * @generated from field: repeated receipts.v1.LineItem items = 7;
+   */
+  items: LineItem[] = [];
+
+  /**
+   * Whether the line items add up to the total of the receipt.
+   *
+   * @generated from field: bool items_reconcile = 8;
+   */
+  itemsReconcile = false;
+
+  /**
+   * @generated from field: optional receipts.v1.Duplicate duplicate_of = 9;
+   */
+  duplicateOf?: Duplicate;
+
+  /**
+   * Explains the last change of status, like why the receipt couldn't be
+   * parsed.
+   *
+   * @generated from field: string status_reason = 10;
+   */
+  statusReason = "";
+
+  /**
+   * Every change of status of the receipt, oldest first.
+   *
+   * @generated from field: repeated receipts.v1.StatusTransition transitions = 11;
+   */
+  transitions: StatusTransition[] = [];
+
+  /**
+   * @generated from field: receipts.v1.ReceiptTax tax = 12;
+   */
+  tax?: ReceiptTax;
+
+  constructor(data?: PartialMessage<FullReceipt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.FullReceipt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
+    { no: 3, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "date", kind: "message", T: Timestamp },
+    { no: 5, name: "file", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 6, name: "expenses", kind: "message", T: Expense, repeated: true },
+    { no: 7, name: "items", kind: "message", T: LineItem, repeated: true },
+    { no: 8, name: "items_reconcile", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "duplicate_of", kind: "message", T: Duplicate, opt: true },
+    { no: 10, name: "status_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "transitions", kind: "message", T: StatusTransition, repeated: true },
+    { no: 12, name: "tax", kind: "message", T: ReceiptTax },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FullReceipt {
+    return new FullReceipt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FullReceipt {
+    return new FullReceipt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FullReceipt {
+    return new FullReceipt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FullReceipt | PlainMessage<FullReceipt> | undefined, b: FullReceipt | PlainMessage<FullReceipt> | undefined): boolean {
+    return proto3.util.equals(FullReceipt, a, b);
+  }
+}
+
+/**
+ * The taxes of a receipt, for business expenses. Empty when the receipt
+ * doesn't break them down. Amounts are in cents.
+ *
+ * @generated from message receipts.v1.ReceiptTax
+ */
+export class ReceiptTax extends Message<ReceiptTax> {
+  /**
+   * The amount before taxes.
+   *
+   * @generated from field: uint64 subtotal = 1;
+   */
+  subtotal = protoInt64.zero;
+
+  /**
+   * @generated from field: string vendor_tax_id = 2;
+   */
+  vendorTaxId = "";
+
+  /**
+   * @generated from field: string invoice_number = 3;
+   */
+  invoiceNumber = "";
+
+  /**
+   * @generated from field: repeated receipts.v1.TaxLine lines = 4;
+   */
+  lines: TaxLine[] = [];
+
+  constructor(data?: PartialMessage<ReceiptTax>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ReceiptTax";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subtotal", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "vendor_tax_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "invoice_number", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "lines", kind: "message", T: TaxLine, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReceiptTax {
+    return new ReceiptTax().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReceiptTax {
+    return new ReceiptTax().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReceiptTax {
+    return new ReceiptTax().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReceiptTax | PlainMessage<ReceiptTax> | undefined, b: ReceiptTax | PlainMessage<ReceiptTax> | undefined): boolean {
+    return proto3.util.equals(ReceiptTax, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.TaxLine
+ */
+export class TaxLine extends Message<TaxLine> {
+  /**
+   * A percentage, like 21 for 21%.
+   *
+   * @generated from field: double rate = 1;
+   */
+  rate = 0;
+
+  /**
+   * @generated from field: uint64 base = 2;
+   */
+  base = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<TaxLine>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.TaxLine";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rate", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "base", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TaxLine {
+    return new TaxLine().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TaxLine {
+    return new TaxLine().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TaxLine {
+    return new TaxLine().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TaxLine | PlainMessage<TaxLine> | undefined, b: TaxLine | PlainMessage<TaxLine> | undefined): boolean {
+    return proto3.util.equals(TaxLine, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.LineItem
+ */
+export class LineItem extends Message<LineItem> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: double quantity = 3;
+   */
+  quantity = 0;
+
+  /**
+   * @generated from field: uint64 unit_price = 4;
+   */
+  unitPrice = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 total = 5;
+   */
+  total = protoInt64.zero;
+
+  /**
+   * @generated from field: string category = 6;
+   */
+  category = "";
+
+  constructor(data?: PartialMessage<LineItem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.LineItem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "quantity", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "unit_price", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "total", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineItem {
+    return new LineItem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineItem {
+    return new LineItem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineItem {
+    return new LineItem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineItem | PlainMessage<LineItem> | undefined, b: LineItem | PlainMessage<LineItem> | undefined): boolean {
+    return proto3.util.equals(LineItem, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.ReparseReceiptRequest
+ */
+export class ReparseReceiptRequest extends Message<ReparseReceiptRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Defaults to the parser picked for the kind of file.
+   *
+   * @generated from field: receipts.v1.ParserBackend backend = 2;
+   */
+  backend = ParserBackend.UNSPECIFIED;
+
+  /**
+   * Extra instructions for the parser, on top of the usual ones.
+   *
+   * @generated from field: string prompt = 3;
+   */
+  prompt = "";
+
+  constructor(data?: PartialMessage<ReparseReceiptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ReparseReceiptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "backend", kind: "enum", T: proto3.getEnumType(ParserBackend) },
+    { no: 3, name: "prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReparseReceiptRequest {
+    return new ReparseReceiptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReparseReceiptRequest {
+    return new ReparseReceiptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReparseReceiptRequest {
+    return new ReparseReceiptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReparseReceiptRequest | PlainMessage<ReparseReceiptRequest> | undefined, b: ReparseReceiptRequest | PlainMessage<ReparseReceiptRequest> | undefined): boolean {
+    return proto3.util.equals(ReparseReceiptRequest, a, b);
+  }
+}
+
+/**
+ * The details of a receipt which the parser reads. The amount and description
+ * are those of its expenses: the total of them, and the description when
+ * there's a single one.
+ *
+ * @generated from message receipts.v1.ReceiptDetails
+ */
+export class ReceiptDetails extends Message<ReceiptDetails> {
+  /**
+   * @generated from field: string vendor = 1;
+   */
+  vendor = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 2;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description = "";
+
+  constructor(data?: PartialMessage<ReceiptDetails>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ReceiptDetails";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "date", kind: "message", T: Timestamp },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReceiptDetails {
+    return new ReceiptDetails().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReceiptDetails {
+    return new ReceiptDetails().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReceiptDetails {
+    return new ReceiptDetails().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReceiptDetails | PlainMessage<ReceiptDetails> | undefined, b: ReceiptDetails | PlainMessage<ReceiptDetails> | undefined): boolean {
+    return proto3.util.equals(ReceiptDetails, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.ReparseReceiptResponse
+ */
+export class ReparseReceiptResponse extends Message<ReparseReceiptResponse> {
+  /**
+   * @generated from field: receipts.v1.ReceiptDetails current = 1;
+   */
+  current?: ReceiptDetails;
+
+  /**
+   * @generated from field: receipts.v1.ReceiptDetails proposed = 2;
+   */
+  proposed?: ReceiptDetails;
+
+  /**
+   * The fields which differ between the current and the proposed details.
+   *
+   * @generated from field: repeated receipts.v1.ReceiptField changed = 3;
+   */
+  changed: ReceiptField[] = [];
+
+  constructor(data?: PartialMessage<ReparseReceiptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ReparseReceiptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "current", kind: "message", T: ReceiptDetails },
+    { no: 2, name: "proposed", kind: "message", T: ReceiptDetails },
+    { no: 3, name: "changed", kind: "enum", T: proto3.getEnumType(ReceiptField), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReparseReceiptResponse {
+    return new ReparseReceiptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReparseReceiptResponse {
+    return new ReparseReceiptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReparseReceiptResponse {
+    return new ReparseReceiptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReparseReceiptResponse | PlainMessage<ReparseReceiptResponse> | undefined, b: ReparseReceiptResponse | PlainMessage<ReparseReceiptResponse> | undefined): boolean {
+    return proto3.util.equals(ReparseReceiptResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.ApplyReceiptChangesRequest
+ */
+export class ApplyReceiptChangesRequest extends Message<ApplyReceiptChangesRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Usually the proposed details returned by ReparseReceipt.
+   *
+   * @generated from field: receipts.v1.ReceiptDetails details = 2;
+   */
+  details?: ReceiptDetails;
+
+  /**
+   * The fields of the details to apply, leaving the rest as they are. The
+   * amount and description can't be changed for receipts which were split
+   * into several expenses.
+   *
+   * @generated from field: repeated receipts.v1.ReceiptField fields = 3;
+   */
+  fields: ReceiptField[] = [];
+
+  constructor(data?: PartialMessage<ApplyReceiptChangesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ApplyReceiptChangesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "details", kind: "message", T: ReceiptDetails },
+    { no: 3, name: "fields", kind: "enum", T: proto3.getEnumType(ReceiptField), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApplyReceiptChangesRequest {
+    return new ApplyReceiptChangesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApplyReceiptChangesRequest {
+    return new ApplyReceiptChangesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApplyReceiptChangesRequest {
+    return new ApplyReceiptChangesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApplyReceiptChangesRequest | PlainMessage<ApplyReceiptChangesRequest> | undefined, b: ApplyReceiptChangesRequest | PlainMessage<ApplyReceiptChangesRequest> | undefined): boolean {
+    return proto3.util.equals(ApplyReceiptChangesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.ApplyReceiptChangesResponse
+ */
+export class ApplyReceiptChangesResponse extends Message<ApplyReceiptChangesResponse> {
+  constructor(data?: PartialMessage<ApplyReceiptChangesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ApplyReceiptChangesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApplyReceiptChangesResponse {
+    return new ApplyReceiptChangesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApplyReceiptChangesResponse {
+    return new ApplyReceiptChangesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApplyReceiptChangesResponse {
+    return new ApplyReceiptChangesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApplyReceiptChangesResponse | PlainMessage<ApplyReceiptChangesResponse> | undefined, b: ApplyReceiptChangesResponse | PlainMessage<ApplyReceiptChangesResponse> | undefined): boolean {
+    return proto3.util.equals(ApplyReceiptChangesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.SearchReceiptsRequest
+ */
+export class SearchReceiptsRequest extends Message<SearchReceiptsRequest> {
+  /**
+   * Words to look for. Quoted phrases, "or" and "-" to exclude words are
+   * supported, like in web search engines.
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * Defaults to 20, and can't be more than 100.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<SearchReceiptsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.SearchReceiptsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchReceiptsRequest {
+    return new SearchReceiptsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchReceiptsRequest {
+    return new SearchReceiptsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchReceiptsRequest {
+    return new SearchReceiptsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchReceiptsRequest | PlainMessage<SearchReceiptsRequest> | undefined, b: SearchReceiptsRequest | PlainMessage<SearchReceiptsRequest> | undefined): boolean {
+    return proto3.util.equals(SearchReceiptsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.SearchReceiptsResponse
+ */
+export class SearchReceiptsResponse extends Message<SearchReceiptsResponse> {
+  /**
+   * @generated from field: repeated receipts.v1.SearchResult results = 1;
+   */
+  results: SearchResult[] = [];
+
+  constructor(data?: PartialMessage<SearchReceiptsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.SearchReceiptsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "results", kind: "message", T: SearchResult, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchReceiptsResponse {
+    return new SearchReceiptsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchReceiptsResponse {
+    return new SearchReceiptsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchReceiptsResponse {
+    return new SearchReceiptsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchReceiptsResponse | PlainMessage<SearchReceiptsResponse> | undefined, b: SearchReceiptsResponse | PlainMessage<SearchReceiptsResponse> | undefined): boolean {
+    return proto3.util.equals(SearchReceiptsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.SearchResult
+ */
+export class SearchResult extends Message<SearchResult> {
+  /**
+   * @generated from field: receipts.v1.Receipt receipt = 1;
+   */
+  receipt?: Receipt;
+
+  /**
+   * The parts of the text of the receipt around the matches, in order.
+   *
+   * @generated from field: repeated receipts.v1.SnippetPart snippet = 2;
+   */
+  snippet: SnippetPart[] = [];
+
+  constructor(data?: PartialMessage<SearchResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.SearchResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "receipt", kind: "message", T: Receipt },
+    { no: 2, name: "snippet", kind: "message", T: SnippetPart, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResult {
+    return new SearchResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResult {
+    return new SearchResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResult {
+    return new SearchResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchResult | PlainMessage<SearchResult> | undefined, b: SearchResult | PlainMessage<SearchResult> | undefined): boolean {
+    return proto3.util.equals(SearchResult, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.SnippetPart
+ */
+export class SnippetPart extends Message<SnippetPart> {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text = "";
+
+  /**
+   * Whether the text matched the query, to highlight it.
+   *
+   * @generated from field: bool match = 2;
+   */
+  match = false;
+
+  constructor(data?: PartialMessage<SnippetPart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.SnippetPart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "match", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnippetPart {
+    return new SnippetPart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnippetPart {
+    return new SnippetPart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnippetPart {
+    return new SnippetPart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SnippetPart | PlainMessage<SnippetPart> | undefined, b: SnippetPart | PlainMessage<SnippetPart> | undefined): boolean {
+    return proto3.util.equals(SnippetPart, a, b);
+  }
+}
+
+/**
+ * @generated from message receipts.v1.ListReconciliationProposalsRequest
+ */
+export class ListReconciliationProposalsRequest extends Message<ListReconciliationProposalsRequest> {
+  constructor(data?: PartialMessage<ListReconciliationProposalsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ListReconciliationProposalsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReconciliationProposalsRequest {
+    return new ListReconciliationProposalsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReconciliationProposalsRequest {
+    return new ListReconciliationProposalsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReceiptRequest {
-    return new DeleteReceiptRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReconciliationProposalsRequest {
+    return new ListReconciliationProposalsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteReceiptRequest | PlainMessage<DeleteReceiptRequest> | undefined, b: DeleteReceiptRequest | PlainMessage<DeleteReceiptRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteReceiptRequest, a, b);
+  static equals(a: ListReconciliationProposalsRequest | PlainMessage<ListReconciliationProposalsRequest> | undefined, b: ListReconciliationProposalsRequest | PlainMessage<ListReconciliationProposalsRequest> | undefined): boolean {
+    return proto3.util.equals(ListReconciliationProposalsRequest, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.DeleteReceiptResponse
+ * @generated from message receipts.v1.ListReconciliationProposalsResponse
  */
-export class DeleteReceiptResponse extends Message<DeleteReceiptResponse> {
-  constructor(data?: PartialMessage<DeleteReceiptResponse>) {
+export class ListReconciliationProposalsResponse extends Message<ListReconciliationProposalsResponse> {
+  /**
+   * Best matches first. Each receipt and transaction is proposed once at most.
+   *
+   * @generated from field: repeated receipts.v1.ReconciliationProposal proposals = 1;
+   */
+  proposals: ReconciliationProposal[] = [];
+
+  constructor(data?: PartialMessage<ListReconciliationProposalsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.DeleteReceiptResponse";
+  static readonly typeName = "receipts.v1.ListReconciliationProposalsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "proposals", kind: "message", T: ReconciliationProposal, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteReceiptResponse {
-    return new DeleteReceiptResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReconciliationProposalsResponse {
+    return new ListReconciliationProposalsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteReceiptResponse {
-    return new DeleteReceiptResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReconciliationProposalsResponse {
+    return new ListReconciliationProposalsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteReceiptResponse {
-    return new DeleteReceiptResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReconciliationProposalsResponse {
+    return new ListReconciliationProposalsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteReceiptResponse | PlainMessage<DeleteReceiptResponse> | undefined, b: DeleteReceiptResponse | PlainMessage<DeleteReceiptResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteReceiptResponse, a, b);
+  static equals(a: ListReconciliationProposalsResponse | PlainMessage<ListReconciliationProposalsResponse> | undefined, b: ListReconciliationProposalsResponse | PlainMessage<ListReconciliationProposalsResponse> | undefined): boolean {
+    return proto3.util.equals(ListReconciliationProposalsResponse, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.ListReceiptsRequest
+ * @generated from message receipts.v1.ReconciliationProposal
  */
-export class ListReceiptsRequest extends Message<ListReceiptsRequest> {
+export class ReconciliationProposal extends Message<ReconciliationProposal> {
   /**
-   * @generated from field: receipts.v1.ListReceiptsSince since = 1;
+   * @generated from field: uint64 receipt_id = 1;
    */
-  since = ListReceiptsSince.UNSPECIFIED;
+  receiptId = protoInt64.zero;
 
   /**
-   * @generated from field: receipts.v1.ReceiptStatus status = 2;
+   * @generated from field: string vendor = 2;
    */
-  status = ReceiptStatus.UNSPECIFIED;
+  vendor = "";
 
-  constructor(data?: PartialMessage<ListReceiptsRequest>) {
+  /**
+   * @generated from field: google.protobuf.Timestamp receipt_date = 3;
+   */
+  receiptDate?: Timestamp;
+
+  /**
+   * Total of the expenses of the receipt.
+   *
+   * @generated from field: uint64 receipt_amount = 4;
+   */
+  receiptAmount = protoInt64.zero;
+
+  /**
+   * @generated from field: receipts.v1.BankTransaction transaction = 5;
+   */
+  transaction?: BankTransaction;
+
+  /**
+   * From 0 to 1, the latter being an exact match of amount, date and vendor.
+   *
+   * @generated from field: double score = 6;
+   */
+  score = 0;
+
+  constructor(data?: PartialMessage<ReconciliationProposal>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.ListReceiptsRequest";
+  static readonly typeName = "receipts.v1.ReconciliationProposal";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "since", kind: "enum", T: proto3.getEnumType(ListReceiptsSince) },
-    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
+    { no: 1, name: "receipt_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "receipt_date", kind: "message", T: Timestamp },
+    { no: 4, name: "receipt_amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "transaction", kind: "message", T: BankTransaction },
+    { no: 6, name: "score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReceiptsRequest {
-    return new ListReceiptsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconciliationProposal {
+    return new ReconciliationProposal().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReceiptsRequest {
-    return new ListReceiptsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconciliationProposal {
+    return new ReconciliationProposal().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReceiptsRequest {
-    return new ListReceiptsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconciliationProposal {
+    return new ReconciliationProposal().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListReceiptsRequest | PlainMessage<ListReceiptsRequest> | undefined, b: ListReceiptsRequest | PlainMessage<ListReceiptsRequest> | undefined): boolean {
-    return proto3.util.equals(ListReceiptsRequest, a, b);
+  static equals(a: ReconciliationProposal | PlainMessage<ReconciliationProposal> | undefined, b: ReconciliationProposal | PlainMessage<ReconciliationProposal> | undefined): boolean {
+    return proto3.util.equals(ReconciliationProposal, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.Receipt
+ * BankTransaction is an expense imported from a bank statement: paid from an
+ * account and not attached to any receipt.
+ *
+ * @generated from message receipts.v1.BankTransaction
  */
-export class Receipt extends Message<Receipt> {
+export class BankTransaction extends Message<BankTransaction> {
   /**
    * @generated from field: uint64 id = 1;
    */
   id = protoInt64.zero;
 
   /**
-   * @generated from field: receipts.v1.ReceiptStatus status = 2;
+   * @generated from field: uint64 account_id = 2;
    */
-  status = ReceiptStatus.UNSPECIFIED;
+  accountId = protoInt64.zero;
 
   /**
-   * @generated from field: string vendor = 3;
+   * @generated from field: google.protobuf.Timestamp date = 3;
    */
-  vendor = "";
+  date?: Timestamp;
 
   /**
-   * @generated from field: google.protobuf.Timestamp date = 4;
+   * @generated from field: uint64 amount = 4;
    */
-  date?: Timestamp;
+  amount = protoInt64.zero;
 
   /**
-   * @generated from field: repeated receipts.v1.Expense expenses = 5;
+   * @generated from field: string description = 5;
    */
-  expenses: Expense[] = [];
+  description = "";
 
-  constructor(data?: PartialMessage<Receipt>) {
+  /**
+   * @generated from field: string category = 6;
+   */
+  category = "";
+
+  /**
+   * @generated from field: string subcategory = 7;
+   */
+  subcategory = "";
+
+  constructor(data?: PartialMessage<BankTransaction>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.Receipt";
+  static readonly typeName = "receipts.v1.BankTransaction";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
-    { no: 3, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "date", kind: "message", T: Timestamp },
-    { no: 5, name: "expenses", kind: "message", T: Expense, repeated: true },
+    { no: 2, name: "account_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "date", kind: "message", T: Timestamp },
+    { no: 4, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "subcategory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Receipt {
-    return new Receipt().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BankTransaction {
+    return new BankTransaction().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Receipt {
-    return new Receipt().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BankTransaction {
+    return new BankTransaction().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Receipt {
-    return new Receipt().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BankTransaction {
+    return new BankTransaction().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Receipt | PlainMessage<Receipt> | undefined, b: Receipt | PlainMessage<Receipt> | undefined): boolean {
-    return proto3.util.equals(Receipt, a, b);
+  static equals(a: BankTransaction | PlainMessage<BankTransaction> | undefined, b: BankTransaction | PlainMessage<BankTransaction> | undefined): boolean {
+    return proto3.util.equals(BankTransaction, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.Expense
+ * The expenses of the receipt take the account of the transaction, and its
+ * amount when it includes a tip or a different exchange rate. The transaction
+ * is deleted.
+ *
+ * @generated from message receipts.v1.ReconcileReceiptRequest
  */
-export class Expense extends Message<Expense> {
+export class ReconcileReceiptRequest extends Message<ReconcileReceiptRequest> {
   /**
-   * @generated from field: uint64 id = 1;
+   * @generated from field: uint64 receipt_id = 1;
    */
-  id = protoInt64.zero;
+  receiptId = protoInt64.zero;
 
   /**
-   * @generated from field: google.protobuf.Timestamp date = 2;
+   * @generated from field: uint64 transaction_id = 2;
    */
-  date?: Timestamp;
+  transactionId = protoInt64.zero;
 
-  /**
-   * @generated from field: string category = 3;
-   */
-  category = "";
+  constructor(data?: PartialMessage<ReconcileReceiptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: string subcategory = 4;
-   */
-  subcategory = "";
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.ReconcileReceiptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "receipt_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "transaction_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
 
-  /**
-   * @generated from field: string description = 5;
-   */
-  description = "";
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileReceiptRequest {
+    return new ReconcileReceiptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileReceiptRequest {
+    return new ReconcileReceiptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileReceiptRequest {
+    return new ReconcileReceiptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconcileReceiptRequest | PlainMessage<ReconcileReceiptRequest> | undefined, b: ReconcileReceiptRequest | PlainMessage<ReconcileReceiptRequest> | undefined): boolean {
+    return proto3.util.equals(ReconcileReceiptRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message receipts.v1.ReconcileReceiptResponse
+ */
+export class ReconcileReceiptResponse extends Message<ReconcileReceiptResponse> {
   /**
-   * @generated from field: uint64 amount = 6;
+   * @generated from field: repeated receipts.v1.Expense expenses = 1;
    */
-  amount = protoInt64.zero;
+  expenses: Expense[] = [];
 
-  constructor(data?: PartialMessage<Expense>) {
+  constructor(data?: PartialMessage<ReconcileReceiptResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.Expense";
+  static readonly typeName = "receipts.v1.ReconcileReceiptResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "date", kind: "message", T: Timestamp },
-    { no: 3, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "subcategory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 1, name: "expenses", kind: "message", T: Expense, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Expense {
-    return new Expense().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileReceiptResponse {
+    return new ReconcileReceiptResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Expense {
-    return new Expense().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileReceiptResponse {
+    return new ReconcileReceiptResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Expense {
-    return new Expense().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileReceiptResponse {
+    return new ReconcileReceiptResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Expense | PlainMessage<Expense> | undefined, b: Expense | PlainMessage<Expense> | undefined): boolean {
-    return proto3.util.equals(Expense, a, b);
+  static equals(a: ReconcileReceiptResponse | PlainMessage<ReconcileReceiptResponse> | undefined, b: ReconcileReceiptResponse | PlainMessage<ReconcileReceiptResponse> | undefined): boolean {
+    return proto3.util.equals(ReconcileReceiptResponse, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.ListReceiptsResponse
+ * @generated from message receipts.v1.GetVATReportRequest
  */
-export class ListReceiptsResponse extends Message<ListReceiptsResponse> {
+export class GetVATReportRequest extends Message<GetVATReportRequest> {
   /**
-   * @generated from field: repeated receipts.v1.Receipt receipts = 1;
+   * @generated from field: int32 year = 1;
    */
-  receipts: Receipt[] = [];
+  year = 0;
 
-  constructor(data?: PartialMessage<ListReceiptsResponse>) {
+  /**
+   * From 1 to 4.
+   *
+   * @generated from field: int32 quarter = 2;
+   */
+  quarter = 0;
+
+  constructor(data?: PartialMessage<GetVATReportRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.ListReceiptsResponse";
+  static readonly typeName = "receipts.v1.GetVATReportRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "receipts", kind: "message", T: Receipt, repeated: true },
+    { no: 1, name: "year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "quarter", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReceiptsResponse {
-    return new ListReceiptsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetVATReportRequest {
+    return new GetVATReportRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReceiptsResponse {
-    return new ListReceiptsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetVATReportRequest {
+    return new GetVATReportRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReceiptsResponse {
-    return new ListReceiptsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetVATReportRequest {
+    return new GetVATReportRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListReceiptsResponse | PlainMessage<ListReceiptsResponse> | undefined, b: ListReceiptsResponse | PlainMessage<ListReceiptsResponse> | undefined): boolean {
-    return proto3.util.equals(ListReceiptsResponse, a, b);
+  static equals(a: GetVATReportRequest | PlainMessage<GetVATReportRequest> | undefined, b: GetVATReportRequest | PlainMessage<GetVATReportRequest> | undefined): boolean {
+    return proto3.util.equals(GetVATReportRequest, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.GetReceiptRequest
+ * Only reviewed receipts with the tax ID of the vendor count, since VAT can't
+ * be deducted from simplified tickets. Amounts are in cents.
+ *
+ * @generated from message receipts.v1.GetVATReportResponse
  */
-export class GetReceiptRequest extends Message<GetReceiptRequest> {
+export class GetVATReportResponse extends Message<GetVATReportResponse> {
   /**
-   * @generated from field: uint64 id = 1;
+   * Highest rate first.
+   *
+   * @generated from field: repeated receipts.v1.VATRate rates = 1;
    */
-  id = protoInt64.zero;
+  rates: VATRate[] = [];
 
-  constructor(data?: PartialMessage<GetReceiptRequest>) {
+  /**
+   * @generated from field: uint64 total_base = 2;
+   */
+  totalBase = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 total_amount = 3;
+   */
+  totalAmount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetVATReportResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.GetReceiptRequest";
+  static readonly typeName = "receipts.v1.GetVATReportResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 1, name: "rates", kind: "message", T: VATRate, repeated: true },
+    { no: 2, name: "total_base", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "total_amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReceiptRequest {
-    return new GetReceiptRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetVATReportResponse {
+    return new GetVATReportResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReceiptRequest {
-    return new GetReceiptRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetVATReportResponse {
+    return new GetVATReportResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReceiptRequest {
-    return new GetReceiptRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetVATReportResponse {
+    return new GetVATReportResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetReceiptRequest | PlainMessage<GetReceiptRequest> | undefined, b: GetReceiptRequest | PlainMessage<GetReceiptRequest> | undefined): boolean {
-    return proto3.util.equals(GetReceiptRequest, a, b);
+  static equals(a: GetVATReportResponse | PlainMessage<GetVATReportResponse> | undefined, b: GetVATReportResponse | PlainMessage<GetVATReportResponse> | undefined): boolean {
+    return proto3.util.equals(GetVATReportResponse, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.GetReceiptResponse
+ * @generated from message receipts.v1.VATRate
  */
-export class GetReceiptResponse extends Message<GetReceiptResponse> {
+export class VATRate extends Message<VATRate> {
   /**
-   * @generated from field: receipts.v1.FullReceipt receipt = 1;
+   * @generated from field: double rate = 1;
    */
-  receipt?: FullReceipt;
+  rate = 0;
 
-  constructor(data?: PartialMessage<GetReceiptResponse>) {
+  /**
+   * @generated from field: uint64 base = 2;
+   */
+  base = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  /**
+   * How many receipts have taxes at the rate.
+   *
+   * @generated from field: uint32 receipts = 4;
+   */
+  receipts = 0;
+
+  constructor(data?: PartialMessage<VATRate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.GetReceiptResponse";
+  static readonly typeName = "receipts.v1.VATRate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "receipt", kind: "message", T: FullReceipt },
+    { no: 1, name: "rate", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "base", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "receipts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReceiptResponse {
-    return new GetReceiptResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VATRate {
+    return new VATRate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReceiptResponse {
-    return new GetReceiptResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VATRate {
+    return new VATRate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReceiptResponse {
-    return new GetReceiptResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VATRate {
+    return new VATRate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetReceiptResponse | PlainMessage<GetReceiptResponse> | undefined, b: GetReceiptResponse | PlainMessage<GetReceiptResponse> | undefined): boolean {
-    return proto3.util.equals(GetReceiptResponse, a, b);
+  static equals(a: VATRate | PlainMessage<VATRate> | undefined, b: VATRate | PlainMessage<VATRate> | undefined): boolean {
+    return proto3.util.equals(VATRate, a, b);
   }
 }
 
 /**
- * @generated from message receipts.v1.FullReceipt
+ * Every filter is optional. Archived receipts are left out.
+ *
+ * @generated from message receipts.v1.GenerateExpenseReportRequest
  */
-export class FullReceipt extends Message<FullReceipt> {
+export class GenerateExpenseReportRequest extends Message<GenerateExpenseReportRequest> {
   /**
-   * @generated from field: uint64 id = 1;
+   * Of the dates of the expenses.
+   *
+   * @generated from field: receipts.v1.DateRange date_range = 1;
    */
-  id = protoInt64.zero;
+  dateRange?: DateRange;
 
   /**
-   * @generated from field: receipts.v1.ReceiptStatus status = 2;
+   * Only reports the expenses in the category.
+   *
+   * @generated from field: string category = 2;
    */
-  status = ReceiptStatus.UNSPECIFIED;
+  category = "";
 
   /**
-   * @generated from field: string vendor = 3;
+   * Only reports the expenses in the subcategory.
+   *
+   * @generated from field: string subcategory = 3;
    */
-  vendor = "";
+  subcategory = "";
+
+  constructor(data?: PartialMessage<GenerateExpenseReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "receipts.v1.GenerateExpenseReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "date_range", kind: "message", T: DateRange },
+    { no: 2, name: "category", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "subcategory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateExpenseReportRequest {
+    return new GenerateExpenseReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateExpenseReportRequest {
+    return new GenerateExpenseReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateExpenseReportRequest {
+    return new GenerateExpenseReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateExpenseReportRequest | PlainMessage<GenerateExpenseReportRequest> | undefined, b: GenerateExpenseReportRequest | PlainMessage<GenerateExpenseReportRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateExpenseReportRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message receipts.v1.GenerateExpenseReportResponse
+ */
+export class GenerateExpenseReportResponse extends Message<GenerateExpenseReportResponse> {
   /**
-   * @generated from field: google.protobuf.Timestamp date = 4;
+   * @generated from field: bytes pdf = 1;
    */
-  date?: Timestamp;
+  pdf = new Uint8Array(0);
 
   /**
-   * @generated from field: bytes file = 5;
+   * @generated from field: uint32 receipts = 2;
    */
-  file = new Uint8Array(0);
+  receipts = 0;
 
   /**
-   * @generated from field: repeated receipts.v1.Expense expenses = 6;
+   * In cents.
+   *
+   * @generated from field: uint64 total = 3;
    */
-  expenses: Expense[] = [];
+  total = protoInt64.zero;
 
-  constructor(data?: PartialMessage<FullReceipt>) {
+  constructor(data?: PartialMessage<GenerateExpenseReportResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "receipts.v1.FullReceipt";
+  static readonly typeName = "receipts.v1.GenerateExpenseReportResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(ReceiptStatus) },
-    { no: 3, name: "vendor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "date", kind: "message", T: Timestamp },
-    { no: 5, name: "file", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 6, name: "expenses", kind: "message", T: Expense, repeated: true },
+    { no: 1, name: "pdf", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "receipts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "total", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FullReceipt {
-    return new FullReceipt().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateExpenseReportResponse {
+    return new GenerateExpenseReportResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FullReceipt {
-    return new FullReceipt().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateExpenseReportResponse {
+    return new GenerateExpenseReportResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FullReceipt {
-    return new FullReceipt().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateExpenseReportResponse {
+    return new GenerateExpenseReportResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: FullReceipt | PlainMessage<FullReceipt> | undefined, b: FullReceipt | PlainMessage<FullReceipt> | undefined): boolean {
-    return proto3.util.equals(FullReceipt, a, b);
+  static equals(a: GenerateExpenseReportResponse | PlainMessage<GenerateExpenseReportResponse> | undefined, b: GenerateExpenseReportResponse | PlainMessage<GenerateExpenseReportResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateExpenseReportResponse, a, b);
   }
 }
 
@@ -0,0 +1,62 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file settlements.v1/settlements.proto (package settlements.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateSettlementRequest, CreateSettlementResponse, GetBalancesRequest, GetBalancesResponse, ListSettlementsRequest, ListSettlementsResponse, RemoveExpenseSplitRequest, RemoveExpenseSplitResponse, SplitExpenseRequest, SplitExpenseResponse } from "./settlements_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service settlements.v1.SettlementsService
+ */
+export const SettlementsService = {
+  typeName: "settlements.v1.SettlementsService",
+  methods: {
+    /**
+     * @generated from rpc settlements.v1.SettlementsService.SplitExpense
+     */
+    splitExpense: {
+      name: "SplitExpense",
+      I: SplitExpenseRequest,
+      O: SplitExpenseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc settlements.v1.SettlementsService.RemoveExpenseSplit
+     */
+    removeExpenseSplit: {
+      name: "RemoveExpenseSplit",
+      I: RemoveExpenseSplitRequest,
+      O: RemoveExpenseSplitResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc settlements.v1.SettlementsService.GetBalances
+     */
+    getBalances: {
+      name: "GetBalances",
+      I: GetBalancesRequest,
+      O: GetBalancesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc settlements.v1.SettlementsService.CreateSettlement
+     */
+    createSettlement: {
+      name: "CreateSettlement",
+      I: CreateSettlementRequest,
+      O: CreateSettlementResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc settlements.v1.SettlementsService.ListSettlements
+     */
+    listSettlements: {
+      name: "ListSettlements",
+      I: ListSettlementsRequest,
+      O: ListSettlementsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
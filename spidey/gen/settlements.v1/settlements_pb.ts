@@ -0,0 +1,687 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file settlements.v1/settlements.proto (package settlements.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum settlements.v1.SplitMethod
+ */
+export enum SplitMethod {
+  /**
+   * @generated from enum value: SPLIT_METHOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SPLIT_METHOD_EQUAL = 1;
+   */
+  EQUAL = 1,
+
+  /**
+   * @generated from enum value: SPLIT_METHOD_PERCENTAGE = 2;
+   */
+  PERCENTAGE = 2,
+
+  /**
+   * @generated from enum value: SPLIT_METHOD_EXACT = 3;
+   */
+  EXACT = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SplitMethod)
+proto3.util.setEnumType(SplitMethod, "settlements.v1.SplitMethod", [
+  { no: 0, name: "SPLIT_METHOD_UNSPECIFIED" },
+  { no: 1, name: "SPLIT_METHOD_EQUAL" },
+  { no: 2, name: "SPLIT_METHOD_PERCENTAGE" },
+  { no: 3, name: "SPLIT_METHOD_EXACT" },
+]);
+
+/**
+ * @generated from message settlements.v1.SplitExpenseRequest
+ */
+export class SplitExpenseRequest extends Message<SplitExpenseRequest> {
+  /**
+   * @generated from field: uint64 expense_id = 1;
+   */
+  expenseId = protoInt64.zero;
+
+  /**
+   * Defaults to the owner of the expense.
+   *
+   * @generated from field: optional string paid_by = 2;
+   */
+  paidBy?: string;
+
+  /**
+   * @generated from field: settlements.v1.SplitMethod method = 3;
+   */
+  method = SplitMethod.UNSPECIFIED;
+
+  /**
+   * @generated from field: repeated settlements.v1.Participant participants = 4;
+   */
+  participants: Participant[] = [];
+
+  constructor(data?: PartialMessage<SplitExpenseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.SplitExpenseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "expense_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "paid_by", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "method", kind: "enum", T: proto3.getEnumType(SplitMethod) },
+    { no: 4, name: "participants", kind: "message", T: Participant, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SplitExpenseRequest {
+    return new SplitExpenseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SplitExpenseRequest {
+    return new SplitExpenseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SplitExpenseRequest {
+    return new SplitExpenseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SplitExpenseRequest | PlainMessage<SplitExpenseRequest> | undefined, b: SplitExpenseRequest | PlainMessage<SplitExpenseRequest> | undefined): boolean {
+    return proto3.util.equals(SplitExpenseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.SplitExpenseResponse
+ */
+export class SplitExpenseResponse extends Message<SplitExpenseResponse> {
+  /**
+   * @generated from field: repeated settlements.v1.Share shares = 1;
+   */
+  shares: Share[] = [];
+
+  constructor(data?: PartialMessage<SplitExpenseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.SplitExpenseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "shares", kind: "message", T: Share, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SplitExpenseResponse {
+    return new SplitExpenseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SplitExpenseResponse {
+    return new SplitExpenseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SplitExpenseResponse {
+    return new SplitExpenseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SplitExpenseResponse | PlainMessage<SplitExpenseResponse> | undefined, b: SplitExpenseResponse | PlainMessage<SplitExpenseResponse> | undefined): boolean {
+    return proto3.util.equals(SplitExpenseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.RemoveExpenseSplitRequest
+ */
+export class RemoveExpenseSplitRequest extends Message<RemoveExpenseSplitRequest> {
+  /**
+   * @generated from field: uint64 expense_id = 1;
+   */
+  expenseId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RemoveExpenseSplitRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.RemoveExpenseSplitRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "expense_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveExpenseSplitRequest {
+    return new RemoveExpenseSplitRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveExpenseSplitRequest {
+    return new RemoveExpenseSplitRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveExpenseSplitRequest {
+    return new RemoveExpenseSplitRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveExpenseSplitRequest | PlainMessage<RemoveExpenseSplitRequest> | undefined, b: RemoveExpenseSplitRequest | PlainMessage<RemoveExpenseSplitRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveExpenseSplitRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.RemoveExpenseSplitResponse
+ */
+export class RemoveExpenseSplitResponse extends Message<RemoveExpenseSplitResponse> {
+  constructor(data?: PartialMessage<RemoveExpenseSplitResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.RemoveExpenseSplitResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveExpenseSplitResponse {
+    return new RemoveExpenseSplitResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveExpenseSplitResponse {
+    return new RemoveExpenseSplitResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveExpenseSplitResponse {
+    return new RemoveExpenseSplitResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveExpenseSplitResponse | PlainMessage<RemoveExpenseSplitResponse> | undefined, b: RemoveExpenseSplitResponse | PlainMessage<RemoveExpenseSplitResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveExpenseSplitResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.GetBalancesRequest
+ */
+export class GetBalancesRequest extends Message<GetBalancesRequest> {
+  constructor(data?: PartialMessage<GetBalancesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.GetBalancesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBalancesRequest {
+    return new GetBalancesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBalancesRequest {
+    return new GetBalancesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBalancesRequest {
+    return new GetBalancesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBalancesRequest | PlainMessage<GetBalancesRequest> | undefined, b: GetBalancesRequest | PlainMessage<GetBalancesRequest> | undefined): boolean {
+    return proto3.util.equals(GetBalancesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.GetBalancesResponse
+ */
+export class GetBalancesResponse extends Message<GetBalancesResponse> {
+  /**
+   * @generated from field: repeated settlements.v1.Balance balances = 1;
+   */
+  balances: Balance[] = [];
+
+  /**
+   * @generated from field: repeated settlements.v1.Debt debts = 2;
+   */
+  debts: Debt[] = [];
+
+  constructor(data?: PartialMessage<GetBalancesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.GetBalancesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "balances", kind: "message", T: Balance, repeated: true },
+    { no: 2, name: "debts", kind: "message", T: Debt, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBalancesResponse {
+    return new GetBalancesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBalancesResponse {
+    return new GetBalancesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBalancesResponse {
+    return new GetBalancesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBalancesResponse | PlainMessage<GetBalancesResponse> | undefined, b: GetBalancesResponse | PlainMessage<GetBalancesResponse> | undefined): boolean {
+    return proto3.util.equals(GetBalancesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.CreateSettlementRequest
+ */
+export class CreateSettlementRequest extends Message<CreateSettlementRequest> {
+  /**
+   * @generated from field: string from_email = 1;
+   */
+  fromEmail = "";
+
+  /**
+   * @generated from field: string to_email = 2;
+   */
+  toEmail = "";
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp date = 4;
+   */
+  date?: Timestamp;
+
+  constructor(data?: PartialMessage<CreateSettlementRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.CreateSettlementRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "to_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "date", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSettlementRequest {
+    return new CreateSettlementRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSettlementRequest {
+    return new CreateSettlementRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSettlementRequest {
+    return new CreateSettlementRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSettlementRequest | PlainMessage<CreateSettlementRequest> | undefined, b: CreateSettlementRequest | PlainMessage<CreateSettlementRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSettlementRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.CreateSettlementResponse
+ */
+export class CreateSettlementResponse extends Message<CreateSettlementResponse> {
+  /**
+   * @generated from field: settlements.v1.Settlement settlement = 1;
+   */
+  settlement?: Settlement;
+
+  constructor(data?: PartialMessage<CreateSettlementResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.CreateSettlementResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settlement", kind: "message", T: Settlement },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSettlementResponse {
+    return new CreateSettlementResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSettlementResponse {
+    return new CreateSettlementResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSettlementResponse {
+    return new CreateSettlementResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSettlementResponse | PlainMessage<CreateSettlementResponse> | undefined, b: CreateSettlementResponse | PlainMessage<CreateSettlementResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSettlementResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.ListSettlementsRequest
+ */
+export class ListSettlementsRequest extends Message<ListSettlementsRequest> {
+  constructor(data?: PartialMessage<ListSettlementsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.ListSettlementsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSettlementsRequest {
+    return new ListSettlementsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSettlementsRequest {
+    return new ListSettlementsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSettlementsRequest {
+    return new ListSettlementsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSettlementsRequest | PlainMessage<ListSettlementsRequest> | undefined, b: ListSettlementsRequest | PlainMessage<ListSettlementsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSettlementsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.ListSettlementsResponse
+ */
+export class ListSettlementsResponse extends Message<ListSettlementsResponse> {
+  /**
+   * @generated from field: repeated settlements.v1.Settlement settlements = 1;
+   */
+  settlements: Settlement[] = [];
+
+  constructor(data?: PartialMessage<ListSettlementsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.ListSettlementsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settlements", kind: "message", T: Settlement, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSettlementsResponse {
+    return new ListSettlementsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSettlementsResponse {
+    return new ListSettlementsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSettlementsResponse {
+    return new ListSettlementsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSettlementsResponse | PlainMessage<ListSettlementsResponse> | undefined, b: ListSettlementsResponse | PlainMessage<ListSettlementsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSettlementsResponse, a, b);
+  }
+}
+
+/**
+ * Participant of a split. Percentage is only used by percentage splits and
+ * amount, in cents, by exact splits.
+ *
+ * @generated from message settlements.v1.Participant
+ */
+export class Participant extends Message<Participant> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: double percentage = 2;
+   */
+  percentage = 0;
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Participant>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.Participant";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "percentage", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Participant {
+    return new Participant().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Participant {
+    return new Participant().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Participant {
+    return new Participant().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Participant | PlainMessage<Participant> | undefined, b: Participant | PlainMessage<Participant> | undefined): boolean {
+    return proto3.util.equals(Participant, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.Share
+ */
+export class Share extends Message<Share> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: uint64 amount = 2;
+   */
+  amount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Share>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.Share";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Share {
+    return new Share().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Share {
+    return new Share().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Share {
+    return new Share().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Share | PlainMessage<Share> | undefined, b: Share | PlainMessage<Share> | undefined): boolean {
+    return proto3.util.equals(Share, a, b);
+  }
+}
+
+/**
+ * Balance is positive when the person is owed money and negative when they owe
+ * it.
+ *
+ * @generated from message settlements.v1.Balance
+ */
+export class Balance extends Message<Balance> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: int64 amount = 2;
+   */
+  amount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Balance>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.Balance";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "amount", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Balance {
+    return new Balance().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Balance {
+    return new Balance().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Balance {
+    return new Balance().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Balance | PlainMessage<Balance> | undefined, b: Balance | PlainMessage<Balance> | undefined): boolean {
+    return proto3.util.equals(Balance, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.Debt
+ */
+export class Debt extends Message<Debt> {
+  /**
+   * @generated from field: string from_email = 1;
+   */
+  fromEmail = "";
+
+  /**
+   * @generated from field: string to_email = 2;
+   */
+  toEmail = "";
+
+  /**
+   * @generated from field: uint64 amount = 3;
+   */
+  amount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Debt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.Debt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "to_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Debt {
+    return new Debt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Debt {
+    return new Debt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Debt {
+    return new Debt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Debt | PlainMessage<Debt> | undefined, b: Debt | PlainMessage<Debt> | undefined): boolean {
+    return proto3.util.equals(Debt, a, b);
+  }
+}
+
+/**
+ * @generated from message settlements.v1.Settlement
+ */
+export class Settlement extends Message<Settlement> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string from_email = 2;
+   */
+  fromEmail = "";
+
+  /**
+   * @generated from field: string to_email = 3;
+   */
+  toEmail = "";
+
+  /**
+   * @generated from field: uint64 amount = 4;
+   */
+  amount = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 5;
+   */
+  date?: Timestamp;
+
+  constructor(data?: PartialMessage<Settlement>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "settlements.v1.Settlement";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "from_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "date", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Settlement {
+    return new Settlement().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Settlement {
+    return new Settlement().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Settlement {
+    return new Settlement().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Settlement | PlainMessage<Settlement> | undefined, b: Settlement | PlainMessage<Settlement> | undefined): boolean {
+    return proto3.util.equals(Settlement, a, b);
+  }
+}
+
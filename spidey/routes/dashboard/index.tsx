@@ -0,0 +1,82 @@
+import { RouteContext } from "$fresh/server.ts";
+import { createPromiseClient } from "@connectrpc/connect";
+import { createConnectTransport } from "@connectrpc/connect-web";
+import { DashboardService } from "../../gen/dashboard.v1/dashboard_connect.ts";
+import {
+  CategoryAggregate,
+  MonthlySpend,
+} from "../../gen/dashboard.v1/dashboard_pb.ts";
+import GenericTable from "../../components/GenericTable.tsx";
+import FormattedMoney from "../../components/FormattedMoney.tsx";
+import { AuthState } from "../../lib/auth.ts";
+
+const url = Deno.env.get("API_HOST")!;
+
+export default async function Dashboard(
+  _req: Request,
+  ctx: RouteContext<unknown, AuthState>,
+) {
+  if (!ctx.state || !ctx.state.loggedIn) {
+    return ctx.renderNotFound({});
+  }
+
+  const transport = createConnectTransport({
+    baseUrl: url!,
+  });
+  const client = createPromiseClient(DashboardService, transport);
+
+  const res = await client.getDashboard(
+    {},
+    { headers: { authorization: `Bearer ${ctx.state.authToken}` } },
+  );
+
+  if (!res.mostRecent) {
+    return (
+      <div class="m-6">
+        <p>You don't seem to have any expenses yet.</p>
+      </div>
+    );
+  }
+
+  const prettyMonthYear = res.mostRecent.toDate().toLocaleDateString("en-GB", {
+    month: "long",
+    year: "numeric",
+  });
+
+  return (
+    <div class="m-6 space-y-6">
+      <div>
+        <h2 class="text-xl font-bold mb-2">Total spend</h2>
+        <GenericTable<MonthlySpend>
+          data={res.totalSpends}
+          columns={[
+            { header: <>Month</>, accessor: (s) => <>{s.monthYear}</> },
+            {
+              header: <>Amount</>,
+              accessor: (s) => (
+                <FormattedMoney amount={Number(s.amount)} currency="EUR" />
+              ),
+            },
+          ]}
+        />
+      </div>
+      <div>
+        <h2 class="text-xl font-bold mb-2">
+          Top categories {prettyMonthYear}
+        </h2>
+        <GenericTable<CategoryAggregate>
+          data={res.topCategories}
+          columns={[
+            { header: <>Category</>, accessor: (c) => <>{c.category}</> },
+            {
+              header: <>Amount</>,
+              accessor: (c) => (
+                <FormattedMoney amount={Number(c.totalAmount)} currency="EUR" />
+              ),
+            },
+          ]}
+        />
+      </div>
+    </div>
+  );
+}